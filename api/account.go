@@ -3,8 +3,11 @@ package api
 import (
 	"errors"
 	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
 	db "goBank/db/sqlc"
 	"goBank/token"
+	"goBank/util"
+	"goBank/val"
 	"net/http"
 )
 
@@ -14,6 +17,8 @@ type createAccountRequest struct {
 	// Removing this param as it needs to default to 0 when setup
 	//Balance  int64  `json:"balance"`
 	Currency string `json:"currency" binding:"required,currency"`
+	// Optional, accounts are checking unless asked for otherwise
+	Product string `json:"product" binding:"omitempty,oneof=checking savings"`
 }
 
 func (server *Server) createAccount(ctx *gin.Context) {
@@ -30,6 +35,7 @@ func (server *Server) createAccount(ctx *gin.Context) {
 		Owner:    authPayload.Username,
 		Currency: req.Currency,
 		Balance:  0,
+		Product:  pgtype.Text{String: req.Product, Valid: req.Product != ""},
 	}
 
 	account, err := server.store.CreateAccount(ctx, arg)
@@ -45,7 +51,27 @@ func (server *Server) createAccount(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, account)
+	ctx.JSON(http.StatusOK, newAccountResponse(account))
+}
+
+// accountResponse adds the account's amounts as decimal strings in its currency's minor units
+type accountResponse struct {
+	db.Account
+	BalanceDecimal          string `json:"balance_decimal"`
+	OverdraftLimitDecimal   string `json:"overdraft_limit_decimal"`
+	HeldBalanceDecimal      string `json:"held_balance_decimal"`
+	AvailableBalanceDecimal string `json:"available_balance_decimal"`
+}
+
+func newAccountResponse(account db.Account) accountResponse {
+	currency, _ := util.LookupCurrency(account.Currency)
+	return accountResponse{
+		Account:                 account,
+		BalanceDecimal:          currency.FormatAmount(account.Balance),
+		OverdraftLimitDecimal:   currency.FormatAmount(account.OverdraftLimit),
+		HeldBalanceDecimal:      currency.FormatAmount(account.HeldBalance),
+		AvailableBalanceDecimal: currency.FormatAmount(account.AvailableBalance()),
+	}
 }
 
 type getAccountRequest struct {
@@ -80,12 +106,20 @@ func (server *Server) getAccount(ctx *gin.Context) {
 		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
 		return
 	}
-	ctx.JSON(http.StatusOK, account)
+	ctx.JSON(http.StatusOK, newAccountResponse(account))
 }
 
 type listAccountRequest struct {
-	PageID   int32 `form:"page_id" binding:"required,min=1"`
-	PageSize int32 `form:"page_size" binding:"required,min=5,max=10"`
+	pageQuery
+	Currency   string `form:"currency" binding:"omitempty,currency"`
+	MinBalance *int64 `form:"min_balance"`
+	MaxBalance *int64 `form:"max_balance"`
+}
+
+type listAccountResponse struct {
+	Accounts []accountResponse `json:"accounts"`
+	// empty on the last page
+	NextPageToken string `json:"next_page_token"`
 }
 
 func (server *Server) listAccounts(ctx *gin.Context) {
@@ -95,11 +129,25 @@ func (server *Server) listAccounts(ctx *gin.Context) {
 		return
 	}
 
+	afterID, err := req.afterID()
+	if err == nil {
+		err = val.ValidateAmountRange(req.MinBalance, req.MaxBalance)
+	}
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
 	arg := db.ListAccountsParams{
-		Owner:  authPayload.Username,
-		Limit:  req.PageSize,
-		Offset: (req.PageID - 1) * req.PageSize,
+		Owner:       authPayload.Username,
+		AfterID:     afterID,
+		Currency:    pgtype.Text{String: req.Currency, Valid: req.Currency != ""},
+		CreatedFrom: timestamptzArg(req.CreatedFrom),
+		CreatedTo:   timestamptzArg(req.CreatedTo),
+		MinBalance:  int8Arg(req.MinBalance),
+		MaxBalance:  int8Arg(req.MaxBalance),
+		Limit:       util.PageLimit(req.PageSize),
 	}
 
 	accounts, err := server.store.ListAccounts(ctx, arg)
@@ -108,5 +156,46 @@ func (server *Server) listAccounts(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, accounts)
+	accounts, nextPageToken := util.NextPage(accounts, req.PageSize, func(account db.Account) int64 { return account.ID })
+
+	rsp := listAccountResponse{
+		Accounts:      make([]accountResponse, len(accounts)),
+		NextPageToken: nextPageToken,
+	}
+	for i, account := range accounts {
+		rsp.Accounts[i] = newAccountResponse(account)
+	}
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+// ownedAccount loads the account in the request's URI and makes sure it belongs to the authenticated user
+func (server *Server) ownedAccount(ctx *gin.Context, accountID int64) (db.Account, bool) {
+	account, valid := server.existingAccount(ctx, accountID)
+	if !valid {
+		return account, false
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if account.Owner != authPayload.Username {
+		err := errors.New("account doesn't belong to the authenticated user")
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return account, false
+	}
+
+	return account, true
+}
+
+// auditableAccount loads the account in the request's URI if the authenticated user may look into it,
+// any account for a banker, only their own for everyone else
+func (server *Server) auditableAccount(ctx *gin.Context, accountID int64) (db.Account, bool) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if authPayload.Role == util.BankerRole {
+		return server.existingAccount(ctx, accountID)
+	}
+	return server.ownedAccount(ctx, accountID)
+}
+
+// listCurrencies is public, clients need the minor units of a currency before they can send amounts in it
+func (server *Server) listCurrencies(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, util.SupportedCurrencies())
 }
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/require"
 	mockdb "goBank/db/mock"
 	db "goBank/db/sqlc"
@@ -140,7 +141,7 @@ func TestGetAccountAPI(t *testing.T) {
 			tc.buildStubs(store)
 
 			//start test server and send request
-			server := newTestServer(t, store)
+			server := newTestServer(t, store, nil)
 			recorder := httptest.NewRecorder()
 
 			url := fmt.Sprintf("/accounts/%d", tc.accountID)
@@ -254,7 +255,7 @@ func TestCreateAccountAPI(t *testing.T) {
 			store := mockdb.NewMockStore(ctrl)
 			tc.buildStubs(store)
 
-			server := newTestServer(t, store)
+			server := newTestServer(t, store, nil)
 			recorder := httptest.NewRecorder()
 
 			// Marshal body data to JSON
@@ -276,14 +277,16 @@ func TestListAccountsAPI(t *testing.T) {
 	user, _ := randomUser(t)
 
 	n := 5
-	accounts := make([]db.Account, n)
-	for i := 0; i < n; i++ {
+	accounts := make([]db.Account, n+1)
+	for i := range accounts {
 		accounts[i] = randomAccount(user.Username)
 	}
 
 	type Query struct {
-		pageID   int
-		pageSize int
+		pageToken  string
+		pageSize   int
+		minBalance string
+		maxBalance string
 	}
 
 	testCases := []struct {
@@ -296,7 +299,6 @@ func TestListAccountsAPI(t *testing.T) {
 		{
 			name: "OK",
 			query: Query{
-				pageID:   1,
 				pageSize: n,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
@@ -304,9 +306,38 @@ func TestListAccountsAPI(t *testing.T) {
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				arg := db.ListAccountsParams{
-					Owner:  user.Username,
-					Limit:  int32(n),
-					Offset: 0,
+					Owner: user.Username,
+					Limit: int32(n + 1),
+				}
+
+				store.EXPECT().
+					ListAccounts(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(accounts[:n], nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchAccounts(t, recorder.Body, accounts[:n], "")
+			},
+		},
+		{
+			name: "NextPage",
+			query: Query{
+				pageToken:  util.EncodeCursor(42),
+				pageSize:   n,
+				minBalance: "10",
+				maxBalance: "1000",
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, user.Role, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListAccountsParams{
+					Owner:      user.Username,
+					AfterID:    42,
+					MinBalance: pgtype.Int8{Int64: 10, Valid: true},
+					MaxBalance: pgtype.Int8{Int64: 1000, Valid: true},
+					Limit:      int32(n + 1),
 				}
 
 				store.EXPECT().
@@ -316,13 +347,12 @@ func TestListAccountsAPI(t *testing.T) {
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
-				requireBodyMatchAccounts(t, recorder.Body, accounts)
+				requireBodyMatchAccounts(t, recorder.Body, accounts[:n], util.EncodeCursor(accounts[n-1].ID))
 			},
 		},
 		{
 			name: "NoAuthorization",
 			query: Query{
-				pageID:   1,
 				pageSize: n,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
@@ -339,7 +369,6 @@ func TestListAccountsAPI(t *testing.T) {
 		{
 			name: "InternalError",
 			query: Query{
-				pageID:   1,
 				pageSize: n,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
@@ -356,10 +385,10 @@ func TestListAccountsAPI(t *testing.T) {
 			},
 		},
 		{
-			name: "InvalidPageID",
+			name: "InvalidPageToken",
 			query: Query{
-				pageID:   -1,
-				pageSize: n,
+				pageToken: "not-a-token",
+				pageSize:  n,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
 				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, user.Role, time.Minute)
@@ -376,7 +405,6 @@ func TestListAccountsAPI(t *testing.T) {
 		{
 			name: "InvalidPageSize",
 			query: Query{
-				pageID:   1,
 				pageSize: 100000,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
@@ -391,6 +419,25 @@ func TestListAccountsAPI(t *testing.T) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
+		{
+			name: "InvalidBalanceRange",
+			query: Query{
+				pageSize:   n,
+				minBalance: "1000",
+				maxBalance: "10",
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, user.Role, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAccounts(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
 	}
 
 	for i := range testCases {
@@ -403,7 +450,7 @@ func TestListAccountsAPI(t *testing.T) {
 			store := mockdb.NewMockStore(ctrl)
 			tc.buildStubs(store)
 
-			server := newTestServer(t, store)
+			server := newTestServer(t, store, nil)
 			recorder := httptest.NewRecorder()
 
 			url := "/accounts"
@@ -412,8 +459,16 @@ func TestListAccountsAPI(t *testing.T) {
 
 			// Add query parameters to request URL
 			q := request.URL.Query()
-			q.Add("page_id", fmt.Sprintf("%d", tc.query.pageID))
 			q.Add("page_size", fmt.Sprintf("%d", tc.query.pageSize))
+			if tc.query.pageToken != "" {
+				q.Add("page_token", tc.query.pageToken)
+			}
+			if tc.query.minBalance != "" {
+				q.Add("min_balance", tc.query.minBalance)
+			}
+			if tc.query.maxBalance != "" {
+				q.Add("max_balance", tc.query.maxBalance)
+			}
 			request.URL.RawQuery = q.Encode()
 
 			tc.setupAuth(t, request, server.tokenMaker)
@@ -443,12 +498,16 @@ func requireBodyMatchAccount(t *testing.T, body *bytes.Buffer, account db.Accoun
 
 }
 
-func requireBodyMatchAccounts(t *testing.T, body *bytes.Buffer, accounts []db.Account) {
+func requireBodyMatchAccounts(t *testing.T, body *bytes.Buffer, accounts []db.Account, nextPageToken string) {
 	data, err := io.ReadAll(body)
 	require.NoError(t, err)
 
-	var gotAccounts []db.Account
-	err = json.Unmarshal(data, &gotAccounts)
+	var gotPage struct {
+		Accounts      []db.Account `json:"accounts"`
+		NextPageToken string       `json:"next_page_token"`
+	}
+	err = json.Unmarshal(data, &gotPage)
 	require.NoError(t, err)
-	require.Equal(t, accounts, gotAccounts)
+	require.Equal(t, accounts, gotPage.Accounts)
+	require.Equal(t, nextPageToken, gotPage.NextPageToken)
 }
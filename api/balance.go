@@ -0,0 +1,134 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+	db "goBank/db/sqlc"
+	"goBank/util"
+	"goBank/val"
+	"net/http"
+	"time"
+)
+
+type getBalanceAtRequest struct {
+	At time.Time `form:"at" binding:"required"`
+}
+
+type balanceAtResponse struct {
+	AccountID      int64     `json:"account_id"`
+	Currency       string    `json:"currency"`
+	At             time.Time `json:"at"`
+	Balance        int64     `json:"balance"`
+	BalanceDecimal string    `json:"balance_decimal"`
+}
+
+// getBalanceAt returns the balance an account had at a point in time, bankers can look into any account
+func (server *Server) getBalanceAt(ctx *gin.Context) {
+	var uri getAccountRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req getBalanceAtRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	account, valid := server.auditableAccount(ctx, uri.ID)
+	if !valid {
+		return
+	}
+
+	balance, err := server.store.GetBalanceAt(ctx, db.GetBalanceAtParams{
+		At:        req.At,
+		AccountID: account.ID,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, balanceAtResponse{
+		AccountID:      account.ID,
+		Currency:       account.Currency,
+		At:             req.At,
+		Balance:        balance,
+		BalanceDecimal: util.FormatAmount(account.Currency, balance),
+	})
+}
+
+type getBalanceHistoryRequest struct {
+	FromDate time.Time `form:"from_date" binding:"required" time_format:"2006-01-02"`
+	ToDate   time.Time `form:"to_date" binding:"required" time_format:"2006-01-02"`
+}
+
+type balancePoint struct {
+	Date           time.Time `json:"date"`
+	Balance        int64     `json:"balance"`
+	BalanceDecimal string    `json:"balance_decimal"`
+}
+
+type balanceHistoryResponse struct {
+	AccountID int64  `json:"account_id"`
+	Currency  string `json:"currency"`
+	// days after today are left out
+	Points []balancePoint `json:"points"`
+}
+
+// getBalanceHistory returns an account's closing balance for each UTC day of a date range, for charting
+func (server *Server) getBalanceHistory(ctx *gin.Context) {
+	var uri getAccountRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req getBalanceHistoryRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	fromDate := db.StartOfDay(req.FromDate)
+	toDate := db.StartOfDay(req.ToDate)
+	if err := val.ValidateBalanceHistoryRange(fromDate, toDate); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	account, valid := server.auditableAccount(ctx, uri.ID)
+	if !valid {
+		return
+	}
+
+	//Tomorrow's balance isn't known yet
+	if today := db.StartOfDay(time.Now()); toDate.After(today) {
+		toDate = today
+	}
+
+	rows, err := server.store.ListDailyBalances(ctx, db.ListDailyBalancesParams{
+		FromDate:  pgtype.Date{Time: fromDate, Valid: true},
+		ToDate:    pgtype.Date{Time: toDate, Valid: true},
+		AccountID: account.ID,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	rsp := balanceHistoryResponse{
+		AccountID: account.ID,
+		Currency:  account.Currency,
+		Points:    make([]balancePoint, len(rows)),
+	}
+	for i, row := range rows {
+		rsp.Points[i] = balancePoint{
+			Date:           row.Day.Time,
+			Balance:        row.Balance,
+			BalanceDecimal: util.FormatAmount(account.Currency, row.Balance),
+		}
+	}
+	ctx.JSON(http.StatusOK, rsp)
+}
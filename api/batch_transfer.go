@@ -0,0 +1,108 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	db "goBank/db/sqlc"
+	"goBank/token"
+	"goBank/util"
+	"net/http"
+)
+
+type batchTransferLeg struct {
+	ToAccountID int64 `json:"to_account_id" binding:"required,min=1"`
+	Amount      int64 `json:"amount" binding:"required,gt=0"`
+}
+
+// batchTransferRequest is the JSON body, a CSV body sends the from account and currency in the query instead
+type batchTransferRequest struct {
+	FromAccountID int64              `json:"from_account_id" form:"from_account_id" binding:"required,min=1"`
+	Currency      string             `json:"currency" form:"currency" binding:"required,currency"`
+	Legs          []batchTransferLeg `json:"legs" form:"-" binding:"required,min=1,max=1000,dive"`
+}
+
+// batchTransferResponse spells out what the legs' fees left for the to accounts
+type batchTransferResponse struct {
+	db.BatchTransferTxResult
+	TotalNetAmount int64 `json:"total_net_amount"`
+}
+
+func (server *Server) createBatchTransfer(ctx *gin.Context) {
+	var req batchTransferRequest
+
+	//Handling bad data responses
+	if err := bindBatchTransferRequest(ctx, &req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var header transferHeader
+	if err := ctx.ShouldBindHeader(&header); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	fromAccount, valid := server.validAccount(ctx, req.FromAccountID, req.Currency)
+	if !valid {
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if fromAccount.Owner != authPayload.Username {
+		err := errors.New("from account doesn't belong to the authenticated user")
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return
+	}
+
+	arg := db.BatchTransferTxParams{
+		FromAccountID:  req.FromAccountID,
+		Role:           authPayload.Role,
+		Username:       authPayload.Username,
+		IdempotencyKey: header.IdempotencyKey,
+	}
+	//A pending transfer pays a single account, so payments that need a banker's approval are sent on their own.
+	//Legs to the same account add up, splitting a payment mustn't get it under the threshold.
+	totals := make(map[int64]int64, len(req.Legs))
+	for _, leg := range req.Legs {
+		totals[leg.ToAccountID] += leg.Amount
+		if server.config.RequiresApproval(req.Currency, totals[leg.ToAccountID]) {
+			err := fmt.Errorf("payment to account %d needs approval and must be sent as a single transfer", leg.ToAccountID)
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+			return
+		}
+		arg.Legs = append(arg.Legs, db.BatchTransferLeg{ToAccountID: leg.ToAccountID, Amount: leg.Amount})
+	}
+
+	result, err := server.store.BatchTransferTx(ctx, arg)
+	if err != nil {
+		transferError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, batchTransferResponse{
+		BatchTransferTxResult: result,
+		TotalNetAmount:        result.TotalAmount - result.TotalFee,
+	})
+}
+
+// bindBatchTransferRequest reads the batch from a JSON body, or from a text/csv body of to_account_id,amount rows
+func bindBatchTransferRequest(ctx *gin.Context, req *batchTransferRequest) error {
+	if ctx.ContentType() != "text/csv" {
+		return ctx.ShouldBindJSON(req)
+	}
+
+	payments, err := util.ParseBatchCSV(ctx.Request.Body)
+	if err != nil {
+		return err
+	}
+
+	req.Legs = make([]batchTransferLeg, len(payments))
+	for i, payment := range payments {
+		req.Legs[i] = batchTransferLeg{ToAccountID: payment.ToAccountID, Amount: payment.Amount}
+	}
+
+	//Binding the query validates the whole request, legs included
+	return ctx.ShouldBindWith(req, binding.Query)
+}
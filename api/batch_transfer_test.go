@@ -0,0 +1,251 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "goBank/db/mock"
+	db "goBank/db/sqlc"
+	"goBank/token"
+	"goBank/util"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestBatchTransferAPI(t *testing.T) {
+	user1, _ := randomUser(t)
+	user2, _ := randomUser(t)
+	user3, _ := randomUser(t)
+
+	account1 := randomAccount(user1.Username)
+	account2 := randomAccount(user2.Username)
+	account3 := randomAccount(user3.Username)
+
+	account1.Currency = util.USD
+	account2.Currency = util.USD
+	account3.Currency = util.USD
+
+	legs := []db.BatchTransferLeg{
+		{ToAccountID: account2.ID, Amount: 10},
+		{ToAccountID: account3.ID, Amount: 20},
+	}
+
+	testCases := []struct {
+		name          string
+		contentType   string
+		query         string
+		body          func(t *testing.T) []byte
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "JSON",
+			body: func(t *testing.T) []byte {
+				data, err := json.Marshal(gin.H{
+					"from_account_id": account1.ID,
+					"currency":        util.USD,
+					"legs": []gin.H{
+						{"to_account_id": account2.ID, "amount": 10},
+						{"to_account_id": account3.ID, "amount": 20},
+					},
+				})
+				require.NoError(t, err)
+				return data
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user1.Username, user1.Role, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+
+				arg := db.BatchTransferTxParams{
+					FromAccountID: account1.ID,
+					Legs:          legs,
+					Role:          user1.Role,
+					Username:      user1.Username,
+				}
+				store.EXPECT().
+					BatchTransferTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.BatchTransferTxResult{TotalAmount: 30, TotalFee: 2}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var rsp batchTransferResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &rsp)
+				require.NoError(t, err)
+				require.Equal(t, int64(30), rsp.TotalAmount)
+				require.Equal(t, int64(2), rsp.TotalFee)
+				require.Equal(t, int64(28), rsp.TotalNetAmount)
+			},
+		},
+		{
+			name:        "CSV",
+			contentType: "text/csv",
+			query:       fmt.Sprintf("?from_account_id=%d&currency=%s", account1.ID, util.USD),
+			body: func(t *testing.T) []byte {
+				return []byte(fmt.Sprintf("to_account_id,amount\n%d,10\n%d,20\n", account2.ID, account3.ID))
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user1.Username, user1.Role, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+
+				arg := db.BatchTransferTxParams{
+					FromAccountID: account1.ID,
+					Legs:          legs,
+					Role:          user1.Role,
+					Username:      user1.Username,
+				}
+				store.EXPECT().BatchTransferTx(gomock.Any(), gomock.Eq(arg)).Times(1)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:        "InvalidCSVAmount",
+			contentType: "text/csv",
+			query:       fmt.Sprintf("?from_account_id=%d&currency=%s", account1.ID, util.USD),
+			body: func(t *testing.T) []byte {
+				return []byte(fmt.Sprintf("%d,-10\n", account2.ID))
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user1.Username, user1.Role, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().BatchTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:        "CSVMissingCurrency",
+			contentType: "text/csv",
+			query:       fmt.Sprintf("?from_account_id=%d", account1.ID),
+			body: func(t *testing.T) []byte {
+				return []byte(fmt.Sprintf("%d,10\n", account2.ID))
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user1.Username, user1.Role, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().BatchTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:        "UnauthorizedUser",
+			contentType: "text/csv",
+			query:       fmt.Sprintf("?from_account_id=%d&currency=%s", account1.ID, util.USD),
+			body: func(t *testing.T) []byte {
+				return []byte(fmt.Sprintf("%d,10\n", account2.ID))
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user2.Username, user2.Role, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().BatchTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:        "InsufficientFunds",
+			contentType: "text/csv",
+			query:       fmt.Sprintf("?from_account_id=%d&currency=%s", account1.ID, util.USD),
+			body: func(t *testing.T) []byte {
+				return []byte(fmt.Sprintf("%d,10\n", account2.ID))
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user1.Username, user1.Role, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().
+					BatchTransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.BatchTransferTxResult{}, db.ErrInsufficientFunds)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name:        "NeedsApproval",
+			contentType: "text/csv",
+			query:       fmt.Sprintf("?from_account_id=%d&currency=%s", account1.ID, util.USD),
+			body: func(t *testing.T) []byte {
+				return []byte(fmt.Sprintf("%d,10\n%d,5000\n", account2.ID, account3.ID))
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user1.Username, user1.Role, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().BatchTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name:        "SplitPaymentNeedsApproval",
+			contentType: "text/csv",
+			query:       fmt.Sprintf("?from_account_id=%d&currency=%s", account1.ID, util.USD),
+			body: func(t *testing.T) []byte {
+				return []byte(fmt.Sprintf("%d,600\n%d,20\n%d,600\n", account2.ID, account3.ID, account2.ID))
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user1.Username, user1.Role, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().BatchTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			url := "/transfers/batch" + tc.query
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(tc.body(t)))
+			require.NoError(t, err)
+			if tc.contentType != "" {
+				request.Header.Set("Content-Type", tc.contentType)
+			}
+
+			tc.setupAuth(t, request, server.tokenMaker)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	db "goBank/db/sqlc"
+	"goBank/util"
+	"goBank/val"
+	"net/http"
+)
+
+type listEntriesRequest struct {
+	pageQuery
+	MinAmount *int64 `form:"min_amount"`
+	MaxAmount *int64 `form:"max_amount"`
+}
+
+type listEntriesResponse struct {
+	Entries []db.Entry `json:"entries"`
+	// empty on the last page
+	NextPageToken string `json:"next_page_token"`
+}
+
+func (server *Server) listEntries(ctx *gin.Context) {
+	var uri getAccountRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req listEntriesRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	afterID, err := req.afterID()
+	if err == nil {
+		err = val.ValidateAmountRange(req.MinAmount, req.MaxAmount)
+	}
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, valid := server.ownedAccount(ctx, uri.ID); !valid {
+		return
+	}
+
+	entries, err := server.store.ListEntries(ctx, db.ListEntriesParams{
+		AccountID:   uri.ID,
+		AfterID:     afterID,
+		CreatedFrom: timestamptzArg(req.CreatedFrom),
+		CreatedTo:   timestamptzArg(req.CreatedTo),
+		MinAmount:   int8Arg(req.MinAmount),
+		MaxAmount:   int8Arg(req.MaxAmount),
+		Limit:       util.PageLimit(req.PageSize),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	entries, nextPageToken := util.NextPage(entries, req.PageSize, func(entry db.Entry) int64 { return entry.ID })
+	ctx.JSON(http.StatusOK, listEntriesResponse{Entries: entries, NextPageToken: nextPageToken})
+}
@@ -0,0 +1,60 @@
+package api
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	db "goBank/db/sqlc"
+	"goBank/token"
+	"net/http"
+	"time"
+)
+
+type createExchangeQuoteRequest struct {
+	FromCurrency string `json:"from_currency" binding:"required,currency"`
+	ToCurrency   string `json:"to_currency" binding:"required,currency,nefield=FromCurrency"`
+}
+
+func (server *Server) createExchangeQuote(ctx *gin.Context) {
+	var req createExchangeQuoteRequest
+
+	//Handling bad data responses
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	rate, err := server.store.GetLatestExchangeRate(ctx, db.GetLatestExchangeRateParams{
+		FromCurrency: req.FromCurrency,
+		ToCurrency:   req.ToCurrency,
+	})
+
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	//The quote locks the rate for the user until it expires or is used by a transfer
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	arg := db.CreateExchangeQuoteParams{
+		ID:           uuid.New(),
+		Username:     authPayload.Username,
+		FromCurrency: rate.FromCurrency,
+		ToCurrency:   rate.ToCurrency,
+		Rate:         rate.Rate,
+		ExpiresAt:    time.Now().Add(server.config.ExchangeQuoteDuration),
+	}
+
+	quote, err := server.store.CreateExchangeQuote(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, quote)
+}
@@ -5,18 +5,21 @@ import (
 	"github.com/stretchr/testify/require"
 	db "goBank/db/sqlc"
 	"goBank/util"
+	"goBank/worker"
 	"os"
 	"testing"
 	"time"
 )
 
-func newTestServer(t *testing.T, store db.Store) *Server {
+func newTestServer(t *testing.T, store db.Store, taskDistributor worker.TaskDistributor) *Server {
 	config := util.Config{
-		TokenSymmetricKey:   util.RandomString(32),
-		AccessTokenDuration: time.Minute,
+		TokenSymmetricKey:       util.RandomString(32),
+		AccessTokenDuration:     time.Minute,
+		ApprovalThresholds:      util.CurrencyAmounts{util.USD: 1000},
+		PendingTransferDuration: time.Hour,
 	}
 
-	server, err := NewServer(config, store)
+	server, err := NewServer(config, store, taskDistributor)
 	require.NoError(t, err)
 
 	return server
@@ -0,0 +1,48 @@
+package api
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+	"goBank/util"
+	"goBank/val"
+	"time"
+)
+
+// pageQuery is the paging and creation date range every keyset paginated list takes in its query string
+type pageQuery struct {
+	// next_page_token of the previous page, empty for the first page
+	PageToken   string     `form:"page_token"`
+	PageSize    int32      `form:"page_size" binding:"required,min=5,max=100"`
+	CreatedFrom *time.Time `form:"created_from"`
+	CreatedTo   *time.Time `form:"created_to"`
+}
+
+// afterID decodes the page token into the id the page starts after and checks the date range
+func (query pageQuery) afterID() (int64, error) {
+	afterID, err := util.DecodeCursor(query.PageToken)
+	if err != nil {
+		return 0, err
+	}
+
+	if query.CreatedFrom != nil && query.CreatedTo != nil {
+		if err = val.ValidateTimeRange(*query.CreatedFrom, *query.CreatedTo); err != nil {
+			return 0, err
+		}
+	}
+	return afterID, nil
+}
+
+// timestamptzArg turns an optional time filter into a query argument, one that isn't set stays invalid
+func timestamptzArg(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, Valid: true}
+}
+
+// int8Arg turns an optional amount filter into a query argument, one that isn't set stays invalid
+func int8Arg(value *int64) pgtype.Int8 {
+	if value == nil {
+		return pgtype.Int8{}
+	}
+	return pgtype.Int8{Int64: *value, Valid: true}
+}
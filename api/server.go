@@ -8,19 +8,21 @@ import (
 	db "goBank/db/sqlc"
 	"goBank/token"
 	"goBank/util"
+	"goBank/worker"
 )
 
 // This server serves up all HTTP requests for our banking service.
 type Server struct {
-	config     util.Config
-	store      db.Store
-	tokenMaker token.Maker
-	router     *gin.Engine
+	config          util.Config
+	store           db.Store
+	tokenMaker      token.Maker
+	router          *gin.Engine
+	taskDistributor worker.TaskDistributor
 }
 
 // NewServer creates a new HTTP server and setup routing.
-func NewServer(config util.Config, store db.Store) (*Server, error) {
-	tokenMaker, err := token.NewJWTMaker(config.TokenSymmetricKey)
+func NewServer(config util.Config, store db.Store, taskDistributor worker.TaskDistributor) (*Server, error) {
+	tokenMaker, err := token.NewMaker(config)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create token maker: %w", err)
 	}
@@ -30,9 +32,10 @@ func NewServer(config util.Config, store db.Store) (*Server, error) {
 	}
 
 	server := &Server{
-		config:     config,
-		store:      store,
-		tokenMaker: tokenMaker,
+		config:          config,
+		store:           store,
+		tokenMaker:      tokenMaker,
+		taskDistributor: taskDistributor,
 	}
 
 	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
@@ -40,6 +43,11 @@ func NewServer(config util.Config, store db.Store) (*Server, error) {
 		if err != nil {
 			return nil, err
 		}
+
+		err = v.RegisterValidation("idempotency_key", validIdempotencyKey)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	server.setupRouter()
@@ -54,6 +62,8 @@ func (server *Server) setupRouter() {
 	router.POST("/users", server.createUser)
 	router.POST("/users/login", server.loginUser)
 	router.POST("/tokens/renew_access", server.renewAccessToken)
+	router.GET("/currencies", server.listCurrencies)
+	router.GET("/.well-known/jwks.json", server.getJWKS)
 
 	//Protecting a group of routes with middleware
 	authRoutes := router.Group("/").Use(authMiddleware(server.tokenMaker))
@@ -61,8 +71,17 @@ func (server *Server) setupRouter() {
 	authRoutes.POST("/accounts", server.createAccount)
 	authRoutes.GET("/accounts/:id", server.getAccount)
 	authRoutes.GET("/accounts", server.listAccounts)
+	authRoutes.GET("/accounts/:id/entries", server.listEntries)
+	authRoutes.GET("/accounts/:id/transfers", server.listTransfers)
+	authRoutes.GET("/accounts/:id/statement", server.getStatement)
+	authRoutes.GET("/accounts/:id/balance", server.getBalanceAt)
+	authRoutes.GET("/accounts/:id/balance_history", server.getBalanceHistory)
 
 	authRoutes.POST("/transfers", server.createTransfer)
+	authRoutes.POST("/transfers/batch", server.createBatchTransfer)
+	authRoutes.GET("/transfers/search", server.searchTransfers)
+
+	authRoutes.POST("/exchange_quotes", server.createExchangeQuote)
 
 	server.router = router
 }
@@ -0,0 +1,71 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	db "goBank/db/sqlc"
+	"goBank/statement"
+	"goBank/val"
+	"net/http"
+	"time"
+)
+
+type getStatementRequest struct {
+	PeriodStart time.Time `form:"period_start" binding:"required"`
+	PeriodEnd   time.Time `form:"period_end" binding:"required"`
+	// json by default, csv and html download the rendered statement
+	Format string `form:"format" binding:"omitempty,oneof=json csv html"`
+}
+
+// getStatement builds an account's statement for any period on demand, unlike the monthly ones it isn't stored
+func (server *Server) getStatement(ctx *gin.Context) {
+	var uri getAccountRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req getStatementRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if err := val.ValidateStatementPeriod(req.PeriodStart, req.PeriodEnd); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, valid := server.ownedAccount(ctx, uri.ID); !valid {
+		return
+	}
+
+	result, err := server.store.StatementTx(ctx, db.StatementTxParams{
+		AccountID:   uri.ID,
+		PeriodStart: req.PeriodStart,
+		PeriodEnd:   req.PeriodEnd,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	var content, contentType string
+	switch req.Format {
+	case "csv":
+		content, err = statement.CSV(result)
+		contentType = "text/csv; charset=utf-8"
+	case "html":
+		content, err = statement.HTML(result)
+		contentType = "text/html; charset=utf-8"
+	default:
+		ctx.JSON(http.StatusOK, result)
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.Header("Content-Disposition", `attachment; filename="`+statement.FileName(result, req.Format)+`"`)
+	ctx.Data(http.StatusOK, contentType, []byte(content))
+}
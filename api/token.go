@@ -4,7 +4,12 @@ import (
 	"errors"
 	"fmt"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
 	db "goBank/db/sqlc"
+	"goBank/token"
+	"goBank/worker"
 	"net/http"
 	"time"
 )
@@ -14,10 +19,15 @@ type renewAccessTokenRequest struct {
 }
 
 type renewAccessTokenResponse struct {
-	AccessToken          string    `json:"access_token"`
-	AccessTokenExpiresAt time.Time `json:"access_token_expires_at"`
+	SessionID             uuid.UUID `json:"session_id"`
+	AccessToken           string    `json:"access_token"`
+	AccessTokenExpiresAt  time.Time `json:"access_token_expires_at"`
+	RefreshToken          string    `json:"refresh_token"`
+	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at"`
 }
 
+// renewAccessToken exchanges the refresh token of a session for a new access token and a new refresh token,
+// the same way the gRPC API does. Presenting a spent refresh token again blocks the whole family of sessions from that login.
 func (server *Server) renewAccessToken(ctx *gin.Context) {
 	var req renewAccessTokenRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
@@ -31,8 +41,6 @@ func (server *Server) renewAccessToken(ctx *gin.Context) {
 		return
 	}
 
-	fmt.Println(refreshPayload)
-
 	session, err := server.store.GetSession(ctx, refreshPayload.ID)
 	if err != nil {
 		if errors.Is(err, db.ErrRecordNotFound) {
@@ -43,12 +51,6 @@ func (server *Server) renewAccessToken(ctx *gin.Context) {
 		return
 	}
 
-	if session.IsBlocked {
-		err := fmt.Errorf("blocked session")
-		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
-		return
-	}
-
 	if session.Username != refreshPayload.Username {
 		err := fmt.Errorf("incorrect session user")
 		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
@@ -61,6 +63,17 @@ func (server *Server) renewAccessToken(ctx *gin.Context) {
 		return
 	}
 
+	if session.IsBlocked {
+		err := fmt.Errorf("blocked session")
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return
+	}
+
+	if session.RotatedAt.Valid {
+		server.refreshTokenReused(ctx, session)
+		return
+	}
+
 	if time.Now().After(session.ExpiresAt) {
 		err := fmt.Errorf("expired session")
 		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
@@ -77,10 +90,88 @@ func (server *Server) renewAccessToken(ctx *gin.Context) {
 		return
 	}
 
+	refreshToken, newRefreshPayload, err := server.tokenMaker.CreateToken(
+		refreshPayload.Username,
+		refreshPayload.Role,
+		time.Until(session.ExpiresAt),
+	)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	newSession, err := server.store.RotateSessionTx(ctx, db.RotateSessionTxParams{
+		Session: session,
+		NewSession: db.CreateSessionParams{
+			ID:           newRefreshPayload.ID,
+			Username:     session.Username,
+			RefreshToken: refreshToken,
+			UserAgent:    ctx.Request.UserAgent(),
+			ClientIp:     ctx.ClientIP(),
+			IsBlocked:    false,
+			ExpiresAt:    newRefreshPayload.ExpiredAt,
+		},
+	})
+	if err != nil {
+		// another renewal with the same refresh token got there first
+		if errors.Is(err, db.ErrSessionRotated) {
+			server.refreshTokenReused(ctx, session)
+			return
+		}
+		// a logout blocked it since it was read
+		if errors.Is(err, db.ErrSessionBlocked) {
+			ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
 	rsp := renewAccessTokenResponse{
-		AccessToken:          accessToken,
-		AccessTokenExpiresAt: accessPayload.ExpiredAt,
+		SessionID:             newSession.ID,
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  accessPayload.ExpiredAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: newRefreshPayload.ExpiredAt,
 	}
 	ctx.JSON(http.StatusOK, rsp)
+}
+
+// refreshTokenReused blocks every session of the login a rotated refresh token belongs to,
+// has the worker email the user about it and answers the request with 401
+func (server *Server) refreshTokenReused(ctx *gin.Context, session db.Session) {
+	_, err := server.store.BlockSessionFamily(ctx, session.FamilyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	//The sessions are blocked either way, a lost notification shouldn't change the answer
+	taskPayload := &worker.PayloadSendSessionReuseAlert{
+		SessionID:  session.ID,
+		UserAgent:  ctx.Request.UserAgent(),
+		ClientIP:   ctx.ClientIP(),
+		DetectedAt: time.Now(),
+	}
+	err = server.taskDistributor.DistributeTaskSendSessionReuseAlert(ctx, taskPayload,
+		asynq.MaxRetry(10), asynq.Queue(worker.QueueCritical))
+	if err != nil {
+		log.Error().Err(err).Str("session_id", session.ID.String()).Msg("failed to enqueue session reuse alert")
+	}
+
+	err = fmt.Errorf("refresh token has already been used")
+	ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+}
+
+// getJWKS publishes the public keys tokens are accepted from so other services can verify them
+func (server *Server) getJWKS(ctx *gin.Context) {
+	publisher, ok := server.tokenMaker.(token.KeyPublisher)
+	if !ok {
+		err := errors.New("tokens aren't signed with a public key")
+		ctx.JSON(http.StatusNotFound, errorResponse(err))
+		return
+	}
 
+	ctx.Header("Cache-Control", "public, max-age=300")
+	ctx.JSON(http.StatusOK, publisher.JWKS())
 }
@@ -0,0 +1,169 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "goBank/db/mock"
+	db "goBank/db/sqlc"
+	"goBank/worker"
+	mockwk "goBank/worker/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRenewAccessTokenAPI(t *testing.T) {
+	user, _ := randomUser(t)
+
+	testCases := []struct {
+		name          string
+		buildSession  func(session *db.Session)
+		buildStubs    func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor, session db.Session)
+		checkResponse func(t *testing.T, session db.Session, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:         "OK",
+			buildSession: func(session *db.Session) {},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor, session db.Session) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(session, nil)
+				store.EXPECT().
+					RotateSessionTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.RotateSessionTxParams) (db.Session, error) {
+						require.Equal(t, session.ID, arg.Session.ID)
+						require.NotEqual(t, session.RefreshToken, arg.NewSession.RefreshToken)
+						return db.Session{
+							ID:                arg.NewSession.ID,
+							Username:          arg.NewSession.Username,
+							RefreshToken:      arg.NewSession.RefreshToken,
+							ExpiresAt:         arg.NewSession.ExpiresAt,
+							FamilyID:          session.FamilyID,
+							PreviousSessionID: pgtype.UUID{Bytes: session.ID, Valid: true},
+						}, nil
+					})
+				store.EXPECT().BlockSessionFamily(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, session db.Session, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var rsp renewAccessTokenResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &rsp))
+				require.NotEmpty(t, rsp.AccessToken)
+				require.NotEqual(t, session.ID, rsp.SessionID)
+				require.NotEqual(t, session.RefreshToken, rsp.RefreshToken)
+				// rotating doesn't extend the login
+				require.WithinDuration(t, session.ExpiresAt, rsp.RefreshTokenExpiresAt, time.Second)
+			},
+		},
+		{
+			name: "ReusedToken",
+			buildSession: func(session *db.Session) {
+				session.RotatedAt = pgtype.Timestamptz{Time: time.Now().Add(-time.Minute), Valid: true}
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor, session db.Session) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(session, nil)
+				store.EXPECT().RotateSessionTx(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().BlockSessionFamily(gomock.Any(), gomock.Eq(session.FamilyID)).Times(1).Return(int64(2), nil)
+				taskDistributor.EXPECT().
+					DistributeTaskSendSessionReuseAlert(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, payload *worker.PayloadSendSessionReuseAlert, opts ...asynq.Option) error {
+						require.Equal(t, session.ID, payload.SessionID)
+						return nil
+					})
+			},
+			checkResponse: func(t *testing.T, session db.Session, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:         "ConcurrentRenewal",
+			buildSession: func(session *db.Session) {},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor, session db.Session) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(session, nil)
+				store.EXPECT().RotateSessionTx(gomock.Any(), gomock.Any()).Times(1).Return(db.Session{}, db.ErrSessionRotated)
+				store.EXPECT().BlockSessionFamily(gomock.Any(), gomock.Eq(session.FamilyID)).Times(1).Return(int64(2), nil)
+				taskDistributor.EXPECT().
+					DistributeTaskSendSessionReuseAlert(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(t *testing.T, session db.Session, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:         "BlockedDuringRenewal",
+			buildSession: func(session *db.Session) {},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor, session db.Session) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(session, nil)
+				store.EXPECT().RotateSessionTx(gomock.Any(), gomock.Any()).Times(1).Return(db.Session{}, db.ErrSessionBlocked)
+				store.EXPECT().BlockSessionFamily(gomock.Any(), gomock.Any()).Times(0)
+				taskDistributor.EXPECT().DistributeTaskSendSessionReuseAlert(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, session db.Session, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "BlockedSession",
+			buildSession: func(session *db.Session) {
+				session.IsBlocked = true
+				session.RotatedAt = pgtype.Timestamptz{Time: time.Now().Add(-time.Minute), Valid: true}
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor, session db.Session) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(session, nil)
+				store.EXPECT().BlockSessionFamily(gomock.Any(), gomock.Any()).Times(0)
+				taskDistributor.EXPECT().DistributeTaskSendSessionReuseAlert(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, session db.Session, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			taskDistributor := mockwk.NewMockTaskDistributor(ctrl)
+
+			server := newTestServer(t, store, taskDistributor)
+			recorder := httptest.NewRecorder()
+
+			refreshToken, refreshPayload, err := server.tokenMaker.CreateToken(user.Username, user.Role, time.Hour)
+			require.NoError(t, err)
+
+			session := db.Session{
+				ID:           refreshPayload.ID,
+				Username:     user.Username,
+				RefreshToken: refreshToken,
+				ExpiresAt:    refreshPayload.ExpiredAt,
+				FamilyID:     refreshPayload.ID,
+			}
+			tc.buildSession(&session)
+			tc.buildStubs(store, taskDistributor, session)
+
+			data, err := json.Marshal(gin.H{"refresh_token": refreshToken})
+			require.NoError(t, err)
+
+			url := "/tokens/renew_access"
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, session, recorder)
+		})
+	}
+}
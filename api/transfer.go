@@ -1,19 +1,60 @@
 package api
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	db "goBank/db/sqlc"
 	"goBank/token"
+	"goBank/util"
+	"goBank/val"
 	"net/http"
+	"time"
 )
 
+const idempotencyKeyHeader = "Idempotency-Key"
+
+type transferHeader struct {
+	IdempotencyKey string `header:"Idempotency-Key" binding:"omitempty,max=255,idempotency_key"`
+}
+
 type transferRequest struct {
 	FromAccountID int64  `json:"from_account_id" binding:"required,min=1"`
 	ToAccountID   int64  `json:"to_account_id" binding:"required,min=1"`
 	Amount        int64  `json:"amount" binding:"required,gt=0"`
 	Currency      string `json:"currency" binding:"required,currency"`
+	// Optional, set for cross-currency transfers at the rate locked by the quote
+	QuoteID string `json:"quote_id" binding:"omitempty,uuid"`
+	// Optional, free text memo searchable through /transfers/search
+	Description string `json:"description" binding:"max=500"`
+	// Optional, the sender's own id for the payment, like an invoice number
+	ExternalReference string `json:"external_reference" binding:"max=100"`
+	// Optional, any JSON object, stored but never read by the bank
+	Metadata json.RawMessage `json:"metadata"`
+}
+
+// details is the memo, reference and metadata the request notes on the transfer
+func (req transferRequest) details() db.TransferDetails {
+	return db.TransferDetails{
+		Description:       req.Description,
+		ExternalReference: req.ExternalReference,
+		Metadata:          req.Metadata,
+	}
+}
+
+// transferResponse spells out how the fee split the transfer
+type transferResponse struct {
+	db.TransferTxResult
+	GrossAmount int64 `json:"gross_amount"`
+	Fee         int64 `json:"fee"`
+	NetAmount   int64 `json:"net_amount"`
+	// The same amounts as decimal strings in the from account's currency
+	GrossAmountDecimal string `json:"gross_amount_decimal"`
+	FeeDecimal         string `json:"fee_decimal"`
+	NetAmountDecimal   string `json:"net_amount_decimal"`
 }
 
 func (server *Server) createTransfer(ctx *gin.Context) {
@@ -25,6 +66,20 @@ func (server *Server) createTransfer(ctx *gin.Context) {
 		return
 	}
 
+	if len(req.Metadata) > 0 {
+		if err := val.ValidateMetadata(req.Metadata); err != nil {
+			ctx.JSON(http.StatusBadRequest, errorResponse(fmt.Errorf("metadata %w", err)))
+			return
+		}
+	}
+
+	//Clients retrying a transfer send the same Idempotency-Key so it only goes through once
+	var header transferHeader
+	if err := ctx.ShouldBindHeader(&header); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
 	fromAccount, valid := server.validAccount(ctx, req.FromAccountID, req.Currency)
 	//Validation account currencies don't match? Just return.
 	if !valid {
@@ -38,29 +93,224 @@ func (server *Server) createTransfer(ctx *gin.Context) {
 		return
 	}
 
-	_, valid = server.validAccount(ctx, req.ToAccountID, req.Currency)
+	//The quote pins the destination currency, so the to account only has to exist
+	if req.QuoteID != "" {
+		_, valid = server.existingAccount(ctx, req.ToAccountID)
+	} else {
+		_, valid = server.validAccount(ctx, req.ToAccountID, req.Currency)
+	}
 	//Validation account currencies don't match? Just return.
 	if !valid {
 		return
 	}
 
-	arg := db.TransferTxParams{
-		FromAccountID: req.FromAccountID,
-		ToAccountID:   req.ToAccountID,
-		Amount:        req.Amount,
+	var quoteID uuid.NullUUID
+	if req.QuoteID != "" {
+		quoteID = uuid.NullUUID{UUID: uuid.MustParse(req.QuoteID), Valid: true}
+	}
+
+	//Large transfers wait for a banker other than the initiator to approve them
+	if server.config.RequiresApproval(req.Currency, req.Amount) {
+		pending, err := server.store.CreatePendingTransferTx(ctx, db.CreatePendingTransferTxParams{
+			FromAccountID:   req.FromAccountID,
+			ToAccountID:     req.ToAccountID,
+			Amount:          req.Amount,
+			TransferDetails: req.details(),
+			QuoteID:         quoteID,
+			Initiator:       authPayload.Username,
+			InitiatorRole:   authPayload.Role,
+			ExpiresAt:       time.Now().Add(server.config.PendingTransferDuration),
+			IdempotencyKey:  header.IdempotencyKey,
+		})
+		if err != nil {
+			transferError(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusAccepted, pending)
+		return
+	}
+
+	var result db.TransferTxResult
+	var err error
+	if quoteID.Valid {
+		result, err = server.store.ExchangeTransferTx(ctx, db.ExchangeTransferTxParams{
+			FromAccountID:   req.FromAccountID,
+			ToAccountID:     req.ToAccountID,
+			Amount:          req.Amount,
+			QuoteID:         quoteID.UUID,
+			TransferDetails: req.details(),
+			Username:        authPayload.Username,
+			Role:            authPayload.Role,
+			IdempotencyKey:  header.IdempotencyKey,
+		})
+	} else {
+		result, err = server.store.TransferTx(ctx, db.TransferTxParams{
+			FromAccountID:   req.FromAccountID,
+			ToAccountID:     req.ToAccountID,
+			Amount:          req.Amount,
+			TransferDetails: req.details(),
+			Role:            authPayload.Role,
+			Username:        authPayload.Username,
+			IdempotencyKey:  header.IdempotencyKey,
+		})
+	}
+
+	if err != nil {
+		transferError(ctx, err)
+		return
+	}
+
+	currency, _ := util.LookupCurrency(result.FromAccount.Currency)
+	rsp := transferResponse{
+		TransferTxResult:   result,
+		GrossAmount:        result.Transfer.Amount,
+		Fee:                result.Transfer.Fee,
+		NetAmount:          result.Transfer.Amount - result.Transfer.Fee,
+		GrossAmountDecimal: currency.FormatAmount(result.Transfer.Amount),
+		FeeDecimal:         currency.FormatAmount(result.Transfer.Fee),
+		NetAmountDecimal:   currency.FormatAmount(result.Transfer.Amount - result.Transfer.Fee),
+	}
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+type listTransfersRequest struct {
+	pageQuery
+	// incoming or outgoing, empty for both
+	Direction string `form:"direction" binding:"omitempty,oneof=incoming outgoing"`
+	MinAmount *int64 `form:"min_amount"`
+	MaxAmount *int64 `form:"max_amount"`
+}
+
+type listTransfersResponse struct {
+	Transfers []db.Transfer `json:"transfers"`
+	// empty on the last page
+	NextPageToken string `json:"next_page_token"`
+}
+
+func (server *Server) listTransfers(ctx *gin.Context) {
+	var uri getAccountRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req listTransfersRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	afterID, err := req.afterID()
+	if err == nil {
+		err = val.ValidateAmountRange(req.MinAmount, req.MaxAmount)
+	}
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, valid := server.ownedAccount(ctx, uri.ID); !valid {
+		return
+	}
+
+	transfers, err := server.store.ListTransfers(ctx, db.ListTransfersParams{
+		AccountID:   uri.ID,
+		Outgoing:    req.Direction != util.IncomingTransfers,
+		Incoming:    req.Direction != util.OutgoingTransfers,
+		AfterID:     afterID,
+		CreatedFrom: timestamptzArg(req.CreatedFrom),
+		CreatedTo:   timestamptzArg(req.CreatedTo),
+		MinAmount:   int8Arg(req.MinAmount),
+		MaxAmount:   int8Arg(req.MaxAmount),
+		Limit:       util.PageLimit(req.PageSize),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	transfers, nextPageToken := util.NextPage(transfers, req.PageSize, func(transfer db.Transfer) int64 { return transfer.ID })
+	ctx.JSON(http.StatusOK, listTransfersResponse{Transfers: transfers, NextPageToken: nextPageToken})
+}
+
+type searchTransfersRequest struct {
+	// Words to look for in descriptions, in web search syntax like "rent -deposit"
+	Query             string `form:"q" binding:"max=200"`
+	ExternalReference string `form:"external_reference" binding:"max=100"`
+	PageToken         string `form:"page_token"`
+	PageSize          int32  `form:"page_size" binding:"required,min=5,max=100"`
+}
+
+// searchTransfers looks through the transfers of every account the user owns, sent or received
+func (server *Server) searchTransfers(ctx *gin.Context) {
+	var req searchTransfersRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if req.Query == "" && req.ExternalReference == "" {
+		err := errors.New("either q or external_reference must be set")
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
 	}
 
-	result, err := server.store.TransferTx(ctx, arg)
+	afterID, err := util.DecodeCursor(req.PageToken)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
 
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	transfers, err := server.store.SearchTransfers(ctx, db.SearchTransfersParams{
+		Owner:             authPayload.Username,
+		Query:             pgtype.Text{String: req.Query, Valid: req.Query != ""},
+		ExternalReference: pgtype.Text{String: req.ExternalReference, Valid: req.ExternalReference != ""},
+		AfterID:           afterID,
+		Limit:             util.PageLimit(req.PageSize),
+	})
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
-	ctx.JSON(http.StatusOK, result)
+	transfers, nextPageToken := util.NextPage(transfers, req.PageSize, func(transfer db.Transfer) int64 { return transfer.ID })
+	ctx.JSON(http.StatusOK, listTransfersResponse{Transfers: transfers, NextPageToken: nextPageToken})
 }
 
-func (server *Server) validAccount(ctx *gin.Context, accountID int64, currency string) (db.Account, bool) {
+// transferError writes the response for an error returned by the store's transfer transactions
+func transferError(ctx *gin.Context, err error) {
+	if errors.Is(err, db.ErrInsufficientFunds) || errors.Is(err, db.ErrQuoteExpired) ||
+		errors.Is(err, db.ErrQuoteUsed) || errors.Is(err, db.ErrQuoteMismatch) ||
+		errors.Is(err, db.ErrAmountTooSmall) || errors.Is(err, db.ErrAmountOverflow) ||
+		errors.Is(err, db.ErrAccountFrozen) || errors.Is(err, db.ErrAccountClosed) ||
+		errors.Is(err, db.ErrFeeExceedsAmount) || errors.Is(err, db.ErrBatchCurrencyMismatch) ||
+		errors.Is(err, db.ErrBatchSelfTransfer) {
+		ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+		return
+	}
+
+	if errors.Is(err, db.ErrTransferAmountLimit) || errors.Is(err, db.ErrDailyAmountLimit) ||
+		errors.Is(err, db.ErrDailyCountLimit) {
+		ctx.JSON(http.StatusTooManyRequests, errorResponse(err))
+		return
+	}
+
+	if errors.Is(err, db.ErrIdempotencyKeyConflict) {
+		ctx.JSON(http.StatusConflict, errorResponse(err))
+		return
+	}
+
+	if errors.Is(err, db.ErrRecordNotFound) {
+		ctx.JSON(http.StatusNotFound, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+}
+
+func (server *Server) existingAccount(ctx *gin.Context, accountID int64) (db.Account, bool) {
 	account, err := server.store.GetAccount(ctx, accountID)
 
 	//Problems with the query
@@ -74,6 +324,15 @@ func (server *Server) validAccount(ctx *gin.Context, accountID int64, currency s
 		return account, false
 	}
 
+	return account, true
+}
+
+func (server *Server) validAccount(ctx *gin.Context, accountID int64, currency string) (db.Account, bool) {
+	account, valid := server.existingAccount(ctx, accountID)
+	if !valid {
+		return account, false
+	}
+
 	//Currencies don't end up matching
 	if account.Currency != currency {
 		err := fmt.Errorf("account [%d] currency mismatch: %s vs %s", account.ID, account.Currency, currency)
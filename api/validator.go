@@ -12,3 +12,11 @@ var validCurrency validator.Func = func(fieldLevel validator.FieldLevel) bool {
 	}
 	return false
 }
+
+var validIdempotencyKey validator.Func = func(fieldLevel validator.FieldLevel) bool {
+	if key, ok := fieldLevel.Field().Interface().(string); ok {
+		// the bank's own keys can't be claimed by a client
+		return !util.IsSystemIdempotencyKey(key)
+	}
+	return false
+}
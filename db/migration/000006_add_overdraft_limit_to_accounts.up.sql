@@ -0,0 +1,4 @@
+ALTER TABLE "accounts"
+    ADD COLUMN "overdraft_limit" bigint NOT NULL DEFAULT 0 CHECK ("overdraft_limit" >= 0);
+
+COMMENT ON COLUMN "accounts"."overdraft_limit" IS 'how far below zero the balance may go';
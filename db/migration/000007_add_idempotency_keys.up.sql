@@ -0,0 +1,20 @@
+CREATE TABLE "idempotency_keys"
+(
+    "username"     varchar     NOT NULL,
+    "key"          varchar     NOT NULL,
+    "request_hash" varchar     NOT NULL,
+    "transfer_id"  bigint,
+    "response"     jsonb,
+    "created_at"   timestamptz NOT NULL DEFAULT (now()),
+    PRIMARY KEY ("username", "key")
+);
+
+ALTER TABLE "idempotency_keys"
+    ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "idempotency_keys"
+    ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+COMMENT ON COLUMN "idempotency_keys"."username" IS 'keys are chosen by clients, so they''re only unique per user';
+
+COMMENT ON COLUMN "idempotency_keys"."request_hash" IS 'hash of the request the key was first used with';
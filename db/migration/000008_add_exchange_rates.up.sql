@@ -0,0 +1,45 @@
+CREATE TABLE "exchange_rates"
+(
+    "id"            bigserial PRIMARY KEY,
+    "from_currency" varchar     NOT NULL,
+    "to_currency"   varchar     NOT NULL,
+    "rate"          bigint      NOT NULL CHECK ("rate" > 0),
+    "created_at"    timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "exchange_rates" ("from_currency", "to_currency", "created_at");
+
+COMMENT ON COLUMN "exchange_rates"."rate" IS 'to_currency per one from_currency, scaled by 10^8';
+
+CREATE TABLE "exchange_quotes"
+(
+    "id"            uuid PRIMARY KEY,
+    "username"      varchar     NOT NULL,
+    "from_currency" varchar     NOT NULL,
+    "to_currency"   varchar     NOT NULL,
+    "rate"          bigint      NOT NULL,
+    "is_used"       bool        NOT NULL DEFAULT false,
+    "expires_at"    timestamptz NOT NULL,
+    "created_at"    timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "exchange_quotes"
+    ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+COMMENT ON COLUMN "exchange_quotes"."rate" IS 'to_currency per one from_currency, scaled by 10^8';
+
+ALTER TABLE "transfers"
+    ADD COLUMN "to_amount" bigint;
+
+UPDATE "transfers"
+SET "to_amount" = "amount";
+
+ALTER TABLE "transfers"
+    ALTER COLUMN "to_amount" SET NOT NULL;
+
+ALTER TABLE "transfers"
+    ADD COLUMN "exchange_rate" bigint NOT NULL DEFAULT 100000000;
+
+COMMENT ON COLUMN "transfers"."to_amount" IS 'amount credited in the to account''s currency';
+
+COMMENT ON COLUMN "transfers"."exchange_rate" IS 'applied rate scaled by 10^8, 100000000 when both accounts share a currency';
@@ -0,0 +1,29 @@
+ALTER TABLE "accounts"
+    ADD COLUMN "held_balance" bigint NOT NULL DEFAULT 0 CHECK ("held_balance" >= 0);
+
+COMMENT ON COLUMN "accounts"."held_balance" IS 'sum of authorized holds, available balance is balance - held_balance';
+
+CREATE TABLE "holds"
+(
+    "id"              bigserial PRIMARY KEY,
+    "account_id"      bigint      NOT NULL,
+    "amount"          bigint      NOT NULL CHECK ("amount" > 0),
+    "captured_amount" bigint      NOT NULL DEFAULT 0,
+    "transfer_id"     bigint,
+    "status"          varchar     NOT NULL DEFAULT 'authorized',
+    "expires_at"      timestamptz NOT NULL,
+    "created_at"      timestamptz NOT NULL DEFAULT (now()),
+    "updated_at"      timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "holds"
+    ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "holds"
+    ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+CREATE INDEX ON "holds" ("account_id");
+
+CREATE INDEX ON "holds" ("status", "expires_at");
+
+COMMENT ON COLUMN "holds"."status" IS 'authorized, captured, voided or expired';
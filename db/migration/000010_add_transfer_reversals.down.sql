@@ -0,0 +1,3 @@
+ALTER TABLE "transfers" DROP COLUMN "reversed_amount";
+
+ALTER TABLE "transfers" DROP COLUMN "reversal_of";
@@ -0,0 +1,14 @@
+ALTER TABLE "transfers"
+    ADD COLUMN "reversal_of" bigint;
+
+ALTER TABLE "transfers"
+    ADD FOREIGN KEY ("reversal_of") REFERENCES "transfers" ("id");
+
+ALTER TABLE "transfers"
+    ADD COLUMN "reversed_amount" bigint NOT NULL DEFAULT 0 CHECK ("reversed_amount" >= 0);
+
+CREATE INDEX ON "transfers" ("reversal_of");
+
+COMMENT ON COLUMN "transfers"."reversal_of" IS 'the original transfer when this one refunds it';
+
+COMMENT ON COLUMN "transfers"."reversed_amount" IS 'how much of to_amount has been refunded so far';
@@ -0,0 +1,52 @@
+CREATE TABLE "standing_orders"
+(
+    "id"              bigserial PRIMARY KEY,
+    "owner"           varchar     NOT NULL,
+    "from_account_id" bigint      NOT NULL,
+    "to_account_id"   bigint      NOT NULL,
+    "amount"          bigint      NOT NULL CHECK ("amount" > 0),
+    "schedule"        varchar     NOT NULL,
+    "next_run_at"     timestamptz NOT NULL,
+    "end_at"          timestamptz,
+    "is_active"       bool        NOT NULL DEFAULT true,
+    "created_at"      timestamptz NOT NULL DEFAULT (now()),
+    "updated_at"      timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "standing_orders"
+    ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
+
+ALTER TABLE "standing_orders"
+    ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "standing_orders"
+    ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+CREATE INDEX ON "standing_orders" ("owner");
+
+CREATE INDEX ON "standing_orders" ("is_active", "next_run_at");
+
+COMMENT ON COLUMN "standing_orders"."schedule" IS 'cron expression or @every interval';
+
+COMMENT ON COLUMN "standing_orders"."end_at" IS 'no runs are scheduled after this time, null runs forever';
+
+CREATE TABLE "standing_order_runs"
+(
+    "id"                bigserial PRIMARY KEY,
+    "standing_order_id" bigint      NOT NULL,
+    "scheduled_at"      timestamptz NOT NULL,
+    "transfer_id"       bigint,
+    "status"            varchar     NOT NULL,
+    "error"             varchar     NOT NULL DEFAULT '',
+    "created_at"        timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "standing_order_runs"
+    ADD FOREIGN KEY ("standing_order_id") REFERENCES "standing_orders" ("id");
+
+ALTER TABLE "standing_order_runs"
+    ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+CREATE UNIQUE INDEX ON "standing_order_runs" ("standing_order_id", "scheduled_at");
+
+COMMENT ON COLUMN "standing_order_runs"."status" IS 'succeeded or failed';
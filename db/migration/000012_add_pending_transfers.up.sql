@@ -0,0 +1,40 @@
+CREATE TABLE "pending_transfers"
+(
+    "id"              bigserial PRIMARY KEY,
+    "from_account_id" bigint      NOT NULL,
+    "to_account_id"   bigint      NOT NULL,
+    "amount"          bigint      NOT NULL CHECK ("amount" > 0),
+    "to_amount"       bigint      NOT NULL,
+    "exchange_rate"   bigint      NOT NULL DEFAULT 100000000,
+    "initiator"       varchar     NOT NULL,
+    "reviewer"        varchar,
+    "status"          varchar     NOT NULL DEFAULT 'pending',
+    "reason"          varchar     NOT NULL DEFAULT '',
+    "transfer_id"     bigint,
+    "expires_at"      timestamptz NOT NULL,
+    "created_at"      timestamptz NOT NULL DEFAULT (now()),
+    "updated_at"      timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "pending_transfers"
+    ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "pending_transfers"
+    ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "pending_transfers"
+    ADD FOREIGN KEY ("initiator") REFERENCES "users" ("username");
+
+ALTER TABLE "pending_transfers"
+    ADD FOREIGN KEY ("reviewer") REFERENCES "users" ("username");
+
+ALTER TABLE "pending_transfers"
+    ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+CREATE INDEX ON "pending_transfers" ("status", "expires_at");
+
+CREATE INDEX ON "pending_transfers" ("initiator");
+
+COMMENT ON COLUMN "pending_transfers"."status" IS 'pending, approved, rejected or expired';
+
+COMMENT ON COLUMN "pending_transfers"."reviewer" IS 'the banker who approved or rejected it, never the initiator';
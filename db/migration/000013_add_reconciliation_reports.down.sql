@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "reconciliation_discrepancies";
+
+DROP TABLE IF EXISTS "reconciliation_reports";
+
+ALTER TABLE "entries" DROP COLUMN "transfer_id";
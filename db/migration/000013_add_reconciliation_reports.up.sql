@@ -0,0 +1,65 @@
+ALTER TABLE "entries"
+    ADD COLUMN "transfer_id" bigint;
+
+ALTER TABLE "entries"
+    ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+CREATE INDEX ON "entries" ("transfer_id");
+
+-- A transfer and its two entries are written in one transaction, so they share its now()
+UPDATE "entries" e
+SET "transfer_id" = t."id"
+FROM "transfers" t
+WHERE e."created_at" = t."created_at"
+  AND ((e."account_id" = t."from_account_id" AND e."amount" = -t."amount")
+    OR (e."account_id" = t."to_account_id" AND e."amount" = t."to_amount"));
+
+COMMENT ON COLUMN "entries"."transfer_id" IS 'the transfer that posted this entry';
+
+CREATE TABLE "reconciliation_reports"
+(
+    "id"                bigserial PRIMARY KEY,
+    "triggered_by"      varchar,
+    "status"            varchar     NOT NULL DEFAULT 'running',
+    "accounts_checked"  bigint      NOT NULL DEFAULT 0,
+    "transfers_checked" bigint      NOT NULL DEFAULT 0,
+    "discrepancy_count" bigint      NOT NULL DEFAULT 0,
+    "created_at"        timestamptz NOT NULL DEFAULT (now()),
+    "completed_at"      timestamptz
+);
+
+CREATE TABLE "reconciliation_discrepancies"
+(
+    "id"          bigserial PRIMARY KEY,
+    "report_id"   bigint      NOT NULL,
+    "kind"        varchar     NOT NULL,
+    "account_id"  bigint,
+    "transfer_id" bigint,
+    "expected"    bigint      NOT NULL,
+    "actual"      bigint      NOT NULL,
+    "created_at"  timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "reconciliation_reports"
+    ADD FOREIGN KEY ("triggered_by") REFERENCES "users" ("username");
+
+ALTER TABLE "reconciliation_discrepancies"
+    ADD FOREIGN KEY ("report_id") REFERENCES "reconciliation_reports" ("id");
+
+ALTER TABLE "reconciliation_discrepancies"
+    ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "reconciliation_discrepancies"
+    ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+CREATE INDEX ON "reconciliation_discrepancies" ("report_id");
+
+COMMENT ON COLUMN "reconciliation_reports"."triggered_by" IS 'the banker who asked for it, null for scheduled runs';
+
+COMMENT ON COLUMN "reconciliation_reports"."status" IS 'running or completed';
+
+COMMENT ON COLUMN "reconciliation_discrepancies"."kind" IS 'balance when an account''s balance isn''t the sum of its entries, entries when a transfer doesn''t have exactly its two entries';
+
+COMMENT ON COLUMN "reconciliation_discrepancies"."expected" IS 'sum of the entries for balance, 2 for entries';
+
+COMMENT ON COLUMN "reconciliation_discrepancies"."actual" IS 'the account balance for balance, the entries pointing at the transfer for entries';
@@ -0,0 +1,30 @@
+ALTER TABLE "accounts"
+    ADD COLUMN "status" varchar NOT NULL DEFAULT 'active';
+
+COMMENT ON COLUMN "accounts"."status" IS 'active, frozen or closed';
+
+-- A closed account shouldn't stop its owner from opening a new one in the same currency
+ALTER TABLE "accounts" DROP CONSTRAINT IF EXISTS "owner_currency_key";
+
+CREATE UNIQUE INDEX "owner_currency_key" ON "accounts" ("owner", "currency") WHERE "status" <> 'closed';
+
+CREATE TABLE "account_status_changes"
+(
+    "id"          bigserial PRIMARY KEY,
+    "account_id"  bigint      NOT NULL,
+    "from_status" varchar     NOT NULL,
+    "to_status"   varchar     NOT NULL,
+    "changed_by"  varchar     NOT NULL,
+    "reason"      varchar     NOT NULL,
+    "created_at"  timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "account_status_changes"
+    ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "account_status_changes"
+    ADD FOREIGN KEY ("changed_by") REFERENCES "users" ("username");
+
+CREATE INDEX ON "account_status_changes" ("account_id");
+
+COMMENT ON COLUMN "account_status_changes"."changed_by" IS 'the banker who changed the status';
@@ -0,0 +1,54 @@
+DROP TABLE IF EXISTS "fee_schedules";
+
+-- Deleting the entries of a transfer with the bank, or the fee entry of a transfer between customers,
+-- would leave the customer balances they moved with nothing in the ledger behind them, so undo refuses to lose them
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM "transfers"
+        WHERE "fee" > 0
+           OR "from_account_id" IN (SELECT "id" FROM "accounts" WHERE "owner" = 'bank')
+           OR "to_account_id" IN (SELECT "id" FROM "accounts" WHERE "owner" = 'bank')
+    ) THEN
+        RAISE EXCEPTION 'cannot undo fees and system accounts: money has moved through the bank''s accounts';
+    END IF;
+END
+$$;
+
+-- Nothing has moved money through the bank's own accounts, whatever else references them goes with them
+DELETE FROM "holds" WHERE "account_id" IN (SELECT "id" FROM "accounts" WHERE "owner" = 'bank');
+
+DELETE FROM "standing_order_runs"
+WHERE "standing_order_id" IN (
+    SELECT "id" FROM "standing_orders"
+    WHERE "from_account_id" IN (SELECT "id" FROM "accounts" WHERE "owner" = 'bank')
+       OR "to_account_id" IN (SELECT "id" FROM "accounts" WHERE "owner" = 'bank')
+);
+
+DELETE FROM "standing_orders"
+WHERE "from_account_id" IN (SELECT "id" FROM "accounts" WHERE "owner" = 'bank')
+   OR "to_account_id" IN (SELECT "id" FROM "accounts" WHERE "owner" = 'bank');
+
+DELETE FROM "pending_transfers"
+WHERE "from_account_id" IN (SELECT "id" FROM "accounts" WHERE "owner" = 'bank')
+   OR "to_account_id" IN (SELECT "id" FROM "accounts" WHERE "owner" = 'bank');
+
+DELETE FROM "reconciliation_discrepancies" WHERE "account_id" IN (SELECT "id" FROM "accounts" WHERE "owner" = 'bank');
+
+DELETE FROM "account_status_changes" WHERE "account_id" IN (SELECT "id" FROM "accounts" WHERE "owner" = 'bank');
+
+DELETE FROM "accounts" WHERE "owner" = 'bank';
+
+ALTER TABLE "transfers" DROP COLUMN "fee";
+
+DROP INDEX IF EXISTS "kind_currency_key";
+
+DROP INDEX IF EXISTS "owner_currency_key";
+
+CREATE UNIQUE INDEX "owner_currency_key" ON "accounts" ("owner", "currency") WHERE "status" <> 'closed';
+
+ALTER TABLE "accounts" DROP COLUMN "kind";
+
+DELETE FROM "users" WHERE "username" = 'bank';
+
+COMMENT ON COLUMN "users"."role" IS NULL;
@@ -0,0 +1,40 @@
+-- Owns the bank's own ledger accounts, it can't log in since no password hashes to an empty string
+INSERT INTO "users" ("username", "hashed_password", "full_name", "email", "role")
+VALUES ('bank', '', 'Bank', 'bank@system.invalid', 'system');
+
+COMMENT ON COLUMN "users"."role" IS 'depositor, banker, or system for the bank user that owns its own ledger accounts';
+
+ALTER TABLE "accounts"
+    ADD COLUMN "kind" varchar NOT NULL DEFAULT 'customer';
+
+COMMENT ON COLUMN "accounts"."kind" IS 'customer, or the purpose of an account the bank owns';
+
+-- The bank owns one account of each kind per currency, customers one per currency
+DROP INDEX IF EXISTS "owner_currency_key";
+
+CREATE UNIQUE INDEX "owner_currency_key" ON "accounts" ("owner", "currency") WHERE "status" <> 'closed' AND "kind" = 'customer';
+
+CREATE UNIQUE INDEX "kind_currency_key" ON "accounts" ("kind", "currency") WHERE "kind" <> 'customer';
+
+ALTER TABLE "transfers"
+    ADD COLUMN "fee" bigint NOT NULL DEFAULT 0 CHECK ("fee" >= 0);
+
+COMMENT ON COLUMN "transfers"."fee" IS 'taken out of amount and credited to the bank''s revenue account';
+
+CREATE TABLE "fee_schedules"
+(
+    "id"           bigserial PRIMARY KEY,
+    "currency"     varchar     NOT NULL,
+    "min_amount"   bigint      NOT NULL CHECK ("min_amount" >= 0),
+    "flat_fee"     bigint      NOT NULL DEFAULT 0 CHECK ("flat_fee" >= 0),
+    "basis_points" bigint      NOT NULL DEFAULT 0 CHECK ("basis_points" >= 0 AND "basis_points" <= 10000),
+    "created_at"   timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX ON "fee_schedules" ("currency", "min_amount");
+
+COMMENT ON COLUMN "fee_schedules"."min_amount" IS 'the tier applies from this amount up to the next tier''s min_amount';
+
+COMMENT ON COLUMN "fee_schedules"."basis_points" IS 'percentage of the amount in hundredths of a percent, added to flat_fee';
+
+COMMENT ON COLUMN "reconciliation_discrepancies"."expected" IS 'sum of the entries for balance, 2 for entries or 3 when the transfer charged a fee';
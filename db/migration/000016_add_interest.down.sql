@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "interest_accruals";
+
+DROP TABLE IF EXISTS "interest_rates";
+
+ALTER TABLE "accounts" DROP COLUMN "product";
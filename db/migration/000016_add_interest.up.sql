@@ -0,0 +1,45 @@
+ALTER TABLE "accounts"
+    ADD COLUMN "product" varchar NOT NULL DEFAULT 'checking';
+
+COMMENT ON COLUMN "accounts"."product" IS 'checking or savings, picks the interest rate together with currency';
+
+CREATE TABLE "interest_rates"
+(
+    "id"           bigserial PRIMARY KEY,
+    "product"      varchar     NOT NULL,
+    "currency"     varchar     NOT NULL,
+    "basis_points" bigint      NOT NULL CHECK ("basis_points" >= 0 AND "basis_points" <= 10000),
+    "updated_at"   timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX ON "interest_rates" ("product", "currency");
+
+COMMENT ON COLUMN "interest_rates"."basis_points" IS 'yearly rate in hundredths of a percent';
+
+CREATE TABLE "interest_accruals"
+(
+    "id"           bigserial PRIMARY KEY,
+    "account_id"   bigint      NOT NULL,
+    "accrual_date" date        NOT NULL,
+    "balance"      bigint      NOT NULL,
+    "basis_points" bigint      NOT NULL,
+    "amount"       bigint      NOT NULL,
+    "transfer_id"  bigint,
+    "created_at"   timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "interest_accruals"
+    ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "interest_accruals"
+    ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+CREATE UNIQUE INDEX ON "interest_accruals" ("account_id", "accrual_date");
+
+CREATE INDEX ON "interest_accruals" ("account_id") WHERE "transfer_id" IS NULL;
+
+COMMENT ON COLUMN "interest_accruals"."balance" IS 'the account''s balance at the end of accrual_date';
+
+COMMENT ON COLUMN "interest_accruals"."amount" IS 'one day of interest in millionths of the currency''s minor unit';
+
+COMMENT ON COLUMN "interest_accruals"."transfer_id" IS 'the monthly transfer that paid it, null until then';
@@ -0,0 +1,7 @@
+ALTER TABLE "pending_transfers" DROP COLUMN "initiator_role";
+
+DROP INDEX IF EXISTS "transfers_from_account_id_created_at_idx";
+
+DROP TABLE IF EXISTS "account_transfer_limits";
+
+DROP TABLE IF EXISTS "role_transfer_limits";
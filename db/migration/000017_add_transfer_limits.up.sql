@@ -0,0 +1,51 @@
+CREATE TABLE "role_transfer_limits"
+(
+    "id"           bigserial PRIMARY KEY,
+    "role"         varchar     NOT NULL,
+    "currency"     varchar     NOT NULL,
+    "max_amount"   bigint CHECK ("max_amount" >= 0),
+    "daily_amount" bigint CHECK ("daily_amount" >= 0),
+    "daily_count"  bigint CHECK ("daily_count" >= 0),
+    "updated_at"   timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX ON "role_transfer_limits" ("role", "currency");
+
+COMMENT ON COLUMN "role_transfer_limits"."max_amount" IS 'largest single transfer, null for no limit';
+
+COMMENT ON COLUMN "role_transfer_limits"."daily_amount" IS 'most sent in total per UTC day, null for no limit';
+
+COMMENT ON COLUMN "role_transfer_limits"."daily_count" IS 'most transfers sent per UTC day, null for no limit';
+
+CREATE TABLE "account_transfer_limits"
+(
+    "account_id"   bigint PRIMARY KEY,
+    "max_amount"   bigint CHECK ("max_amount" >= 0),
+    "daily_amount" bigint CHECK ("daily_amount" >= 0),
+    "daily_count"  bigint CHECK ("daily_count" >= 0),
+    "updated_by"   varchar     NOT NULL,
+    "updated_at"   timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "account_transfer_limits"
+    ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "account_transfer_limits"
+    ADD FOREIGN KEY ("updated_by") REFERENCES "users" ("username");
+
+COMMENT ON TABLE "account_transfer_limits" IS 'overrides the owner''s role limits for one account, a null column keeps the role''s';
+
+-- Today's usage sums the account's outgoing transfers since midnight
+CREATE INDEX ON "transfers" ("from_account_id", "created_at");
+
+-- A large transfer is approved under the limits of the role that initiated it
+ALTER TABLE "pending_transfers"
+    ADD COLUMN "initiator_role" varchar;
+
+UPDATE "pending_transfers"
+SET "initiator_role" = "users"."role"
+FROM "users"
+WHERE "users"."username" = "pending_transfers"."initiator";
+
+ALTER TABLE "pending_transfers"
+    ALTER COLUMN "initiator_role" SET NOT NULL;
@@ -0,0 +1,8 @@
+-- Lists page through an owner's accounts and an account's entries and transfers by id
+CREATE INDEX ON "accounts" ("owner", "id");
+
+CREATE INDEX ON "entries" ("account_id", "id");
+
+CREATE INDEX ON "transfers" ("from_account_id", "id");
+
+CREATE INDEX ON "transfers" ("to_account_id", "id");
@@ -0,0 +1,15 @@
+ALTER TABLE "pending_transfers" DROP COLUMN IF EXISTS "metadata";
+
+ALTER TABLE "pending_transfers" DROP COLUMN IF EXISTS "external_reference";
+
+ALTER TABLE "pending_transfers" DROP COLUMN IF EXISTS "description";
+
+DROP INDEX IF EXISTS "transfers_external_reference_idx";
+
+DROP INDEX IF EXISTS "transfers_to_tsvector_idx";
+
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "metadata";
+
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "external_reference";
+
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "description";
@@ -0,0 +1,29 @@
+ALTER TABLE "transfers"
+    ADD COLUMN "description" varchar NOT NULL DEFAULT '';
+
+ALTER TABLE "transfers"
+    ADD COLUMN "external_reference" varchar NOT NULL DEFAULT '';
+
+ALTER TABLE "transfers"
+    ADD COLUMN "metadata" jsonb NOT NULL DEFAULT '{}';
+
+COMMENT ON COLUMN "transfers"."description" IS 'free text memo from the sender, searchable';
+
+COMMENT ON COLUMN "transfers"."external_reference" IS 'the sender''s own id for the payment, like an invoice number';
+
+COMMENT ON COLUMN "transfers"."metadata" IS 'free-form JSON object the bank stores but never reads';
+
+-- Searching a user's history matches the description with full-text search and the reference exactly
+CREATE INDEX ON "transfers" USING GIN (to_tsvector('english', "description"));
+
+CREATE INDEX ON "transfers" ("external_reference") WHERE "external_reference" <> '';
+
+-- A transfer waiting for approval keeps its details until it's created
+ALTER TABLE "pending_transfers"
+    ADD COLUMN "description" varchar NOT NULL DEFAULT '';
+
+ALTER TABLE "pending_transfers"
+    ADD COLUMN "external_reference" varchar NOT NULL DEFAULT '';
+
+ALTER TABLE "pending_transfers"
+    ADD COLUMN "metadata" jsonb NOT NULL DEFAULT '{}';
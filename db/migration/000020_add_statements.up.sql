@@ -0,0 +1,33 @@
+CREATE TABLE "statements"
+(
+    "id"              bigserial PRIMARY KEY,
+    "account_id"      bigint      NOT NULL,
+    "period_start"    timestamptz NOT NULL,
+    "period_end"      timestamptz NOT NULL,
+    "opening_balance" bigint      NOT NULL,
+    "closing_balance" bigint      NOT NULL,
+    "total_credits"   bigint      NOT NULL,
+    "total_debits"    bigint      NOT NULL,
+    "entry_count"     bigint      NOT NULL,
+    "csv"             text        NOT NULL,
+    "html"            text        NOT NULL,
+    "emailed_at"      timestamptz,
+    "created_at"      timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON COLUMN "statements"."period_end" IS 'exclusive, entries posted at period_end belong to the next statement';
+
+COMMENT ON COLUMN "statements"."total_debits" IS 'sum of the negative entries, stored as a positive number';
+
+COMMENT ON COLUMN "statements"."html" IS 'printable form of the statement, a browser prints it to PDF';
+
+COMMENT ON COLUMN "statements"."emailed_at" IS 'when it was sent to the owner, null until then';
+
+ALTER TABLE "statements"
+    ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+-- One statement per account and period, so a retried monthly run doesn't send it twice
+CREATE UNIQUE INDEX ON "statements" ("account_id", "period_start", "period_end");
+
+-- Opening and closing balances subtract the entries posted after a point in time
+CREATE INDEX ON "entries" ("account_id", "created_at");
@@ -0,0 +1,16 @@
+CREATE TABLE "balance_snapshots"
+(
+    "id"            bigserial PRIMARY KEY,
+    "account_id"    bigint      NOT NULL,
+    "snapshot_date" date        NOT NULL,
+    "balance"       bigint      NOT NULL,
+    "created_at"    timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON COLUMN "balance_snapshots"."balance" IS 'the account''s balance at the end of snapshot_date in UTC';
+
+ALTER TABLE "balance_snapshots"
+    ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+-- One snapshot per account and day, a balance at a point in time starts from the latest one before it
+CREATE UNIQUE INDEX ON "balance_snapshots" ("account_id", "snapshot_date");
@@ -0,0 +1,66 @@
+CREATE TABLE "deposits"
+(
+    "id"           bigserial PRIMARY KEY,
+    "account_id"   bigint      NOT NULL,
+    "amount"       bigint      NOT NULL CHECK ("amount" > 0),
+    "transfer_id"  bigint      NOT NULL,
+    "deposited_by" varchar     NOT NULL,
+    "reference"    varchar     NOT NULL DEFAULT '',
+    "created_at"   timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "deposits"
+    ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "deposits"
+    ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "deposits"
+    ADD FOREIGN KEY ("deposited_by") REFERENCES "users" ("username");
+
+CREATE INDEX ON "deposits" ("account_id");
+
+COMMENT ON COLUMN "deposits"."transfer_id" IS 'the transfer from the bank''s cash account in the currency';
+
+COMMENT ON COLUMN "deposits"."deposited_by" IS 'the banker who took in the funds';
+
+COMMENT ON COLUMN "deposits"."reference" IS 'the receipt or slip number the funds came in with';
+
+CREATE TABLE "withdrawals"
+(
+    "id"                     bigserial PRIMARY KEY,
+    "account_id"             bigint      NOT NULL,
+    "amount"                 bigint      NOT NULL CHECK ("amount" > 0),
+    "status"                 varchar     NOT NULL DEFAULT 'pending',
+    "requested_by"           varchar     NOT NULL,
+    "transfer_id"            bigint      NOT NULL,
+    "settlement_transfer_id" bigint,
+    "processor_reference"    varchar     NOT NULL DEFAULT '',
+    "failure_reason"         varchar     NOT NULL DEFAULT '',
+    "created_at"             timestamptz NOT NULL DEFAULT (now()),
+    "updated_at"             timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "withdrawals"
+    ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "withdrawals"
+    ADD FOREIGN KEY ("requested_by") REFERENCES "users" ("username");
+
+ALTER TABLE "withdrawals"
+    ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "withdrawals"
+    ADD FOREIGN KEY ("settlement_transfer_id") REFERENCES "transfers" ("id");
+
+CREATE INDEX ON "withdrawals" ("account_id");
+
+CREATE INDEX ON "withdrawals" ("status");
+
+COMMENT ON COLUMN "withdrawals"."status" IS 'pending until the processor settles it, then settled or failed';
+
+COMMENT ON COLUMN "withdrawals"."transfer_id" IS 'the transfer into the bank''s clearing account that holds the funds while pending';
+
+COMMENT ON COLUMN "withdrawals"."settlement_transfer_id" IS 'from clearing to cash once settled, back to the account once failed';
+
+COMMENT ON COLUMN "withdrawals"."processor_reference" IS 'the processor''s id for the payout';
@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS "sessions_family_id_idx";
+
+ALTER TABLE "sessions"
+    DROP COLUMN IF EXISTS "rotated_at";
+
+ALTER TABLE "sessions"
+    DROP COLUMN IF EXISTS "previous_session_id";
+
+ALTER TABLE "sessions"
+    DROP COLUMN IF EXISTS "family_id";
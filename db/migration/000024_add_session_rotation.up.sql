@@ -0,0 +1,25 @@
+ALTER TABLE "sessions"
+    ADD COLUMN "family_id" uuid;
+
+UPDATE "sessions"
+SET "family_id" = "id";
+
+ALTER TABLE "sessions"
+    ALTER COLUMN "family_id" SET NOT NULL;
+
+ALTER TABLE "sessions"
+    ADD COLUMN "previous_session_id" uuid;
+
+ALTER TABLE "sessions"
+    ADD COLUMN "rotated_at" timestamptz;
+
+ALTER TABLE "sessions"
+    ADD FOREIGN KEY ("previous_session_id") REFERENCES "sessions" ("id");
+
+CREATE INDEX ON "sessions" ("family_id");
+
+COMMENT ON COLUMN "sessions"."family_id" IS 'the session created at login, every session its refresh token was rotated into shares it';
+
+COMMENT ON COLUMN "sessions"."previous_session_id" IS 'the session whose refresh token was exchanged for this one';
+
+COMMENT ON COLUMN "sessions"."rotated_at" IS 'when its refresh token was exchanged for a new one, presenting it again after that means it was stolen';
@@ -1,6 +1,6 @@
 -- name: CreateAccount :one
-INSERT INTO accounts (owner, balance, currency)
-VALUES ($1, $2, $3)
+INSERT INTO accounts (owner, balance, currency, product)
+VALUES ($1, $2, $3, COALESCE(sqlc.narg(product)::varchar, 'checking'))
 RETURNING *;
 
 -- name: GetAccount :one
@@ -13,12 +13,31 @@ SELECT * FROM accounts
 WHERE id = $1 LIMIT 1
 FOR NO KEY UPDATE;
 
+-- Only run at startup, the no-op update makes the upsert return the row when it already exists
+-- name: CreateSystemAccount :one
+INSERT INTO accounts (owner, balance, currency, kind)
+VALUES ('bank', 0, sqlc.arg(currency), sqlc.arg(kind))
+ON CONFLICT (kind, currency) WHERE kind <> 'customer'
+DO UPDATE SET kind = EXCLUDED.kind
+RETURNING *;
+
+-- Doesn't lock the row, moveMoney locks it along with the other account in id order
+-- name: GetSystemAccount :one
+SELECT * FROM accounts
+WHERE kind = sqlc.arg(kind) AND currency = sqlc.arg(currency)
+LIMIT 1;
+
 -- name: ListAccounts :many
 SELECT * FROM accounts
-WHERE owner = $1
+WHERE owner = sqlc.arg(owner)
+  AND id > sqlc.arg(after_id)
+  AND (sqlc.narg(currency)::varchar IS NULL OR currency = sqlc.narg(currency)::varchar)
+  AND (sqlc.narg(created_from)::timestamptz IS NULL OR created_at >= sqlc.narg(created_from)::timestamptz)
+  AND (sqlc.narg(created_to)::timestamptz IS NULL OR created_at < sqlc.narg(created_to)::timestamptz)
+  AND (sqlc.narg(min_balance)::bigint IS NULL OR balance >= sqlc.narg(min_balance)::bigint)
+  AND (sqlc.narg(max_balance)::bigint IS NULL OR balance <= sqlc.narg(max_balance)::bigint)
 ORDER BY id
-LIMIT $2
-OFFSET $3;
+LIMIT sqlc.arg('limit');
 
 -- name: UpdateAccount :one
 UPDATE accounts
@@ -26,12 +45,36 @@ SET balance = $2
 WHERE id = $1
 RETURNING *;
 
+-- name: UpdateAccountOverdraftLimit :one
+UPDATE accounts
+SET overdraft_limit = sqlc.arg(overdraft_limit)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
 -- name: AddAccountBalance :one
 UPDATE accounts
 SET balance = balance + sqlc.arg(amount)
 WHERE id = sqlc.arg(id)
 RETURNING *;
 
--- name: DeleteAccount :exec
-DELETE FROM accounts
-WHERE id = $1;
\ No newline at end of file
+-- name: AddAccountHeldBalance :one
+UPDATE accounts
+SET held_balance = held_balance + sqlc.arg(amount)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: UpdateAccountStatus :one
+UPDATE accounts
+SET status = sqlc.arg(status)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: CreateAccountStatusChange :one
+INSERT INTO account_status_changes (account_id, from_status, to_status, changed_by, reason)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: ListAccountStatusChanges :many
+SELECT * FROM account_status_changes
+WHERE account_id = $1
+ORDER BY id;
@@ -0,0 +1,62 @@
+-- The latest snapshot taken before the day of at is the starting point and the entries posted since are added to it.
+-- An account without one goes back from its current balance instead.
+-- name: GetBalanceAt :one
+WITH snapshot AS (SELECT s.balance,
+                         (s.snapshot_date + 1)::timestamp AT TIME ZONE 'UTC' AS taken_at
+                  FROM balance_snapshots s
+                  WHERE s.account_id = sqlc.arg(account_id)
+                    AND s.snapshot_date < (sqlc.arg(at)::timestamptz AT TIME ZONE 'UTC')::date
+                  ORDER BY s.snapshot_date DESC
+                  LIMIT 1)
+SELECT (CASE
+            WHEN EXISTS (SELECT 1 FROM snapshot) THEN
+                        (SELECT balance FROM snapshot) +
+                        COALESCE((SELECT SUM(e.amount)
+                                  FROM entries e
+                                  WHERE e.account_id = a.id
+                                    AND e.created_at >= (SELECT taken_at FROM snapshot)
+                                    AND e.created_at < sqlc.arg(at)::timestamptz), 0)
+            ELSE a.balance - COALESCE((SELECT SUM(e.amount)
+                                       FROM entries e
+                                       WHERE e.account_id = a.id
+                                         AND e.created_at >= sqlc.arg(at)::timestamptz), 0)
+    END)::bigint AS balance
+FROM accounts a
+WHERE a.id = sqlc.arg(account_id);
+
+-- name: ListSnapshotAccounts :many
+SELECT id
+FROM accounts
+WHERE status <> 'closed'
+  AND created_at < sqlc.arg(day_end)
+  AND id > sqlc.arg(after_id)
+ORDER BY id
+LIMIT sqlc.arg('limit');
+
+-- The balance at the end of the day is today's balance less every entry posted since, a rerun keeps the first snapshot
+-- name: CreateBalanceSnapshots :execrows
+INSERT INTO balance_snapshots (account_id, snapshot_date, balance)
+SELECT a.id,
+       sqlc.arg(snapshot_date),
+       a.balance - COALESCE((SELECT SUM(e.amount)
+                             FROM entries e
+                             WHERE e.account_id = a.id
+                               AND e.created_at >= sqlc.arg(day_end)), 0)
+FROM accounts a
+WHERE a.id = ANY (sqlc.arg(account_ids)::bigint[])
+ON CONFLICT (account_id, snapshot_date) DO NOTHING;
+
+-- Each day's closing balance, from its snapshot when there is one, today's is the balance so far
+-- name: ListDailyBalances :many
+SELECT d.day::date AS day,
+       COALESCE(s.balance,
+                a.balance - COALESCE((SELECT SUM(e.amount)
+                                      FROM entries e
+                                      WHERE e.account_id = a.id
+                                        AND e.created_at >= (d.day + interval '1 day') AT TIME ZONE 'UTC'), 0))::bigint AS balance
+FROM accounts a
+         CROSS JOIN generate_series(sqlc.arg(from_date)::date::timestamp, sqlc.arg(to_date)::date::timestamp,
+                                    interval '1 day') AS d(day)
+         LEFT JOIN balance_snapshots s ON s.account_id = a.id AND s.snapshot_date = d.day::date
+WHERE a.id = sqlc.arg(account_id)
+ORDER BY d.day;
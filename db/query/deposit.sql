@@ -0,0 +1,10 @@
+-- name: CreateDeposit :one
+INSERT INTO deposits (account_id, amount, transfer_id, deposited_by, reference)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetDeposit :one
+SELECT *
+FROM deposits
+WHERE id = $1
+LIMIT 1;
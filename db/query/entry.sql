@@ -1,6 +1,6 @@
 -- name: CreateEntry :one
-INSERT INTO entries (account_id, amount)
-VALUES ($1, $2)
+INSERT INTO entries (account_id, amount, transfer_id)
+VALUES ($1, $2, sqlc.narg(transfer_id))
 RETURNING *;
 
 -- name: GetEntry :one
@@ -9,7 +9,11 @@ WHERE id = $1 LIMIT 1;
 
 -- name: ListEntries :many
 SELECT * FROM entries
-WHERE account_id = $1
+WHERE account_id = sqlc.arg(account_id)
+  AND id > sqlc.arg(after_id)
+  AND (sqlc.narg(created_from)::timestamptz IS NULL OR created_at >= sqlc.narg(created_from)::timestamptz)
+  AND (sqlc.narg(created_to)::timestamptz IS NULL OR created_at < sqlc.narg(created_to)::timestamptz)
+  AND (sqlc.narg(min_amount)::bigint IS NULL OR amount >= sqlc.narg(min_amount)::bigint)
+  AND (sqlc.narg(max_amount)::bigint IS NULL OR amount <= sqlc.narg(max_amount)::bigint)
 ORDER BY id
-LIMIT $2
-OFFSET $3;
+LIMIT sqlc.arg('limit');
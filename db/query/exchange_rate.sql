@@ -0,0 +1,37 @@
+-- name: CreateExchangeRate :one
+INSERT INTO exchange_rates (from_currency,
+                            to_currency,
+                            rate)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: GetLatestExchangeRate :one
+SELECT *
+FROM exchange_rates
+WHERE from_currency = $1
+  AND to_currency = $2
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: CreateExchangeQuote :one
+INSERT INTO exchange_quotes (id,
+                             username,
+                             from_currency,
+                             to_currency,
+                             rate,
+                             expires_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetExchangeQuoteForUpdate :one
+SELECT *
+FROM exchange_quotes
+WHERE id = $1
+LIMIT 1
+FOR UPDATE;
+
+-- name: UseExchangeQuote :one
+UPDATE exchange_quotes
+SET is_used = TRUE
+WHERE id = $1
+RETURNING *;
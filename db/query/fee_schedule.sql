@@ -0,0 +1,23 @@
+-- name: CreateFeeSchedule :one
+INSERT INTO fee_schedules (currency, min_amount, flat_fee, basis_points)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetFeeSchedule :one
+SELECT *
+FROM fee_schedules
+WHERE currency = sqlc.arg(currency)
+  AND min_amount <= sqlc.arg(amount)
+ORDER BY min_amount DESC
+LIMIT 1;
+
+-- name: ListFeeSchedules :many
+SELECT *
+FROM fee_schedules
+WHERE currency = $1
+ORDER BY min_amount;
+
+-- name: DeleteFeeSchedules :exec
+DELETE
+FROM fee_schedules
+WHERE currency = $1;
@@ -0,0 +1,21 @@
+-- Returns no rows when the user has already claimed the key
+-- name: CreateIdempotencyKey :one
+INSERT INTO idempotency_keys (username, key, request_hash)
+VALUES ($1, $2, $3)
+ON CONFLICT (username, key) DO NOTHING
+RETURNING *;
+
+-- name: GetIdempotencyKey :one
+SELECT *
+FROM idempotency_keys
+WHERE username = $1
+  AND key = $2
+LIMIT 1;
+
+-- name: UpdateIdempotencyKeyResponse :one
+UPDATE idempotency_keys
+SET transfer_id = sqlc.arg(transfer_id),
+    response    = sqlc.arg(response)
+WHERE username = sqlc.arg(username)
+  AND key = sqlc.arg(key)
+RETURNING *;
@@ -0,0 +1,65 @@
+-- name: UpsertInterestRate :one
+INSERT INTO interest_rates (product, currency, basis_points)
+VALUES ($1, $2, $3)
+ON CONFLICT (product, currency)
+    DO UPDATE SET basis_points = EXCLUDED.basis_points,
+                  updated_at   = now()
+RETURNING *;
+
+-- name: ListInterestRates :many
+SELECT *
+FROM interest_rates
+ORDER BY product, currency;
+
+-- The end of day balance is today's balance less every entry posted since the day ended
+-- name: ListInterestBearingAccounts :many
+SELECT a.id,
+       r.basis_points,
+       (a.balance - COALESCE((SELECT SUM(e.amount)
+                              FROM entries e
+                              WHERE e.account_id = a.id
+                                AND e.created_at >= sqlc.arg(day_end)), 0))::bigint AS end_of_day_balance
+FROM accounts a
+         JOIN interest_rates r ON r.product = a.product AND r.currency = a.currency
+WHERE a.kind = 'customer'
+  AND a.status = 'active'
+  AND r.basis_points > 0
+  AND a.id > sqlc.arg(after_id)
+ORDER BY a.id
+LIMIT sqlc.arg('limit');
+
+-- A rerun of the same day keeps the first accrual
+-- name: CreateInterestAccrual :execrows
+INSERT INTO interest_accruals (account_id, accrual_date, balance, basis_points, amount)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (account_id, accrual_date) DO NOTHING;
+
+-- name: ListInterestAccruals :many
+SELECT *
+FROM interest_accruals
+WHERE account_id = $1
+ORDER BY accrual_date DESC
+LIMIT $2 OFFSET $3;
+
+-- name: ListAccountsWithUnpostedInterest :many
+SELECT DISTINCT account_id
+FROM interest_accruals
+WHERE transfer_id IS NULL
+  AND accrual_date < sqlc.arg(before)
+  AND account_id > sqlc.arg(after_id)
+ORDER BY account_id
+LIMIT sqlc.arg('limit');
+
+-- name: SumUnpostedInterest :one
+SELECT COALESCE(SUM(amount), 0)::bigint AS total
+FROM interest_accruals
+WHERE account_id = $1
+  AND transfer_id IS NULL
+  AND accrual_date < sqlc.arg(before);
+
+-- name: MarkInterestPosted :execrows
+UPDATE interest_accruals
+SET transfer_id = sqlc.arg(transfer_id)
+WHERE account_id = sqlc.arg(account_id)
+  AND transfer_id IS NULL
+  AND accrual_date < sqlc.arg(before);
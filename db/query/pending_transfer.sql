@@ -0,0 +1,56 @@
+-- name: CreatePendingTransfer :one
+INSERT INTO pending_transfers (from_account_id,
+                               to_account_id,
+                               amount,
+                               to_amount,
+                               exchange_rate,
+                               initiator,
+                               initiator_role,
+                               expires_at,
+                               description,
+                               external_reference,
+                               metadata)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+RETURNING *;
+
+-- name: GetPendingTransfer :one
+SELECT *
+FROM pending_transfers
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetPendingTransferForUpdate :one
+SELECT *
+FROM pending_transfers
+WHERE id = $1
+LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListPendingTransfers :many
+SELECT *
+FROM pending_transfers
+WHERE status = 'pending'
+ORDER BY id
+LIMIT $1 OFFSET $2;
+
+-- name: UpdatePendingTransferStatus :one
+UPDATE pending_transfers
+SET status      = sqlc.arg(status),
+    reviewer    = sqlc.narg(reviewer),
+    reason      = sqlc.arg(reason),
+    transfer_id = sqlc.narg(transfer_id),
+    updated_at  = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: ExpirePendingTransfers :many
+UPDATE pending_transfers
+SET status     = 'expired',
+    updated_at = now()
+WHERE id IN (SELECT id
+             FROM pending_transfers
+             WHERE status = 'pending'
+               AND expires_at <= now()
+             ORDER BY id
+             LIMIT $1 FOR UPDATE SKIP LOCKED)
+RETURNING *;
@@ -0,0 +1,72 @@
+-- name: CreateReconciliationReport :one
+INSERT INTO reconciliation_reports (triggered_by)
+VALUES (sqlc.narg(triggered_by))
+RETURNING *;
+
+-- name: GetReconciliationReport :one
+SELECT *
+FROM reconciliation_reports
+WHERE id = $1
+LIMIT 1;
+
+-- name: ListReconciliationReports :many
+SELECT *
+FROM reconciliation_reports
+ORDER BY id DESC
+LIMIT $1 OFFSET $2;
+
+-- name: CompleteReconciliationReport :one
+UPDATE reconciliation_reports
+SET status            = 'completed',
+    accounts_checked  = sqlc.arg(accounts_checked),
+    transfers_checked = sqlc.arg(transfers_checked),
+    discrepancy_count = sqlc.arg(discrepancy_count),
+    completed_at      = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: CreateReconciliationDiscrepancy :one
+INSERT INTO reconciliation_discrepancies (report_id,
+                                          kind,
+                                          account_id,
+                                          transfer_id,
+                                          expected,
+                                          actual)
+VALUES (sqlc.arg(report_id), sqlc.arg(kind), sqlc.narg(account_id), sqlc.narg(transfer_id), sqlc.arg(expected),
+        sqlc.arg(actual))
+RETURNING *;
+
+-- name: ListReconciliationDiscrepancies :many
+SELECT *
+FROM reconciliation_discrepancies
+WHERE report_id = $1
+ORDER BY id;
+
+-- name: DeleteReconciliationDiscrepancies :exec
+DELETE
+FROM reconciliation_discrepancies
+WHERE report_id = $1;
+
+-- name: ReconcileAccounts :many
+SELECT a.id,
+       a.balance,
+       COALESCE((SELECT SUM(e.amount) FROM entries e WHERE e.account_id = a.id), 0)::bigint AS entries_total
+FROM accounts a
+WHERE a.id > sqlc.arg(after_id)
+ORDER BY a.id
+LIMIT sqlc.arg('limit');
+
+-- name: ReconcileTransfers :many
+SELECT t.id,
+       t.fee,
+       COUNT(e.id) FILTER (WHERE e.account_id = t.from_account_id AND e.amount = -t.amount)::bigint AS from_entries,
+       COUNT(e.id) FILTER (WHERE e.account_id = t.to_account_id AND e.amount = t.to_amount)::bigint AS to_entries,
+       COUNT(e.id) FILTER (WHERE e.account_id <> t.from_account_id AND e.account_id <> t.to_account_id AND
+                                 e.amount = t.fee)::bigint                                          AS fee_entries,
+       COUNT(e.id)::bigint                                                                          AS total_entries
+FROM transfers t
+         LEFT JOIN entries e ON e.transfer_id = t.id
+WHERE t.id > sqlc.arg(after_id)
+GROUP BY t.id
+ORDER BY t.id
+LIMIT sqlc.arg('limit');
@@ -0,0 +1,65 @@
+-- name: CreateStandingOrder :one
+INSERT INTO standing_orders (owner,
+                             from_account_id,
+                             to_account_id,
+                             amount,
+                             schedule,
+                             next_run_at,
+                             end_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: GetStandingOrder :one
+SELECT *
+FROM standing_orders
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetStandingOrderForUpdate :one
+SELECT *
+FROM standing_orders
+WHERE id = $1
+LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListStandingOrders :many
+SELECT *
+FROM standing_orders
+WHERE owner = $1
+ORDER BY id
+LIMIT $2 OFFSET $3;
+
+-- name: ListDueStandingOrders :many
+SELECT *
+FROM standing_orders
+WHERE is_active = TRUE
+  AND next_run_at <= now()
+ORDER BY next_run_at
+LIMIT $1;
+
+-- name: UpdateStandingOrder :one
+UPDATE standing_orders
+SET amount      = COALESCE(sqlc.narg(amount), amount),
+    schedule    = COALESCE(sqlc.narg(schedule), schedule),
+    next_run_at = COALESCE(sqlc.narg(next_run_at), next_run_at),
+    end_at      = COALESCE(sqlc.narg(end_at), end_at),
+    is_active   = COALESCE(sqlc.narg(is_active), is_active),
+    updated_at  = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: CreateStandingOrderRun :one
+INSERT INTO standing_order_runs (standing_order_id,
+                                 scheduled_at,
+                                 transfer_id,
+                                 status,
+                                 error)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: ListStandingOrderRuns :many
+SELECT *
+FROM standing_order_runs
+WHERE standing_order_id = $1
+ORDER BY id DESC
+LIMIT $2 OFFSET $3;
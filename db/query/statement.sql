@@ -0,0 +1,58 @@
+-- name: ListStatementEntries :many
+SELECT e.id,
+       e.amount,
+       e.transfer_id,
+       e.created_at,
+       COALESCE(t.description, '')::varchar        AS description,
+       COALESCE(t.external_reference, '')::varchar AS external_reference
+FROM entries e
+         LEFT JOIN transfers t ON t.id = e.transfer_id
+WHERE e.account_id = sqlc.arg(account_id)
+  AND e.created_at >= sqlc.arg(period_start)
+  AND e.created_at < sqlc.arg(period_end)
+ORDER BY e.id;
+
+-- Customer accounts that can have activity in the period, closed ones only if they had some
+-- name: ListStatementAccounts :many
+SELECT a.id
+FROM accounts a
+WHERE a.kind = 'customer'
+  AND a.created_at < sqlc.arg(period_end)
+  AND (a.status <> 'closed' OR EXISTS (SELECT 1
+                                       FROM entries e
+                                       WHERE e.account_id = a.id
+                                         AND e.created_at >= sqlc.arg(period_start)
+                                         AND e.created_at < sqlc.arg(period_end)))
+  AND a.id > sqlc.arg(after_id)
+ORDER BY a.id
+LIMIT sqlc.arg('limit');
+
+-- A rerun of the same period replaces the statement unless it was already emailed
+-- name: CreateStatement :one
+INSERT INTO statements (account_id, period_start, period_end, opening_balance, closing_balance,
+                        total_credits, total_debits, entry_count, csv, html)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+ON CONFLICT (account_id, period_start, period_end)
+    DO UPDATE SET opening_balance = EXCLUDED.opening_balance,
+                  closing_balance = EXCLUDED.closing_balance,
+                  total_credits   = EXCLUDED.total_credits,
+                  total_debits    = EXCLUDED.total_debits,
+                  entry_count     = EXCLUDED.entry_count,
+                  csv             = EXCLUDED.csv,
+                  html            = EXCLUDED.html
+    WHERE statements.emailed_at IS NULL
+RETURNING *;
+
+-- name: GetStatementForPeriod :one
+SELECT *
+FROM statements
+WHERE account_id = $1
+  AND period_start = $2
+  AND period_end = $3
+LIMIT 1;
+
+-- name: MarkStatementEmailed :one
+UPDATE statements
+SET emailed_at = now()
+WHERE id = $1
+RETURNING *;
@@ -1,17 +1,43 @@
 -- name: CreateTransfer :one
-INSERT INTO transfers (from_account_id, to_account_id, amount)
-VALUES ($1, $2, $3)
+INSERT INTO transfers (from_account_id, to_account_id, amount, to_amount, exchange_rate, fee, reversal_of,
+                       description, external_reference, metadata)
+VALUES ($1, $2, $3, $4, $5, $6, sqlc.narg(reversal_of), $7, $8, COALESCE(sqlc.narg(metadata)::jsonb, '{}'))
 RETURNING *;
 
 -- name: GetTransfer :one
 SELECT * FROM transfers
 WHERE id = $1 LIMIT 1;
 
+-- name: GetTransferForUpdate :one
+SELECT * FROM transfers
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: AddTransferReversedAmount :one
+UPDATE transfers
+SET reversed_amount = reversed_amount + sqlc.arg(amount)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
 -- name: ListTransfers :many
 SELECT * FROM transfers
-WHERE
-    from_account_id = $1 OR
-    to_account_id = $2
+WHERE ((sqlc.arg(outgoing)::boolean AND from_account_id = sqlc.arg(account_id)) OR
+       (sqlc.arg(incoming)::boolean AND to_account_id = sqlc.arg(account_id)))
+  AND id > sqlc.arg(after_id)
+  AND (sqlc.narg(created_from)::timestamptz IS NULL OR created_at >= sqlc.narg(created_from)::timestamptz)
+  AND (sqlc.narg(created_to)::timestamptz IS NULL OR created_at < sqlc.narg(created_to)::timestamptz)
+  AND (sqlc.narg(min_amount)::bigint IS NULL OR amount >= sqlc.narg(min_amount)::bigint)
+  AND (sqlc.narg(max_amount)::bigint IS NULL OR amount <= sqlc.narg(max_amount)::bigint)
 ORDER BY id
-LIMIT $3
-OFFSET $4;
+LIMIT sqlc.arg('limit');
+
+-- name: SearchTransfers :many
+SELECT t.* FROM transfers t
+WHERE (t.from_account_id IN (SELECT a.id FROM accounts a WHERE a.owner = sqlc.arg(owner)::varchar) OR
+       t.to_account_id IN (SELECT a.id FROM accounts a WHERE a.owner = sqlc.arg(owner)::varchar))
+  AND (sqlc.narg(query)::text IS NULL OR
+       to_tsvector('english', t.description) @@ websearch_to_tsquery('english', sqlc.narg(query)::text))
+  AND (sqlc.narg(external_reference)::varchar IS NULL OR t.external_reference = sqlc.narg(external_reference)::varchar)
+  AND t.id > sqlc.arg(after_id)
+ORDER BY t.id
+LIMIT sqlc.arg('limit');
@@ -0,0 +1,47 @@
+-- name: UpsertRoleTransferLimits :one
+INSERT INTO role_transfer_limits (role, currency, max_amount, daily_amount, daily_count)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (role, currency)
+    DO UPDATE SET max_amount   = EXCLUDED.max_amount,
+                  daily_amount = EXCLUDED.daily_amount,
+                  daily_count  = EXCLUDED.daily_count,
+                  updated_at   = now()
+RETURNING *;
+
+-- name: GetRoleTransferLimits :one
+SELECT *
+FROM role_transfer_limits
+WHERE role = $1
+  AND currency = $2
+LIMIT 1;
+
+-- name: UpsertAccountTransferLimits :one
+INSERT INTO account_transfer_limits (account_id, max_amount, daily_amount, daily_count, updated_by)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (account_id)
+    DO UPDATE SET max_amount   = EXCLUDED.max_amount,
+                  daily_amount = EXCLUDED.daily_amount,
+                  daily_count  = EXCLUDED.daily_count,
+                  updated_by   = EXCLUDED.updated_by,
+                  updated_at   = now()
+RETURNING *;
+
+-- name: GetAccountTransferLimits :one
+SELECT *
+FROM account_transfer_limits
+WHERE account_id = $1
+LIMIT 1;
+
+-- name: DeleteAccountTransferLimits :exec
+DELETE
+FROM account_transfer_limits
+WHERE account_id = $1;
+
+-- Reversals refund someone else's transfer, so they don't use up the account's limits
+-- name: GetDailyTransferUsage :one
+SELECT COALESCE(SUM(amount), 0)::bigint AS amount,
+       COUNT(*)::bigint                 AS count
+FROM transfers
+WHERE from_account_id = $1
+  AND created_at >= sqlc.arg(since)
+  AND reversal_of IS NULL;
@@ -23,3 +23,8 @@ SET
 WHERE
         username = sqlc.arg(username)
 RETURNING *;
+
+-- name: ListUsersByRole :many
+SELECT * FROM users
+WHERE role = $1
+ORDER BY username;
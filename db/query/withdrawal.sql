@@ -0,0 +1,27 @@
+-- name: CreateWithdrawal :one
+INSERT INTO withdrawals (account_id, amount, requested_by, transfer_id)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetWithdrawal :one
+SELECT *
+FROM withdrawals
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetWithdrawalForUpdate :one
+SELECT *
+FROM withdrawals
+WHERE id = $1
+LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: SettleWithdrawal :one
+UPDATE withdrawals
+SET status                 = sqlc.arg(status),
+    settlement_transfer_id = sqlc.arg(settlement_transfer_id),
+    processor_reference    = sqlc.arg(processor_reference),
+    failure_reason         = sqlc.arg(failure_reason),
+    updated_at             = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
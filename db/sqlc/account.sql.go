@@ -7,13 +7,15 @@ package db
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const addAccountBalance = `-- name: AddAccountBalance :one
 UPDATE accounts
 SET balance = balance + $1
 WHERE id = $2
-RETURNING id, owner, balance, currency, created_at
+RETURNING id, owner, balance, currency, created_at, overdraft_limit, held_balance, status, kind, product
 `
 
 type AddAccountBalanceParams struct {
@@ -30,24 +32,65 @@ func (q *Queries) AddAccountBalance(ctx context.Context, arg AddAccountBalancePa
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.HeldBalance,
+		&i.Status,
+		&i.Kind,
+		&i.Product,
+	)
+	return i, err
+}
+
+const addAccountHeldBalance = `-- name: AddAccountHeldBalance :one
+UPDATE accounts
+SET held_balance = held_balance + $1
+WHERE id = $2
+RETURNING id, owner, balance, currency, created_at, overdraft_limit, held_balance, status, kind, product
+`
+
+type AddAccountHeldBalanceParams struct {
+	Amount int64 `json:"amount"`
+	ID     int64 `json:"id"`
+}
+
+func (q *Queries) AddAccountHeldBalance(ctx context.Context, arg AddAccountHeldBalanceParams) (Account, error) {
+	row := q.db.QueryRow(ctx, addAccountHeldBalance, arg.Amount, arg.ID)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.HeldBalance,
+		&i.Status,
+		&i.Kind,
+		&i.Product,
 	)
 	return i, err
 }
 
 const createAccount = `-- name: CreateAccount :one
-INSERT INTO accounts (owner, balance, currency)
-VALUES ($1, $2, $3)
-RETURNING id, owner, balance, currency, created_at
+INSERT INTO accounts (owner, balance, currency, product)
+VALUES ($1, $2, $3, COALESCE($4::varchar, 'checking'))
+RETURNING id, owner, balance, currency, created_at, overdraft_limit, held_balance, status, kind, product
 `
 
 type CreateAccountParams struct {
-	Owner    string `json:"owner"`
-	Balance  int64  `json:"balance"`
-	Currency string `json:"currency"`
+	Owner    string      `json:"owner"`
+	Balance  int64       `json:"balance"`
+	Currency string      `json:"currency"`
+	Product  pgtype.Text `json:"product"`
 }
 
 func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error) {
-	row := q.db.QueryRow(ctx, createAccount, arg.Owner, arg.Balance, arg.Currency)
+	row := q.db.QueryRow(ctx, createAccount,
+		arg.Owner,
+		arg.Balance,
+		arg.Currency,
+		arg.Product,
+	)
 	var i Account
 	err := row.Scan(
 		&i.ID,
@@ -55,22 +98,84 @@ func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (A
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.HeldBalance,
+		&i.Status,
+		&i.Kind,
+		&i.Product,
 	)
 	return i, err
 }
 
-const deleteAccount = `-- name: DeleteAccount :exec
-DELETE FROM accounts
-WHERE id = $1
+const createAccountStatusChange = `-- name: CreateAccountStatusChange :one
+INSERT INTO account_status_changes (account_id, from_status, to_status, changed_by, reason)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, account_id, from_status, to_status, changed_by, reason, created_at
+`
+
+type CreateAccountStatusChangeParams struct {
+	AccountID  int64  `json:"account_id"`
+	FromStatus string `json:"from_status"`
+	ToStatus   string `json:"to_status"`
+	ChangedBy  string `json:"changed_by"`
+	Reason     string `json:"reason"`
+}
+
+func (q *Queries) CreateAccountStatusChange(ctx context.Context, arg CreateAccountStatusChangeParams) (AccountStatusChange, error) {
+	row := q.db.QueryRow(ctx, createAccountStatusChange,
+		arg.AccountID,
+		arg.FromStatus,
+		arg.ToStatus,
+		arg.ChangedBy,
+		arg.Reason,
+	)
+	var i AccountStatusChange
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.FromStatus,
+		&i.ToStatus,
+		&i.ChangedBy,
+		&i.Reason,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createSystemAccount = `-- name: CreateSystemAccount :one
+INSERT INTO accounts (owner, balance, currency, kind)
+VALUES ('bank', 0, $1, $2)
+ON CONFLICT (kind, currency) WHERE kind <> 'customer'
+DO UPDATE SET kind = EXCLUDED.kind
+RETURNING id, owner, balance, currency, created_at, overdraft_limit, held_balance, status, kind, product
 `
 
-func (q *Queries) DeleteAccount(ctx context.Context, id int64) error {
-	_, err := q.db.Exec(ctx, deleteAccount, id)
-	return err
+type CreateSystemAccountParams struct {
+	Currency string `json:"currency"`
+	Kind     string `json:"kind"`
+}
+
+// Only run at startup, the no-op update makes the upsert return the row when it already exists
+func (q *Queries) CreateSystemAccount(ctx context.Context, arg CreateSystemAccountParams) (Account, error) {
+	row := q.db.QueryRow(ctx, createSystemAccount, arg.Currency, arg.Kind)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.HeldBalance,
+		&i.Status,
+		&i.Kind,
+		&i.Product,
+	)
+	return i, err
 }
 
 const getAccount = `-- name: GetAccount :one
-SELECT id, owner, balance, currency, created_at FROM accounts
+SELECT id, owner, balance, currency, created_at, overdraft_limit, held_balance, status, kind, product FROM accounts
 WHERE id = $1 LIMIT 1
 `
 
@@ -83,12 +188,17 @@ func (q *Queries) GetAccount(ctx context.Context, id int64) (Account, error) {
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.HeldBalance,
+		&i.Status,
+		&i.Kind,
+		&i.Product,
 	)
 	return i, err
 }
 
 const getAccountForUpdate = `-- name: GetAccountForUpdate :one
-SELECT id, owner, balance, currency, created_at FROM accounts
+SELECT id, owner, balance, currency, created_at, overdraft_limit, held_balance, status, kind, product FROM accounts
 WHERE id = $1 LIMIT 1
 FOR NO KEY UPDATE
 `
@@ -103,26 +213,114 @@ func (q *Queries) GetAccountForUpdate(ctx context.Context, id int64) (Account, e
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.HeldBalance,
+		&i.Status,
+		&i.Kind,
+		&i.Product,
+	)
+	return i, err
+}
+
+const getSystemAccount = `-- name: GetSystemAccount :one
+SELECT id, owner, balance, currency, created_at, overdraft_limit, held_balance, status, kind, product FROM accounts
+WHERE kind = $1 AND currency = $2
+LIMIT 1
+`
+
+type GetSystemAccountParams struct {
+	Kind     string `json:"kind"`
+	Currency string `json:"currency"`
+}
+
+// Doesn't lock the row, moveMoney locks it along with the other account in id order
+func (q *Queries) GetSystemAccount(ctx context.Context, arg GetSystemAccountParams) (Account, error) {
+	row := q.db.QueryRow(ctx, getSystemAccount, arg.Kind, arg.Currency)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.HeldBalance,
+		&i.Status,
+		&i.Kind,
+		&i.Product,
 	)
 	return i, err
 }
 
+const listAccountStatusChanges = `-- name: ListAccountStatusChanges :many
+SELECT id, account_id, from_status, to_status, changed_by, reason, created_at FROM account_status_changes
+WHERE account_id = $1
+ORDER BY id
+`
+
+func (q *Queries) ListAccountStatusChanges(ctx context.Context, accountID int64) ([]AccountStatusChange, error) {
+	rows, err := q.db.Query(ctx, listAccountStatusChanges, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AccountStatusChange{}
+	for rows.Next() {
+		var i AccountStatusChange
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.FromStatus,
+			&i.ToStatus,
+			&i.ChangedBy,
+			&i.Reason,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listAccounts = `-- name: ListAccounts :many
-SELECT id, owner, balance, currency, created_at FROM accounts
+SELECT id, owner, balance, currency, created_at, overdraft_limit, held_balance, status, kind, product FROM accounts
 WHERE owner = $1
+  AND id > $2
+  AND ($3::varchar IS NULL OR currency = $3::varchar)
+  AND ($4::timestamptz IS NULL OR created_at >= $4::timestamptz)
+  AND ($5::timestamptz IS NULL OR created_at < $5::timestamptz)
+  AND ($6::bigint IS NULL OR balance >= $6::bigint)
+  AND ($7::bigint IS NULL OR balance <= $7::bigint)
 ORDER BY id
-LIMIT $2
-OFFSET $3
+LIMIT $8
 `
 
 type ListAccountsParams struct {
-	Owner  string `json:"owner"`
-	Limit  int32  `json:"limit"`
-	Offset int32  `json:"offset"`
+	Owner       string             `json:"owner"`
+	AfterID     int64              `json:"after_id"`
+	Currency    pgtype.Text        `json:"currency"`
+	CreatedFrom pgtype.Timestamptz `json:"created_from"`
+	CreatedTo   pgtype.Timestamptz `json:"created_to"`
+	MinBalance  pgtype.Int8        `json:"min_balance"`
+	MaxBalance  pgtype.Int8        `json:"max_balance"`
+	Limit       int32              `json:"limit"`
 }
 
 func (q *Queries) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]Account, error) {
-	rows, err := q.db.Query(ctx, listAccounts, arg.Owner, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, listAccounts,
+		arg.Owner,
+		arg.AfterID,
+		arg.Currency,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.MinBalance,
+		arg.MaxBalance,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -136,6 +334,11 @@ func (q *Queries) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]A
 			&i.Balance,
 			&i.Currency,
 			&i.CreatedAt,
+			&i.OverdraftLimit,
+			&i.HeldBalance,
+			&i.Status,
+			&i.Kind,
+			&i.Product,
 		); err != nil {
 			return nil, err
 		}
@@ -151,7 +354,7 @@ const updateAccount = `-- name: UpdateAccount :one
 UPDATE accounts
 SET balance = $2
 WHERE id = $1
-RETURNING id, owner, balance, currency, created_at
+RETURNING id, owner, balance, currency, created_at, overdraft_limit, held_balance, status, kind, product
 `
 
 type UpdateAccountParams struct {
@@ -168,6 +371,71 @@ func (q *Queries) UpdateAccount(ctx context.Context, arg UpdateAccountParams) (A
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.HeldBalance,
+		&i.Status,
+		&i.Kind,
+		&i.Product,
+	)
+	return i, err
+}
+
+const updateAccountOverdraftLimit = `-- name: UpdateAccountOverdraftLimit :one
+UPDATE accounts
+SET overdraft_limit = $1
+WHERE id = $2
+RETURNING id, owner, balance, currency, created_at, overdraft_limit, held_balance, status, kind, product
+`
+
+type UpdateAccountOverdraftLimitParams struct {
+	OverdraftLimit int64 `json:"overdraft_limit"`
+	ID             int64 `json:"id"`
+}
+
+func (q *Queries) UpdateAccountOverdraftLimit(ctx context.Context, arg UpdateAccountOverdraftLimitParams) (Account, error) {
+	row := q.db.QueryRow(ctx, updateAccountOverdraftLimit, arg.OverdraftLimit, arg.ID)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.HeldBalance,
+		&i.Status,
+		&i.Kind,
+		&i.Product,
+	)
+	return i, err
+}
+
+const updateAccountStatus = `-- name: UpdateAccountStatus :one
+UPDATE accounts
+SET status = $1
+WHERE id = $2
+RETURNING id, owner, balance, currency, created_at, overdraft_limit, held_balance, status, kind, product
+`
+
+type UpdateAccountStatusParams struct {
+	Status string `json:"status"`
+	ID     int64  `json:"id"`
+}
+
+func (q *Queries) UpdateAccountStatus(ctx context.Context, arg UpdateAccountStatusParams) (Account, error) {
+	row := q.db.QueryRow(ctx, updateAccountStatus, arg.Status, arg.ID)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.HeldBalance,
+		&i.Status,
+		&i.Kind,
+		&i.Product,
 	)
 	return i, err
 }
@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/require"
 	"goBank/util"
 	"testing"
@@ -9,6 +10,10 @@ import (
 )
 
 func createRandomAccount(t *testing.T) Account {
+	return createRandomAccountWithBalance(t, util.RandomMoney())
+}
+
+func createRandomAccountWithBalance(t *testing.T, balance int64) Account {
 	user := createRandomUser(t)
 
 	arg := CreateAccountParams{
@@ -18,7 +23,7 @@ func createRandomAccount(t *testing.T) Account {
 
 		//Randomized instead
 		Owner:    user.Username,
-		Balance:  util.RandomMoney(),
+		Balance:  balance,
 		Currency: util.RandomCurrency(),
 	}
 
@@ -29,6 +34,10 @@ func createRandomAccount(t *testing.T) Account {
 	require.Equal(t, arg.Balance, account.Balance)
 	require.Equal(t, arg.Currency, account.Currency)
 
+	require.Zero(t, account.OverdraftLimit)
+	require.Equal(t, AccountStatusActive, account.Status)
+	require.Zero(t, account.HeldBalance)
+
 	require.NotZero(t, account.ID)
 	require.NotZero(t, account.CreatedAt)
 
@@ -74,15 +83,21 @@ func TestUpdateAccount(t *testing.T) {
 	require.WithinDuration(t, account1.CreatedAt, account2.CreatedAt, time.Second)
 }
 
-func TestDeleteAccount(t *testing.T) {
+func TestUpdateAccountOverdraftLimit(t *testing.T) {
 	account1 := createRandomAccount(t)
-	err := testStore.DeleteAccount(context.Background(), account1.ID)
+
+	arg := UpdateAccountOverdraftLimitParams{
+		ID:             account1.ID,
+		OverdraftLimit: util.RandomMoney(),
+	}
+
+	account2, err := testStore.UpdateAccountOverdraftLimit(context.Background(), arg)
 	require.NoError(t, err)
+	require.NotEmpty(t, account2)
 
-	account2, err := testStore.GetAccount(context.Background(), account1.ID)
-	require.Error(t, err)
-	require.EqualError(t, err, ErrRecordNotFound.Error())
-	require.Empty(t, account2)
+	require.Equal(t, account1.ID, account2.ID)
+	require.Equal(t, account1.Balance, account2.Balance)
+	require.Equal(t, arg.OverdraftLimit, account2.OverdraftLimit)
 }
 
 func TestListAccounts(t *testing.T) {
@@ -93,9 +108,8 @@ func TestListAccounts(t *testing.T) {
 	}
 
 	arg := ListAccountsParams{
-		Owner:  lastAccount.Owner,
-		Limit:  5,
-		Offset: 0,
+		Owner: lastAccount.Owner,
+		Limit: 5,
 	}
 
 	accounts, err := testStore.ListAccounts(context.Background(), arg)
@@ -106,4 +120,17 @@ func TestListAccounts(t *testing.T) {
 		require.NotEmpty(t, account)
 		require.Equal(t, lastAccount.Owner, account.Owner)
 	}
+
+	arg.Currency = pgtype.Text{String: lastAccount.Currency, Valid: true}
+	arg.MinBalance = pgtype.Int8{Int64: lastAccount.Balance, Valid: true}
+	arg.MaxBalance = pgtype.Int8{Int64: lastAccount.Balance, Valid: true}
+	accounts, err = testStore.ListAccounts(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	require.Equal(t, lastAccount.ID, accounts[0].ID)
+
+	arg.AfterID = lastAccount.ID
+	accounts, err = testStore.ListAccounts(context.Background(), arg)
+	require.NoError(t, err)
+	require.Empty(t, accounts)
 }
@@ -0,0 +1,163 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.20.0
+// source: balance_snapshot.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createBalanceSnapshots = `-- name: CreateBalanceSnapshots :execrows
+INSERT INTO balance_snapshots (account_id, snapshot_date, balance)
+SELECT a.id,
+       $1,
+       a.balance - COALESCE((SELECT SUM(e.amount)
+                             FROM entries e
+                             WHERE e.account_id = a.id
+                               AND e.created_at >= $2), 0)
+FROM accounts a
+WHERE a.id = ANY ($3::bigint[])
+ON CONFLICT (account_id, snapshot_date) DO NOTHING
+`
+
+type CreateBalanceSnapshotsParams struct {
+	SnapshotDate pgtype.Date `json:"snapshot_date"`
+	DayEnd       time.Time   `json:"day_end"`
+	AccountIds   []int64     `json:"account_ids"`
+}
+
+// The balance at the end of the day is today's balance less every entry posted since, a rerun keeps the first snapshot
+func (q *Queries) CreateBalanceSnapshots(ctx context.Context, arg CreateBalanceSnapshotsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createBalanceSnapshots, arg.SnapshotDate, arg.DayEnd, arg.AccountIds)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getBalanceAt = `-- name: GetBalanceAt :one
+WITH snapshot AS (SELECT s.balance,
+                         (s.snapshot_date + 1)::timestamp AT TIME ZONE 'UTC' AS taken_at
+                  FROM balance_snapshots s
+                  WHERE s.account_id = $2
+                    AND s.snapshot_date < ($1::timestamptz AT TIME ZONE 'UTC')::date
+                  ORDER BY s.snapshot_date DESC
+                  LIMIT 1)
+SELECT (CASE
+            WHEN EXISTS (SELECT 1 FROM snapshot) THEN
+                        (SELECT balance FROM snapshot) +
+                        COALESCE((SELECT SUM(e.amount)
+                                  FROM entries e
+                                  WHERE e.account_id = a.id
+                                    AND e.created_at >= (SELECT taken_at FROM snapshot)
+                                    AND e.created_at < $1::timestamptz), 0)
+            ELSE a.balance - COALESCE((SELECT SUM(e.amount)
+                                       FROM entries e
+                                       WHERE e.account_id = a.id
+                                         AND e.created_at >= $1::timestamptz), 0)
+    END)::bigint AS balance
+FROM accounts a
+WHERE a.id = $2
+`
+
+type GetBalanceAtParams struct {
+	At        time.Time `json:"at"`
+	AccountID int64     `json:"account_id"`
+}
+
+// The latest snapshot taken before the day of at is the starting point and the entries posted since are added to it.
+// An account without one goes back from its current balance instead.
+func (q *Queries) GetBalanceAt(ctx context.Context, arg GetBalanceAtParams) (int64, error) {
+	row := q.db.QueryRow(ctx, getBalanceAt, arg.At, arg.AccountID)
+	var balance int64
+	err := row.Scan(&balance)
+	return balance, err
+}
+
+const listDailyBalances = `-- name: ListDailyBalances :many
+SELECT d.day::date AS day,
+       COALESCE(s.balance,
+                a.balance - COALESCE((SELECT SUM(e.amount)
+                                      FROM entries e
+                                      WHERE e.account_id = a.id
+                                        AND e.created_at >= (d.day + interval '1 day') AT TIME ZONE 'UTC'), 0))::bigint AS balance
+FROM accounts a
+         CROSS JOIN generate_series($1::date::timestamp, $2::date::timestamp,
+                                    interval '1 day') AS d(day)
+         LEFT JOIN balance_snapshots s ON s.account_id = a.id AND s.snapshot_date = d.day::date
+WHERE a.id = $3
+ORDER BY d.day
+`
+
+type ListDailyBalancesParams struct {
+	FromDate  pgtype.Date `json:"from_date"`
+	ToDate    pgtype.Date `json:"to_date"`
+	AccountID int64       `json:"account_id"`
+}
+
+type ListDailyBalancesRow struct {
+	Day     pgtype.Date `json:"day"`
+	Balance int64       `json:"balance"`
+}
+
+// Each day's closing balance, from its snapshot when there is one, today's is the balance so far
+func (q *Queries) ListDailyBalances(ctx context.Context, arg ListDailyBalancesParams) ([]ListDailyBalancesRow, error) {
+	rows, err := q.db.Query(ctx, listDailyBalances, arg.FromDate, arg.ToDate, arg.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListDailyBalancesRow{}
+	for rows.Next() {
+		var i ListDailyBalancesRow
+		if err := rows.Scan(&i.Day, &i.Balance); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSnapshotAccounts = `-- name: ListSnapshotAccounts :many
+SELECT id
+FROM accounts
+WHERE status <> 'closed'
+  AND created_at < $1
+  AND id > $2
+ORDER BY id
+LIMIT $3
+`
+
+type ListSnapshotAccountsParams struct {
+	DayEnd  time.Time `json:"day_end"`
+	AfterID int64     `json:"after_id"`
+	Limit   int32     `json:"limit"`
+}
+
+func (q *Queries) ListSnapshotAccounts(ctx context.Context, arg ListSnapshotAccountsParams) ([]int64, error) {
+	rows, err := q.db.Query(ctx, listSnapshotAccounts, arg.DayEnd, arg.AfterID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
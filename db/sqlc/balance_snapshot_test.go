@@ -0,0 +1,75 @@
+package db
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"goBank/util"
+	"testing"
+	"time"
+)
+
+func TestGetBalanceAt(t *testing.T) {
+	account1 := createRandomAccountWithCurrency(t, 1000, util.USD)
+	account2 := createRandomAccountWithCurrency(t, 0, util.USD)
+	beforeTransfer := time.Now()
+
+	_, err := testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        400,
+	})
+	require.NoError(t, err)
+
+	// without a snapshot the balance is worked back from the current one
+	balance, err := testStore.GetBalanceAt(context.Background(), GetBalanceAtParams{
+		At:        beforeTransfer,
+		AccountID: account1.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), balance)
+
+	balance, err = testStore.GetBalanceAt(context.Background(), GetBalanceAtParams{
+		At:        time.Now().Add(time.Minute),
+		AccountID: account1.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(600), balance)
+
+	// a snapshot from yesterday is the starting point and today's entries are added to it
+	today := StartOfDay(time.Now())
+	created, err := testStore.CreateBalanceSnapshots(context.Background(), CreateBalanceSnapshotsParams{
+		SnapshotDate: pgtype.Date{Time: today.AddDate(0, 0, -1), Valid: true},
+		DayEnd:       today,
+		AccountIds:   []int64{account1.ID},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), created)
+
+	// the same day again keeps the first snapshot
+	created, err = testStore.CreateBalanceSnapshots(context.Background(), CreateBalanceSnapshotsParams{
+		SnapshotDate: pgtype.Date{Time: today.AddDate(0, 0, -1), Valid: true},
+		DayEnd:       today,
+		AccountIds:   []int64{account1.ID},
+	})
+	require.NoError(t, err)
+	require.Zero(t, created)
+
+	balance, err = testStore.GetBalanceAt(context.Background(), GetBalanceAtParams{
+		At:        time.Now().Add(time.Minute),
+		AccountID: account1.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(600), balance)
+
+	rows, err := testStore.ListDailyBalances(context.Background(), ListDailyBalancesParams{
+		FromDate:  pgtype.Date{Time: today.AddDate(0, 0, -1), Valid: true},
+		ToDate:    pgtype.Date{Time: today, Valid: true},
+		AccountID: account1.ID,
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	require.True(t, today.AddDate(0, 0, -1).Equal(rows[0].Day.Time))
+	require.Equal(t, int64(1000), rows[0].Balance)
+	require.Equal(t, int64(600), rows[1].Balance)
+}
@@ -0,0 +1,107 @@
+package db
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"goBank/util"
+	"testing"
+)
+
+func TestBatchTransferTx(t *testing.T) {
+	from := createRandomAccountWithCurrency(t, 1000, util.USD)
+	to1 := createRandomAccountWithCurrency(t, 0, util.USD)
+	to2 := createRandomAccountWithCurrency(t, 0, util.USD)
+
+	result, err := testStore.BatchTransferTx(context.Background(), BatchTransferTxParams{
+		FromAccountID: from.ID,
+		Legs: []BatchTransferLeg{
+			{ToAccountID: to1.ID, Amount: 100},
+			{ToAccountID: to2.ID, Amount: 200},
+			{ToAccountID: to1.ID, Amount: 50},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Transfers, 3)
+	require.Equal(t, int64(350), result.TotalAmount)
+	require.Equal(t, from.Balance-350, result.FromAccount.Balance)
+
+	for i, leg := range []int64{to1.ID, to2.ID, to1.ID} {
+		require.Equal(t, from.ID, result.Transfers[i].Transfer.FromAccountID)
+		require.Equal(t, leg, result.Transfers[i].Transfer.ToAccountID)
+	}
+
+	updatedTo1, err := testStore.GetAccount(context.Background(), to1.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(150), updatedTo1.Balance)
+}
+
+func TestBatchTransferTxAllOrNothing(t *testing.T) {
+	from := createRandomAccountWithCurrency(t, 100, util.USD)
+	to := createRandomAccountWithCurrency(t, 0, util.USD)
+	other := createRandomAccountWithCurrency(t, 0, util.EUR)
+
+	// the batch's total is more than the account has, even though each leg alone fits
+	_, err := testStore.BatchTransferTx(context.Background(), BatchTransferTxParams{
+		FromAccountID: from.ID,
+		Legs: []BatchTransferLeg{
+			{ToAccountID: to.ID, Amount: 60},
+			{ToAccountID: to.ID, Amount: 60},
+		},
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	_, err = testStore.BatchTransferTx(context.Background(), BatchTransferTxParams{
+		FromAccountID: from.ID,
+		Legs: []BatchTransferLeg{
+			{ToAccountID: to.ID, Amount: 10},
+			{ToAccountID: other.ID, Amount: 10},
+		},
+	})
+	require.ErrorIs(t, err, ErrBatchCurrencyMismatch)
+
+	_, err = testStore.BatchTransferTx(context.Background(), BatchTransferTxParams{FromAccountID: from.ID})
+	require.ErrorIs(t, err, ErrBatchEmpty)
+
+	updatedFrom, err := testStore.GetAccount(context.Background(), from.ID)
+	require.NoError(t, err)
+	require.Equal(t, from.Balance, updatedFrom.Balance)
+
+	updatedTo, err := testStore.GetAccount(context.Background(), to.ID)
+	require.NoError(t, err)
+	require.Zero(t, updatedTo.Balance)
+}
+
+func TestBatchTransferTxDeadlock(t *testing.T) {
+	account1 := createRandomAccountWithCurrency(t, 1000, util.USD)
+	account2 := createRandomAccountWithCurrency(t, 1000, util.USD)
+	account3 := createRandomAccountWithCurrency(t, 1000, util.USD)
+
+	// batches paying each other in opposite directions would deadlock without locking in ID order
+	n := 10
+	errs := make(chan error)
+	for i := 0; i < n; i++ {
+		from, to1, to2 := account1, account2, account3
+		if i%2 == 1 {
+			from, to1, to2 = account3, account2, account1
+		}
+
+		go func() {
+			_, err := testStore.BatchTransferTx(context.Background(), BatchTransferTxParams{
+				FromAccountID: from.ID,
+				Legs: []BatchTransferLeg{
+					{ToAccountID: to1.ID, Amount: 10},
+					{ToAccountID: to2.ID, Amount: 10},
+				},
+			})
+			errs <- err
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		require.NoError(t, <-errs)
+	}
+
+	updatedAccount2, err := testStore.GetAccount(context.Background(), account2.ID)
+	require.NoError(t, err)
+	require.Equal(t, account2.Balance+int64(n)*10, updatedAccount2.Balance)
+}
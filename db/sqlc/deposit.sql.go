@@ -0,0 +1,67 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.20.0
+// source: deposit.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createDeposit = `-- name: CreateDeposit :one
+INSERT INTO deposits (account_id, amount, transfer_id, deposited_by, reference)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, account_id, amount, transfer_id, deposited_by, reference, created_at
+`
+
+type CreateDepositParams struct {
+	AccountID   int64  `json:"account_id"`
+	Amount      int64  `json:"amount"`
+	TransferID  int64  `json:"transfer_id"`
+	DepositedBy string `json:"deposited_by"`
+	Reference   string `json:"reference"`
+}
+
+func (q *Queries) CreateDeposit(ctx context.Context, arg CreateDepositParams) (Deposit, error) {
+	row := q.db.QueryRow(ctx, createDeposit,
+		arg.AccountID,
+		arg.Amount,
+		arg.TransferID,
+		arg.DepositedBy,
+		arg.Reference,
+	)
+	var i Deposit
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Amount,
+		&i.TransferID,
+		&i.DepositedBy,
+		&i.Reference,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getDeposit = `-- name: GetDeposit :one
+SELECT id, account_id, amount, transfer_id, deposited_by, reference, created_at
+FROM deposits
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetDeposit(ctx context.Context, id int64) (Deposit, error) {
+	row := q.db.QueryRow(ctx, getDeposit, id)
+	var i Deposit
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Amount,
+		&i.TransferID,
+		&i.DepositedBy,
+		&i.Reference,
+		&i.CreatedAt,
+	)
+	return i, err
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/require"
 )
 
@@ -48,22 +49,33 @@ func TestGetEntry(t *testing.T) {
 
 func TestListEntries(t *testing.T) {
 	account := createRandomAccount(t)
+	var created []Entry
 	for i := 0; i < 10; i++ {
-		createRandomEntry(t, account)
+		created = append(created, createRandomEntry(t, account))
 	}
 
 	arg := ListEntriesParams{
 		AccountID: account.ID,
+		AfterID:   created[4].ID,
 		Limit:     5,
-		Offset:    5,
 	}
 
 	entries, err := testStore.ListEntries(context.Background(), arg)
 	require.NoError(t, err)
 	require.Len(t, entries, 5)
 
-	for _, entry := range entries {
+	for i, entry := range entries {
 		require.NotEmpty(t, entry)
 		require.Equal(t, arg.AccountID, entry.AccountID)
+		require.Equal(t, created[5+i].ID, entry.ID)
 	}
+
+	arg = ListEntriesParams{
+		AccountID:   account.ID,
+		CreatedFrom: pgtype.Timestamptz{Time: time.Now().Add(time.Minute), Valid: true},
+		Limit:       5,
+	}
+	entries, err = testStore.ListEntries(context.Background(), arg)
+	require.NoError(t, err)
+	require.Empty(t, entries)
 }
@@ -17,6 +17,9 @@ var ErrUniqueViolation = &pgconn.PgError{
 
 var ErrRecordNotFound = pgx.ErrNoRows
 
+// ErrInsufficientFunds is returned when a debit would take an account below its overdraft limit
+var ErrInsufficientFunds = errors.New("insufficient funds")
+
 func ErrorCode(err error) string {
 	var pgErr *pgconn.PgError
 	if errors.As(err, &pgErr) {
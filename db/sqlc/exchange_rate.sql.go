@@ -0,0 +1,157 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.20.0
+// source: exchange_rate.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const createExchangeQuote = `-- name: CreateExchangeQuote :one
+INSERT INTO exchange_quotes (id,
+                             username,
+                             from_currency,
+                             to_currency,
+                             rate,
+                             expires_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, username, from_currency, to_currency, rate, is_used, expires_at, created_at
+`
+
+type CreateExchangeQuoteParams struct {
+	ID           uuid.UUID `json:"id"`
+	Username     string    `json:"username"`
+	FromCurrency string    `json:"from_currency"`
+	ToCurrency   string    `json:"to_currency"`
+	Rate         int64     `json:"rate"`
+	ExpiresAt    time.Time `json:"expires_at"`
+}
+
+func (q *Queries) CreateExchangeQuote(ctx context.Context, arg CreateExchangeQuoteParams) (ExchangeQuote, error) {
+	row := q.db.QueryRow(ctx, createExchangeQuote,
+		arg.ID,
+		arg.Username,
+		arg.FromCurrency,
+		arg.ToCurrency,
+		arg.Rate,
+		arg.ExpiresAt,
+	)
+	var i ExchangeQuote
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.FromCurrency,
+		&i.ToCurrency,
+		&i.Rate,
+		&i.IsUsed,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createExchangeRate = `-- name: CreateExchangeRate :one
+INSERT INTO exchange_rates (from_currency,
+                            to_currency,
+                            rate)
+VALUES ($1, $2, $3)
+RETURNING id, from_currency, to_currency, rate, created_at
+`
+
+type CreateExchangeRateParams struct {
+	FromCurrency string `json:"from_currency"`
+	ToCurrency   string `json:"to_currency"`
+	Rate         int64  `json:"rate"`
+}
+
+func (q *Queries) CreateExchangeRate(ctx context.Context, arg CreateExchangeRateParams) (ExchangeRate, error) {
+	row := q.db.QueryRow(ctx, createExchangeRate, arg.FromCurrency, arg.ToCurrency, arg.Rate)
+	var i ExchangeRate
+	err := row.Scan(
+		&i.ID,
+		&i.FromCurrency,
+		&i.ToCurrency,
+		&i.Rate,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getExchangeQuoteForUpdate = `-- name: GetExchangeQuoteForUpdate :one
+SELECT id, username, from_currency, to_currency, rate, is_used, expires_at, created_at
+FROM exchange_quotes
+WHERE id = $1
+LIMIT 1
+FOR UPDATE
+`
+
+func (q *Queries) GetExchangeQuoteForUpdate(ctx context.Context, id uuid.UUID) (ExchangeQuote, error) {
+	row := q.db.QueryRow(ctx, getExchangeQuoteForUpdate, id)
+	var i ExchangeQuote
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.FromCurrency,
+		&i.ToCurrency,
+		&i.Rate,
+		&i.IsUsed,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getLatestExchangeRate = `-- name: GetLatestExchangeRate :one
+SELECT id, from_currency, to_currency, rate, created_at
+FROM exchange_rates
+WHERE from_currency = $1
+  AND to_currency = $2
+ORDER BY created_at DESC
+LIMIT 1
+`
+
+type GetLatestExchangeRateParams struct {
+	FromCurrency string `json:"from_currency"`
+	ToCurrency   string `json:"to_currency"`
+}
+
+func (q *Queries) GetLatestExchangeRate(ctx context.Context, arg GetLatestExchangeRateParams) (ExchangeRate, error) {
+	row := q.db.QueryRow(ctx, getLatestExchangeRate, arg.FromCurrency, arg.ToCurrency)
+	var i ExchangeRate
+	err := row.Scan(
+		&i.ID,
+		&i.FromCurrency,
+		&i.ToCurrency,
+		&i.Rate,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const useExchangeQuote = `-- name: UseExchangeQuote :one
+UPDATE exchange_quotes
+SET is_used = TRUE
+WHERE id = $1
+RETURNING id, username, from_currency, to_currency, rate, is_used, expires_at, created_at
+`
+
+func (q *Queries) UseExchangeQuote(ctx context.Context, id uuid.UUID) (ExchangeQuote, error) {
+	row := q.db.QueryRow(ctx, useExchangeQuote, id)
+	var i ExchangeQuote
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.FromCurrency,
+		&i.ToCurrency,
+		&i.Rate,
+		&i.IsUsed,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
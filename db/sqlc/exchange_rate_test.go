@@ -0,0 +1,114 @@
+package db
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"goBank/util"
+	"math"
+	"testing"
+	"time"
+)
+
+func createRandomExchangeRate(t *testing.T, fromCurrency, toCurrency string) ExchangeRate {
+	arg := CreateExchangeRateParams{
+		FromCurrency: fromCurrency,
+		ToCurrency:   toCurrency,
+		Rate:         util.RandomInt(ExchangeRateScale/2, ExchangeRateScale*2),
+	}
+
+	rate, err := testStore.CreateExchangeRate(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, rate)
+
+	require.Equal(t, arg.FromCurrency, rate.FromCurrency)
+	require.Equal(t, arg.ToCurrency, rate.ToCurrency)
+	require.Equal(t, arg.Rate, rate.Rate)
+
+	require.NotZero(t, rate.ID)
+	require.NotZero(t, rate.CreatedAt)
+
+	return rate
+}
+
+func createRandomExchangeQuote(t *testing.T, username string, rate ExchangeRate, duration time.Duration) ExchangeQuote {
+	arg := CreateExchangeQuoteParams{
+		ID:           uuid.New(),
+		Username:     username,
+		FromCurrency: rate.FromCurrency,
+		ToCurrency:   rate.ToCurrency,
+		Rate:         rate.Rate,
+		ExpiresAt:    time.Now().Add(duration),
+	}
+
+	quote, err := testStore.CreateExchangeQuote(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, quote)
+
+	require.Equal(t, arg.ID, quote.ID)
+	require.Equal(t, arg.Username, quote.Username)
+	require.Equal(t, arg.Rate, quote.Rate)
+	require.False(t, quote.IsUsed)
+	require.WithinDuration(t, arg.ExpiresAt, quote.ExpiresAt, time.Second)
+
+	return quote
+}
+
+func TestCreateExchangeRate(t *testing.T) {
+	createRandomExchangeRate(t, util.USD, util.EUR)
+}
+
+func TestGetLatestExchangeRate(t *testing.T) {
+	createRandomExchangeRate(t, util.EUR, util.CAD)
+	rate1 := createRandomExchangeRate(t, util.EUR, util.CAD)
+
+	rate2, err := testStore.GetLatestExchangeRate(context.Background(), GetLatestExchangeRateParams{
+		FromCurrency: util.EUR,
+		ToCurrency:   util.CAD,
+	})
+	require.NoError(t, err)
+	require.Equal(t, rate1.ID, rate2.ID)
+	require.Equal(t, rate1.Rate, rate2.Rate)
+}
+
+func TestCreateExchangeQuote(t *testing.T) {
+	user := createRandomUser(t)
+	rate := createRandomExchangeRate(t, util.USD, util.CAD)
+	createRandomExchangeQuote(t, user.Username, rate, time.Minute)
+}
+
+func TestConvertAmount(t *testing.T) {
+	currencies, err := util.ParseCurrencies("USD,EUR,JPY,KWD")
+	require.NoError(t, err)
+	usd, eur, jpy, kwd := currencies[util.USD], currencies[util.EUR], currencies["JPY"], currencies["KWD"]
+
+	convert := func(amount int64, rate int64, from util.Currency, to util.Currency) int64 {
+		converted, err := ConvertAmount(amount, rate, from, to)
+		require.NoError(t, err)
+		return converted
+	}
+
+	require.Equal(t, int64(100), convert(100, IdentityExchangeRate, usd, usd))
+	require.Equal(t, int64(108), convert(100, 108_450_000, usd, eur))
+	require.Equal(t, int64(0), convert(1, 50_000_000, usd, eur))
+
+	// rates are per major unit, 1.00 USD at 150.25 JPY per USD is 150 JPY
+	require.Equal(t, int64(150), convert(100, 15_025_000_000, usd, jpy))
+	// 1000 JPY at 0.00665 USD per JPY is 6.65 USD
+	require.Equal(t, int64(665), convert(1000, 665_000, jpy, usd))
+	// 1.000 KWD at 3.25 USD per KWD is 3.25 USD
+	require.Equal(t, int64(325), convert(1000, 325_000_000, kwd, usd))
+	// 1.00 USD at 0.307 KWD per USD is 0.307 KWD
+	require.Equal(t, int64(307), convert(100, 30_700_000, usd, kwd))
+}
+
+func TestConvertAmountOverflow(t *testing.T) {
+	currencies, err := util.ParseCurrencies("JPY,KWD")
+	require.NoError(t, err)
+	jpy, kwd := currencies["JPY"], currencies["KWD"]
+
+	// a huge amount at a huge rate, scaled up again for KWD's extra minor units, doesn't fit in an int64
+	converted, err := ConvertAmount(math.MaxInt64/2, 1_000_000*ExchangeRateScale, jpy, kwd)
+	require.ErrorIs(t, err, ErrAmountOverflow)
+	require.Zero(t, converted)
+}
@@ -3,11 +3,26 @@ package db
 import (
 	"context"
 	"fmt"
+
+	"github.com/jackc/pgx/v5"
 )
 
 // execTx executes a function within a database transaction not exported to keep it safe, exported in the function below
 func (store *SQLStore) execTx(ctx context.Context, fn func(*Queries) error) error {
-	tx, err := store.connPool.Begin(ctx)
+	return store.execTxWithOptions(ctx, pgx.TxOptions{}, fn)
+}
+
+// execReadTx executes a function that only reads within a read-only REPEATABLE READ transaction.
+// Every query sees the same snapshot, so reads stay consistent with each other without locking any rows.
+func (store *SQLStore) execReadTx(ctx context.Context, fn func(*Queries) error) error {
+	return store.execTxWithOptions(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.RepeatableRead,
+		AccessMode: pgx.ReadOnly,
+	}, fn)
+}
+
+func (store *SQLStore) execTxWithOptions(ctx context.Context, txOptions pgx.TxOptions, fn func(*Queries) error) error {
+	tx, err := store.connPool.BeginTx(ctx, txOptions)
 	if err != nil {
 		return err
 	}
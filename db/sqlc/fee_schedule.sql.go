@@ -0,0 +1,115 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.20.0
+// source: fee_schedule.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createFeeSchedule = `-- name: CreateFeeSchedule :one
+INSERT INTO fee_schedules (currency, min_amount, flat_fee, basis_points)
+VALUES ($1, $2, $3, $4)
+RETURNING id, currency, min_amount, flat_fee, basis_points, created_at
+`
+
+type CreateFeeScheduleParams struct {
+	Currency    string `json:"currency"`
+	MinAmount   int64  `json:"min_amount"`
+	FlatFee     int64  `json:"flat_fee"`
+	BasisPoints int64  `json:"basis_points"`
+}
+
+func (q *Queries) CreateFeeSchedule(ctx context.Context, arg CreateFeeScheduleParams) (FeeSchedule, error) {
+	row := q.db.QueryRow(ctx, createFeeSchedule,
+		arg.Currency,
+		arg.MinAmount,
+		arg.FlatFee,
+		arg.BasisPoints,
+	)
+	var i FeeSchedule
+	err := row.Scan(
+		&i.ID,
+		&i.Currency,
+		&i.MinAmount,
+		&i.FlatFee,
+		&i.BasisPoints,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteFeeSchedules = `-- name: DeleteFeeSchedules :exec
+DELETE
+FROM fee_schedules
+WHERE currency = $1
+`
+
+func (q *Queries) DeleteFeeSchedules(ctx context.Context, currency string) error {
+	_, err := q.db.Exec(ctx, deleteFeeSchedules, currency)
+	return err
+}
+
+const getFeeSchedule = `-- name: GetFeeSchedule :one
+SELECT id, currency, min_amount, flat_fee, basis_points, created_at
+FROM fee_schedules
+WHERE currency = $1
+  AND min_amount <= $2
+ORDER BY min_amount DESC
+LIMIT 1
+`
+
+type GetFeeScheduleParams struct {
+	Currency string `json:"currency"`
+	Amount   int64  `json:"amount"`
+}
+
+func (q *Queries) GetFeeSchedule(ctx context.Context, arg GetFeeScheduleParams) (FeeSchedule, error) {
+	row := q.db.QueryRow(ctx, getFeeSchedule, arg.Currency, arg.Amount)
+	var i FeeSchedule
+	err := row.Scan(
+		&i.ID,
+		&i.Currency,
+		&i.MinAmount,
+		&i.FlatFee,
+		&i.BasisPoints,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listFeeSchedules = `-- name: ListFeeSchedules :many
+SELECT id, currency, min_amount, flat_fee, basis_points, created_at
+FROM fee_schedules
+WHERE currency = $1
+ORDER BY min_amount
+`
+
+func (q *Queries) ListFeeSchedules(ctx context.Context, currency string) ([]FeeSchedule, error) {
+	rows, err := q.db.Query(ctx, listFeeSchedules, currency)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FeeSchedule{}
+	for rows.Next() {
+		var i FeeSchedule
+		if err := rows.Scan(
+			&i.ID,
+			&i.Currency,
+			&i.MinAmount,
+			&i.FlatFee,
+			&i.BasisPoints,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
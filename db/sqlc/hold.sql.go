@@ -0,0 +1,215 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.20.0
+// source: hold.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createHold = `-- name: CreateHold :one
+INSERT INTO holds (account_id,
+                   amount,
+                   expires_at)
+VALUES ($1, $2, $3)
+RETURNING id, account_id, amount, captured_amount, transfer_id, status, expires_at, created_at, updated_at
+`
+
+type CreateHoldParams struct {
+	AccountID int64     `json:"account_id"`
+	Amount    int64     `json:"amount"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func (q *Queries) CreateHold(ctx context.Context, arg CreateHoldParams) (Hold, error) {
+	row := q.db.QueryRow(ctx, createHold, arg.AccountID, arg.Amount, arg.ExpiresAt)
+	var i Hold
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Amount,
+		&i.CapturedAmount,
+		&i.TransferID,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getHold = `-- name: GetHold :one
+SELECT id, account_id, amount, captured_amount, transfer_id, status, expires_at, created_at, updated_at
+FROM holds
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetHold(ctx context.Context, id int64) (Hold, error) {
+	row := q.db.QueryRow(ctx, getHold, id)
+	var i Hold
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Amount,
+		&i.CapturedAmount,
+		&i.TransferID,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getHoldForUpdate = `-- name: GetHoldForUpdate :one
+SELECT id, account_id, amount, captured_amount, transfer_id, status, expires_at, created_at, updated_at
+FROM holds
+WHERE id = $1
+LIMIT 1
+FOR UPDATE
+`
+
+func (q *Queries) GetHoldForUpdate(ctx context.Context, id int64) (Hold, error) {
+	row := q.db.QueryRow(ctx, getHoldForUpdate, id)
+	var i Hold
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Amount,
+		&i.CapturedAmount,
+		&i.TransferID,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listExpiredHolds = `-- name: ListExpiredHolds :many
+SELECT id, account_id, amount, captured_amount, transfer_id, status, expires_at, created_at, updated_at
+FROM holds
+WHERE status = 'authorized'
+  AND expires_at <= now()
+ORDER BY id
+LIMIT $1
+`
+
+func (q *Queries) ListExpiredHolds(ctx context.Context, limit int32) ([]Hold, error) {
+	rows, err := q.db.Query(ctx, listExpiredHolds, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Hold{}
+	for rows.Next() {
+		var i Hold
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Amount,
+			&i.CapturedAmount,
+			&i.TransferID,
+			&i.Status,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listHolds = `-- name: ListHolds :many
+SELECT id, account_id, amount, captured_amount, transfer_id, status, expires_at, created_at, updated_at
+FROM holds
+WHERE account_id = $1
+ORDER BY id
+LIMIT $2 OFFSET $3
+`
+
+type ListHoldsParams struct {
+	AccountID int64 `json:"account_id"`
+	Limit     int32 `json:"limit"`
+	Offset    int32 `json:"offset"`
+}
+
+func (q *Queries) ListHolds(ctx context.Context, arg ListHoldsParams) ([]Hold, error) {
+	rows, err := q.db.Query(ctx, listHolds, arg.AccountID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Hold{}
+	for rows.Next() {
+		var i Hold
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Amount,
+			&i.CapturedAmount,
+			&i.TransferID,
+			&i.Status,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateHoldStatus = `-- name: UpdateHoldStatus :one
+UPDATE holds
+SET status          = $1,
+    captured_amount = $2,
+    transfer_id     = $3,
+    updated_at      = now()
+WHERE id = $4
+RETURNING id, account_id, amount, captured_amount, transfer_id, status, expires_at, created_at, updated_at
+`
+
+type UpdateHoldStatusParams struct {
+	Status         string      `json:"status"`
+	CapturedAmount int64       `json:"captured_amount"`
+	TransferID     pgtype.Int8 `json:"transfer_id"`
+	ID             int64       `json:"id"`
+}
+
+func (q *Queries) UpdateHoldStatus(ctx context.Context, arg UpdateHoldStatusParams) (Hold, error) {
+	row := q.db.QueryRow(ctx, updateHoldStatus,
+		arg.Status,
+		arg.CapturedAmount,
+		arg.TransferID,
+		arg.ID,
+	)
+	var i Hold
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Amount,
+		&i.CapturedAmount,
+		&i.TransferID,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,81 @@
+package db
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"goBank/util"
+	"testing"
+	"time"
+)
+
+func createRandomHold(t *testing.T, account Account) Hold {
+	arg := CreateHoldParams{
+		AccountID: account.ID,
+		Amount:    util.RandomMoney(),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	hold, err := testStore.CreateHold(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, hold)
+
+	require.Equal(t, arg.AccountID, hold.AccountID)
+	require.Equal(t, arg.Amount, hold.Amount)
+	require.Equal(t, HoldStatusAuthorized, hold.Status)
+	require.Zero(t, hold.CapturedAmount)
+	require.False(t, hold.TransferID.Valid)
+	require.WithinDuration(t, arg.ExpiresAt, hold.ExpiresAt, time.Second)
+
+	require.NotZero(t, hold.ID)
+	require.NotZero(t, hold.CreatedAt)
+
+	return hold
+}
+
+func TestCreateHold(t *testing.T) {
+	account := createRandomAccount(t)
+	createRandomHold(t, account)
+}
+
+func TestGetHold(t *testing.T) {
+	account := createRandomAccount(t)
+	hold1 := createRandomHold(t, account)
+
+	hold2, err := testStore.GetHold(context.Background(), hold1.ID)
+	require.NoError(t, err)
+	require.Equal(t, hold1.ID, hold2.ID)
+	require.Equal(t, hold1.Amount, hold2.Amount)
+	require.Equal(t, hold1.Status, hold2.Status)
+}
+
+func TestListHolds(t *testing.T) {
+	account := createRandomAccount(t)
+	for i := 0; i < 5; i++ {
+		createRandomHold(t, account)
+	}
+
+	holds, err := testStore.ListHolds(context.Background(), ListHoldsParams{
+		AccountID: account.ID,
+		Limit:     5,
+		Offset:    0,
+	})
+	require.NoError(t, err)
+	require.Len(t, holds, 5)
+
+	for _, hold := range holds {
+		require.Equal(t, account.ID, hold.AccountID)
+	}
+}
+
+func TestAddAccountHeldBalance(t *testing.T) {
+	account1 := createRandomAccount(t)
+
+	account2, err := testStore.AddAccountHeldBalance(context.Background(), AddAccountHeldBalanceParams{
+		ID:     account1.ID,
+		Amount: 10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance, account2.Balance)
+	require.Equal(t, int64(10), account2.HeldBalance)
+	require.Equal(t, account1.Balance-10, account2.AvailableBalance())
+}
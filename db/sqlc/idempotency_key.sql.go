@@ -0,0 +1,102 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.20.0
+// source: idempotency_key.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createIdempotencyKey = `-- name: CreateIdempotencyKey :one
+INSERT INTO idempotency_keys (username, key, request_hash)
+VALUES ($1, $2, $3)
+ON CONFLICT (username, key) DO NOTHING
+RETURNING username, key, request_hash, transfer_id, response, created_at
+`
+
+type CreateIdempotencyKeyParams struct {
+	Username    string `json:"username"`
+	Key         string `json:"key"`
+	RequestHash string `json:"request_hash"`
+}
+
+// Returns no rows when the user has already claimed the key
+func (q *Queries) CreateIdempotencyKey(ctx context.Context, arg CreateIdempotencyKeyParams) (IdempotencyKey, error) {
+	row := q.db.QueryRow(ctx, createIdempotencyKey, arg.Username, arg.Key, arg.RequestHash)
+	var i IdempotencyKey
+	err := row.Scan(
+		&i.Username,
+		&i.Key,
+		&i.RequestHash,
+		&i.TransferID,
+		&i.Response,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getIdempotencyKey = `-- name: GetIdempotencyKey :one
+SELECT username, key, request_hash, transfer_id, response, created_at
+FROM idempotency_keys
+WHERE username = $1
+  AND key = $2
+LIMIT 1
+`
+
+type GetIdempotencyKeyParams struct {
+	Username string `json:"username"`
+	Key      string `json:"key"`
+}
+
+func (q *Queries) GetIdempotencyKey(ctx context.Context, arg GetIdempotencyKeyParams) (IdempotencyKey, error) {
+	row := q.db.QueryRow(ctx, getIdempotencyKey, arg.Username, arg.Key)
+	var i IdempotencyKey
+	err := row.Scan(
+		&i.Username,
+		&i.Key,
+		&i.RequestHash,
+		&i.TransferID,
+		&i.Response,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const updateIdempotencyKeyResponse = `-- name: UpdateIdempotencyKeyResponse :one
+UPDATE idempotency_keys
+SET transfer_id = $1,
+    response    = $2
+WHERE username = $3
+  AND key = $4
+RETURNING username, key, request_hash, transfer_id, response, created_at
+`
+
+type UpdateIdempotencyKeyResponseParams struct {
+	TransferID pgtype.Int8 `json:"transfer_id"`
+	Response   []byte      `json:"response"`
+	Username   string      `json:"username"`
+	Key        string      `json:"key"`
+}
+
+func (q *Queries) UpdateIdempotencyKeyResponse(ctx context.Context, arg UpdateIdempotencyKeyResponseParams) (IdempotencyKey, error) {
+	row := q.db.QueryRow(ctx, updateIdempotencyKeyResponse,
+		arg.TransferID,
+		arg.Response,
+		arg.Username,
+		arg.Key,
+	)
+	var i IdempotencyKey
+	err := row.Scan(
+		&i.Username,
+		&i.Key,
+		&i.RequestHash,
+		&i.TransferID,
+		&i.Response,
+		&i.CreatedAt,
+	)
+	return i, err
+}
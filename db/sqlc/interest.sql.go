@@ -0,0 +1,273 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.20.0
+// source: interest.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createInterestAccrual = `-- name: CreateInterestAccrual :execrows
+INSERT INTO interest_accruals (account_id, accrual_date, balance, basis_points, amount)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (account_id, accrual_date) DO NOTHING
+`
+
+type CreateInterestAccrualParams struct {
+	AccountID   int64       `json:"account_id"`
+	AccrualDate pgtype.Date `json:"accrual_date"`
+	Balance     int64       `json:"balance"`
+	BasisPoints int64       `json:"basis_points"`
+	Amount      int64       `json:"amount"`
+}
+
+// A rerun of the same day keeps the first accrual
+func (q *Queries) CreateInterestAccrual(ctx context.Context, arg CreateInterestAccrualParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createInterestAccrual,
+		arg.AccountID,
+		arg.AccrualDate,
+		arg.Balance,
+		arg.BasisPoints,
+		arg.Amount,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const listAccountsWithUnpostedInterest = `-- name: ListAccountsWithUnpostedInterest :many
+SELECT DISTINCT account_id
+FROM interest_accruals
+WHERE transfer_id IS NULL
+  AND accrual_date < $1
+  AND account_id > $2
+ORDER BY account_id
+LIMIT $3
+`
+
+type ListAccountsWithUnpostedInterestParams struct {
+	Before  pgtype.Date `json:"before"`
+	AfterID int64       `json:"after_id"`
+	Limit   int32       `json:"limit"`
+}
+
+func (q *Queries) ListAccountsWithUnpostedInterest(ctx context.Context, arg ListAccountsWithUnpostedInterestParams) ([]int64, error) {
+	rows, err := q.db.Query(ctx, listAccountsWithUnpostedInterest, arg.Before, arg.AfterID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var account_id int64
+		if err := rows.Scan(&account_id); err != nil {
+			return nil, err
+		}
+		items = append(items, account_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInterestAccruals = `-- name: ListInterestAccruals :many
+SELECT id, account_id, accrual_date, balance, basis_points, amount, transfer_id, created_at
+FROM interest_accruals
+WHERE account_id = $1
+ORDER BY accrual_date DESC
+LIMIT $2 OFFSET $3
+`
+
+type ListInterestAccrualsParams struct {
+	AccountID int64 `json:"account_id"`
+	Limit     int32 `json:"limit"`
+	Offset    int32 `json:"offset"`
+}
+
+func (q *Queries) ListInterestAccruals(ctx context.Context, arg ListInterestAccrualsParams) ([]InterestAccrual, error) {
+	rows, err := q.db.Query(ctx, listInterestAccruals, arg.AccountID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []InterestAccrual{}
+	for rows.Next() {
+		var i InterestAccrual
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.AccrualDate,
+			&i.Balance,
+			&i.BasisPoints,
+			&i.Amount,
+			&i.TransferID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInterestBearingAccounts = `-- name: ListInterestBearingAccounts :many
+SELECT a.id,
+       r.basis_points,
+       (a.balance - COALESCE((SELECT SUM(e.amount)
+                              FROM entries e
+                              WHERE e.account_id = a.id
+                                AND e.created_at >= $1), 0))::bigint AS end_of_day_balance
+FROM accounts a
+         JOIN interest_rates r ON r.product = a.product AND r.currency = a.currency
+WHERE a.kind = 'customer'
+  AND a.status = 'active'
+  AND r.basis_points > 0
+  AND a.id > $2
+ORDER BY a.id
+LIMIT $3
+`
+
+type ListInterestBearingAccountsParams struct {
+	DayEnd  time.Time `json:"day_end"`
+	AfterID int64     `json:"after_id"`
+	Limit   int32     `json:"limit"`
+}
+
+type ListInterestBearingAccountsRow struct {
+	ID              int64 `json:"id"`
+	BasisPoints     int64 `json:"basis_points"`
+	EndOfDayBalance int64 `json:"end_of_day_balance"`
+}
+
+// The end of day balance is today's balance less every entry posted since the day ended
+func (q *Queries) ListInterestBearingAccounts(ctx context.Context, arg ListInterestBearingAccountsParams) ([]ListInterestBearingAccountsRow, error) {
+	rows, err := q.db.Query(ctx, listInterestBearingAccounts, arg.DayEnd, arg.AfterID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListInterestBearingAccountsRow{}
+	for rows.Next() {
+		var i ListInterestBearingAccountsRow
+		if err := rows.Scan(&i.ID, &i.BasisPoints, &i.EndOfDayBalance); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInterestRates = `-- name: ListInterestRates :many
+SELECT id, product, currency, basis_points, updated_at
+FROM interest_rates
+ORDER BY product, currency
+`
+
+func (q *Queries) ListInterestRates(ctx context.Context) ([]InterestRate, error) {
+	rows, err := q.db.Query(ctx, listInterestRates)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []InterestRate{}
+	for rows.Next() {
+		var i InterestRate
+		if err := rows.Scan(
+			&i.ID,
+			&i.Product,
+			&i.Currency,
+			&i.BasisPoints,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markInterestPosted = `-- name: MarkInterestPosted :execrows
+UPDATE interest_accruals
+SET transfer_id = $1
+WHERE account_id = $2
+  AND transfer_id IS NULL
+  AND accrual_date < $3
+`
+
+type MarkInterestPostedParams struct {
+	TransferID pgtype.Int8 `json:"transfer_id"`
+	AccountID  int64       `json:"account_id"`
+	Before     pgtype.Date `json:"before"`
+}
+
+func (q *Queries) MarkInterestPosted(ctx context.Context, arg MarkInterestPostedParams) (int64, error) {
+	result, err := q.db.Exec(ctx, markInterestPosted, arg.TransferID, arg.AccountID, arg.Before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const sumUnpostedInterest = `-- name: SumUnpostedInterest :one
+SELECT COALESCE(SUM(amount), 0)::bigint AS total
+FROM interest_accruals
+WHERE account_id = $1
+  AND transfer_id IS NULL
+  AND accrual_date < $2
+`
+
+type SumUnpostedInterestParams struct {
+	AccountID int64       `json:"account_id"`
+	Before    pgtype.Date `json:"before"`
+}
+
+func (q *Queries) SumUnpostedInterest(ctx context.Context, arg SumUnpostedInterestParams) (int64, error) {
+	row := q.db.QueryRow(ctx, sumUnpostedInterest, arg.AccountID, arg.Before)
+	var total int64
+	err := row.Scan(&total)
+	return total, err
+}
+
+const upsertInterestRate = `-- name: UpsertInterestRate :one
+INSERT INTO interest_rates (product, currency, basis_points)
+VALUES ($1, $2, $3)
+ON CONFLICT (product, currency)
+    DO UPDATE SET basis_points = EXCLUDED.basis_points,
+                  updated_at   = now()
+RETURNING id, product, currency, basis_points, updated_at
+`
+
+type UpsertInterestRateParams struct {
+	Product     string `json:"product"`
+	Currency    string `json:"currency"`
+	BasisPoints int64  `json:"basis_points"`
+}
+
+func (q *Queries) UpsertInterestRate(ctx context.Context, arg UpsertInterestRateParams) (InterestRate, error) {
+	row := q.db.QueryRow(ctx, upsertInterestRate, arg.Product, arg.Currency, arg.BasisPoints)
+	var i InterestRate
+	err := row.Scan(
+		&i.ID,
+		&i.Product,
+		&i.Currency,
+		&i.BasisPoints,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
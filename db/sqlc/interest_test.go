@@ -0,0 +1,85 @@
+package db
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestDailyInterest(t *testing.T) {
+	// 365.00 at 1% earns exactly one cent a day
+	require.Equal(t, int64(InterestScale), DailyInterest(36500, 100))
+	// a single cent at 2.5% earns a sliver of a cent that still adds up over the month
+	require.Equal(t, int64(68), DailyInterest(1, 250))
+	require.Zero(t, DailyInterest(0, 250))
+	require.Zero(t, DailyInterest(-5000, 250))
+	require.Zero(t, DailyInterest(5000, 0))
+}
+
+func TestRoundInterest(t *testing.T) {
+	require.Equal(t, int64(0), RoundInterest(InterestScale/2-1))
+	require.Equal(t, int64(1), RoundInterest(InterestScale/2))
+	require.Equal(t, int64(12), RoundInterest(12*InterestScale+InterestScale/3))
+}
+
+func TestPostInterestTx(t *testing.T) {
+	// XTS is reserved for testing, so no other test touches this expense account
+	account := createRandomAccountWithCurrency(t, 36500, "XTS")
+	before := time.Now().UTC().Truncate(24 * time.Hour)
+
+	for day := 1; day <= 3; day++ {
+		created, err := testStore.CreateInterestAccrual(context.Background(), CreateInterestAccrualParams{
+			AccountID:   account.ID,
+			AccrualDate: pgtype.Date{Time: before.AddDate(0, 0, -day), Valid: true},
+			Balance:     account.Balance,
+			BasisPoints: 100,
+			Amount:      DailyInterest(account.Balance, 100),
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(1), created)
+	}
+
+	// accruing the same day twice keeps the first one
+	created, err := testStore.CreateInterestAccrual(context.Background(), CreateInterestAccrualParams{
+		AccountID:   account.ID,
+		AccrualDate: pgtype.Date{Time: before.AddDate(0, 0, -1), Valid: true},
+		Balance:     account.Balance,
+		BasisPoints: 100,
+		Amount:      DailyInterest(account.Balance, 100),
+	})
+	require.NoError(t, err)
+	require.Zero(t, created)
+
+	result, err := testStore.PostInterestTx(context.Background(), PostInterestTxParams{
+		AccountID: account.ID,
+		Before:    before,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), result.Accruals)
+	require.Equal(t, int64(3), result.Transfer.Amount)
+	require.Equal(t, account.ID, result.ToAccount.ID)
+	require.Equal(t, account.Balance+3, result.ToAccount.Balance)
+	require.Equal(t, AccountKindExpense, result.FromAccount.Kind)
+	require.Equal(t, int64(3), result.ToEntry.Amount)
+
+	// everything is paid, so a second run posts nothing
+	result, err = testStore.PostInterestTx(context.Background(), PostInterestTxParams{
+		AccountID: account.ID,
+		Before:    before,
+	})
+	require.NoError(t, err)
+	require.Zero(t, result.Accruals)
+	require.Zero(t, result.Transfer.ID)
+
+	accruals, err := testStore.ListInterestAccruals(context.Background(), ListInterestAccrualsParams{
+		AccountID: account.ID,
+		Limit:     5,
+	})
+	require.NoError(t, err)
+	require.Len(t, accruals, 3)
+	for _, accrual := range accruals {
+		require.True(t, accrual.TransferID.Valid)
+	}
+}
@@ -35,5 +35,14 @@ func TestMain(m *testing.M) {
 
 	testStore = NewStore(connPool)
 
+	//The tests also move money in XTS, the code reserved for testing
+	currencies := []string{"XTS"}
+	for _, currency := range config.Currencies {
+		currencies = append(currencies, currency.Code)
+	}
+	if err = testStore.OpenSystemAccounts(context.Background(), currencies); err != nil {
+		log.Fatal("can't open system accounts:", err)
+	}
+
 	os.Exit(m.Run())
 }
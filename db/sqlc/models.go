@@ -5,9 +5,11 @@
 package db
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Account struct {
@@ -16,6 +18,59 @@ type Account struct {
 	Balance   int64     `json:"balance"`
 	Currency  string    `json:"currency"`
 	CreatedAt time.Time `json:"created_at"`
+	// how far below zero the balance may go
+	OverdraftLimit int64 `json:"overdraft_limit"`
+	// sum of authorized holds, available balance is balance - held_balance
+	HeldBalance int64 `json:"held_balance"`
+	// active, frozen or closed
+	Status string `json:"status"`
+	// customer, or the purpose of an account the bank owns
+	Kind string `json:"kind"`
+	// checking or savings, picks the interest rate together with currency
+	Product string `json:"product"`
+}
+
+type AccountStatusChange struct {
+	ID         int64  `json:"id"`
+	AccountID  int64  `json:"account_id"`
+	FromStatus string `json:"from_status"`
+	ToStatus   string `json:"to_status"`
+	// the banker who changed the status
+	ChangedBy string    `json:"changed_by"`
+	Reason    string    `json:"reason"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// overrides the owner's role limits for one account, a null column keeps the role's
+type AccountTransferLimit struct {
+	AccountID   int64       `json:"account_id"`
+	MaxAmount   pgtype.Int8 `json:"max_amount"`
+	DailyAmount pgtype.Int8 `json:"daily_amount"`
+	DailyCount  pgtype.Int8 `json:"daily_count"`
+	UpdatedBy   string      `json:"updated_by"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+}
+
+type BalanceSnapshot struct {
+	ID           int64       `json:"id"`
+	AccountID    int64       `json:"account_id"`
+	SnapshotDate pgtype.Date `json:"snapshot_date"`
+	// the account's balance at the end of snapshot_date in UTC
+	Balance   int64     `json:"balance"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Deposit struct {
+	ID        int64 `json:"id"`
+	AccountID int64 `json:"account_id"`
+	Amount    int64 `json:"amount"`
+	// the transfer from the bank's cash account in the currency
+	TransferID int64 `json:"transfer_id"`
+	// the banker who took in the funds
+	DepositedBy string `json:"deposited_by"`
+	// the receipt or slip number the funds came in with
+	Reference string    `json:"reference"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 type Entry struct {
@@ -24,6 +79,150 @@ type Entry struct {
 	// can be negative or positive
 	Amount    int64     `json:"amount"`
 	CreatedAt time.Time `json:"created_at"`
+	// the transfer that posted this entry
+	TransferID pgtype.Int8 `json:"transfer_id"`
+}
+
+type ExchangeQuote struct {
+	ID           uuid.UUID `json:"id"`
+	Username     string    `json:"username"`
+	FromCurrency string    `json:"from_currency"`
+	ToCurrency   string    `json:"to_currency"`
+	// to_currency per one from_currency, scaled by 10^8
+	Rate      int64     `json:"rate"`
+	IsUsed    bool      `json:"is_used"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ExchangeRate struct {
+	ID           int64  `json:"id"`
+	FromCurrency string `json:"from_currency"`
+	ToCurrency   string `json:"to_currency"`
+	// to_currency per one from_currency, scaled by 10^8
+	Rate      int64     `json:"rate"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type FeeSchedule struct {
+	ID       int64  `json:"id"`
+	Currency string `json:"currency"`
+	// the tier applies from this amount up to the next tier's min_amount
+	MinAmount int64 `json:"min_amount"`
+	FlatFee   int64 `json:"flat_fee"`
+	// percentage of the amount in hundredths of a percent, added to flat_fee
+	BasisPoints int64     `json:"basis_points"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type Hold struct {
+	ID             int64       `json:"id"`
+	AccountID      int64       `json:"account_id"`
+	Amount         int64       `json:"amount"`
+	CapturedAmount int64       `json:"captured_amount"`
+	TransferID     pgtype.Int8 `json:"transfer_id"`
+	// authorized, captured, voided or expired
+	Status    string    `json:"status"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type IdempotencyKey struct {
+	// keys are chosen by clients, so they're only unique per user
+	Username string `json:"username"`
+	Key      string `json:"key"`
+	// hash of the request the key was first used with
+	RequestHash string      `json:"request_hash"`
+	TransferID  pgtype.Int8 `json:"transfer_id"`
+	Response    []byte      `json:"response"`
+	CreatedAt   time.Time   `json:"created_at"`
+}
+
+type InterestAccrual struct {
+	ID          int64       `json:"id"`
+	AccountID   int64       `json:"account_id"`
+	AccrualDate pgtype.Date `json:"accrual_date"`
+	// the account's balance at the end of accrual_date
+	Balance     int64 `json:"balance"`
+	BasisPoints int64 `json:"basis_points"`
+	// one day of interest in millionths of the currency's minor unit
+	Amount int64 `json:"amount"`
+	// the monthly transfer that paid it, null until then
+	TransferID pgtype.Int8 `json:"transfer_id"`
+	CreatedAt  time.Time   `json:"created_at"`
+}
+
+type InterestRate struct {
+	ID       int64  `json:"id"`
+	Product  string `json:"product"`
+	Currency string `json:"currency"`
+	// yearly rate in hundredths of a percent
+	BasisPoints int64     `json:"basis_points"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type PendingTransfer struct {
+	ID            int64  `json:"id"`
+	FromAccountID int64  `json:"from_account_id"`
+	ToAccountID   int64  `json:"to_account_id"`
+	Amount        int64  `json:"amount"`
+	ToAmount      int64  `json:"to_amount"`
+	ExchangeRate  int64  `json:"exchange_rate"`
+	Initiator     string `json:"initiator"`
+	// the banker who approved or rejected it, never the initiator
+	Reviewer pgtype.Text `json:"reviewer"`
+	// pending, approved, rejected or expired
+	Status            string          `json:"status"`
+	Reason            string          `json:"reason"`
+	TransferID        pgtype.Int8     `json:"transfer_id"`
+	ExpiresAt         time.Time       `json:"expires_at"`
+	CreatedAt         time.Time       `json:"created_at"`
+	UpdatedAt         time.Time       `json:"updated_at"`
+	InitiatorRole     string          `json:"initiator_role"`
+	Description       string          `json:"description"`
+	ExternalReference string          `json:"external_reference"`
+	Metadata          json.RawMessage `json:"metadata"`
+}
+
+type ReconciliationDiscrepancy struct {
+	ID       int64 `json:"id"`
+	ReportID int64 `json:"report_id"`
+	// balance when an account's balance isn't the sum of its entries, entries when a transfer doesn't have exactly its two entries
+	Kind       string      `json:"kind"`
+	AccountID  pgtype.Int8 `json:"account_id"`
+	TransferID pgtype.Int8 `json:"transfer_id"`
+	// sum of the entries for balance, 2 for entries or 3 when the transfer charged a fee
+	Expected int64 `json:"expected"`
+	// the account balance for balance, the entries pointing at the transfer for entries
+	Actual    int64     `json:"actual"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ReconciliationReport struct {
+	ID int64 `json:"id"`
+	// the banker who asked for it, null for scheduled runs
+	TriggeredBy pgtype.Text `json:"triggered_by"`
+	// running or completed
+	Status           string             `json:"status"`
+	AccountsChecked  int64              `json:"accounts_checked"`
+	TransfersChecked int64              `json:"transfers_checked"`
+	DiscrepancyCount int64              `json:"discrepancy_count"`
+	CreatedAt        time.Time          `json:"created_at"`
+	CompletedAt      pgtype.Timestamptz `json:"completed_at"`
+}
+
+type RoleTransferLimit struct {
+	ID       int64  `json:"id"`
+	Role     string `json:"role"`
+	Currency string `json:"currency"`
+	// largest single transfer, null for no limit
+	MaxAmount pgtype.Int8 `json:"max_amount"`
+	// most sent in total per UTC day, null for no limit
+	DailyAmount pgtype.Int8 `json:"daily_amount"`
+	// most transfers sent per UTC day, null for no limit
+	DailyCount pgtype.Int8 `json:"daily_count"`
+	UpdatedAt  time.Time   `json:"updated_at"`
 }
 
 type Session struct {
@@ -35,6 +234,59 @@ type Session struct {
 	IsBlocked    bool      `json:"is_blocked"`
 	ExpiresAt    time.Time `json:"expires_at"`
 	CreatedAt    time.Time `json:"created_at"`
+	// the session created at login, every session its refresh token was rotated into shares it
+	FamilyID uuid.UUID `json:"family_id"`
+	// the session whose refresh token was exchanged for this one
+	PreviousSessionID pgtype.UUID `json:"previous_session_id"`
+	// when its refresh token was exchanged for a new one, presenting it again after that means it was stolen
+	RotatedAt pgtype.Timestamptz `json:"rotated_at"`
+}
+
+type StandingOrder struct {
+	ID            int64  `json:"id"`
+	Owner         string `json:"owner"`
+	FromAccountID int64  `json:"from_account_id"`
+	ToAccountID   int64  `json:"to_account_id"`
+	Amount        int64  `json:"amount"`
+	// cron expression or @every interval
+	Schedule  string    `json:"schedule"`
+	NextRunAt time.Time `json:"next_run_at"`
+	// no runs are scheduled after this time, null runs forever
+	EndAt     pgtype.Timestamptz `json:"end_at"`
+	IsActive  bool               `json:"is_active"`
+	CreatedAt time.Time          `json:"created_at"`
+	UpdatedAt time.Time          `json:"updated_at"`
+}
+
+type StandingOrderRun struct {
+	ID              int64       `json:"id"`
+	StandingOrderID int64       `json:"standing_order_id"`
+	ScheduledAt     time.Time   `json:"scheduled_at"`
+	TransferID      pgtype.Int8 `json:"transfer_id"`
+	// succeeded or failed
+	Status    string    `json:"status"`
+	Error     string    `json:"error"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Statement struct {
+	ID          int64     `json:"id"`
+	AccountID   int64     `json:"account_id"`
+	PeriodStart time.Time `json:"period_start"`
+	// exclusive, entries posted at period_end belong to the next statement
+	PeriodEnd      time.Time `json:"period_end"`
+	OpeningBalance int64     `json:"opening_balance"`
+	ClosingBalance int64     `json:"closing_balance"`
+	TotalCredits   int64     `json:"total_credits"`
+	// sum of the negative entries, stored as a positive number
+	TotalDebits int64  `json:"total_debits"`
+	EntryCount  int64  `json:"entry_count"`
+	Csv         string `json:"csv"`
+	// printable form of the statement, a browser prints it to PDF
+	Html string `json:"html"`
+	// when it was sent to the owner, null until then
+	EmailedAt pgtype.Timestamptz `json:"emailed_at"`
+	CreatedAt time.Time          `json:"created_at"`
 }
 
 type Transfer struct {
@@ -44,6 +296,22 @@ type Transfer struct {
 	// must be positive
 	Amount    int64     `json:"amount"`
 	CreatedAt time.Time `json:"created_at"`
+	// amount credited in the to account's currency
+	ToAmount int64 `json:"to_amount"`
+	// applied rate scaled by 10^8, 100000000 when both accounts share a currency
+	ExchangeRate int64 `json:"exchange_rate"`
+	// the original transfer when this one refunds it
+	ReversalOf pgtype.Int8 `json:"reversal_of"`
+	// how much of to_amount has been refunded so far
+	ReversedAmount int64 `json:"reversed_amount"`
+	// taken out of amount and credited to the bank's revenue account
+	Fee int64 `json:"fee"`
+	// free text memo from the sender, searchable
+	Description string `json:"description"`
+	// the sender's own id for the payment, like an invoice number
+	ExternalReference string `json:"external_reference"`
+	// free-form JSON object the bank stores but never reads
+	Metadata json.RawMessage `json:"metadata"`
 }
 
 type User struct {
@@ -54,7 +322,8 @@ type User struct {
 	PasswordChangedAt time.Time `json:"password_changed_at"`
 	CreatedAt         time.Time `json:"created_at"`
 	IsEmailVerified   bool      `json:"is_email_verified"`
-	Role              string    `json:"role"`
+	// depositor, banker, or system for the bank user that owns its own ledger accounts
+	Role string `json:"role"`
 }
 
 type VerifyEmail struct {
@@ -66,3 +335,21 @@ type VerifyEmail struct {
 	CreatedAt  time.Time `json:"created_at"`
 	ExpiredAt  time.Time `json:"expired_at"`
 }
+
+type Withdrawal struct {
+	ID        int64 `json:"id"`
+	AccountID int64 `json:"account_id"`
+	Amount    int64 `json:"amount"`
+	// pending until the processor settles it, then settled or failed
+	Status      string `json:"status"`
+	RequestedBy string `json:"requested_by"`
+	// the transfer into the bank's clearing account that holds the funds while pending
+	TransferID int64 `json:"transfer_id"`
+	// from clearing to cash once settled, back to the account once failed
+	SettlementTransferID pgtype.Int8 `json:"settlement_transfer_id"`
+	// the processor's id for the payout
+	ProcessorReference string    `json:"processor_reference"`
+	FailureReason      string    `json:"failure_reason"`
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
+}
@@ -0,0 +1,304 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.20.0
+// source: pending_transfer.sql
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createPendingTransfer = `-- name: CreatePendingTransfer :one
+INSERT INTO pending_transfers (from_account_id,
+                               to_account_id,
+                               amount,
+                               to_amount,
+                               exchange_rate,
+                               initiator,
+                               initiator_role,
+                               expires_at,
+                               description,
+                               external_reference,
+                               metadata)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+RETURNING id, from_account_id, to_account_id, amount, to_amount, exchange_rate, initiator, reviewer, status, reason, transfer_id, expires_at, created_at, updated_at, initiator_role, description, external_reference, metadata
+`
+
+type CreatePendingTransferParams struct {
+	FromAccountID     int64           `json:"from_account_id"`
+	ToAccountID       int64           `json:"to_account_id"`
+	Amount            int64           `json:"amount"`
+	ToAmount          int64           `json:"to_amount"`
+	ExchangeRate      int64           `json:"exchange_rate"`
+	Initiator         string          `json:"initiator"`
+	InitiatorRole     string          `json:"initiator_role"`
+	ExpiresAt         time.Time       `json:"expires_at"`
+	Description       string          `json:"description"`
+	ExternalReference string          `json:"external_reference"`
+	Metadata          json.RawMessage `json:"metadata"`
+}
+
+func (q *Queries) CreatePendingTransfer(ctx context.Context, arg CreatePendingTransferParams) (PendingTransfer, error) {
+	row := q.db.QueryRow(ctx, createPendingTransfer,
+		arg.FromAccountID,
+		arg.ToAccountID,
+		arg.Amount,
+		arg.ToAmount,
+		arg.ExchangeRate,
+		arg.Initiator,
+		arg.InitiatorRole,
+		arg.ExpiresAt,
+		arg.Description,
+		arg.ExternalReference,
+		arg.Metadata,
+	)
+	var i PendingTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.ToAmount,
+		&i.ExchangeRate,
+		&i.Initiator,
+		&i.Reviewer,
+		&i.Status,
+		&i.Reason,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.InitiatorRole,
+		&i.Description,
+		&i.ExternalReference,
+		&i.Metadata,
+	)
+	return i, err
+}
+
+const expirePendingTransfers = `-- name: ExpirePendingTransfers :many
+UPDATE pending_transfers
+SET status     = 'expired',
+    updated_at = now()
+WHERE id IN (SELECT id
+             FROM pending_transfers
+             WHERE status = 'pending'
+               AND expires_at <= now()
+             ORDER BY id
+             LIMIT $1 FOR UPDATE SKIP LOCKED)
+RETURNING id, from_account_id, to_account_id, amount, to_amount, exchange_rate, initiator, reviewer, status, reason, transfer_id, expires_at, created_at, updated_at, initiator_role, description, external_reference, metadata
+`
+
+func (q *Queries) ExpirePendingTransfers(ctx context.Context, limit int32) ([]PendingTransfer, error) {
+	rows, err := q.db.Query(ctx, expirePendingTransfers, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PendingTransfer{}
+	for rows.Next() {
+		var i PendingTransfer
+		if err := rows.Scan(
+			&i.ID,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.ToAmount,
+			&i.ExchangeRate,
+			&i.Initiator,
+			&i.Reviewer,
+			&i.Status,
+			&i.Reason,
+			&i.TransferID,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.InitiatorRole,
+			&i.Description,
+			&i.ExternalReference,
+			&i.Metadata,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPendingTransfer = `-- name: GetPendingTransfer :one
+SELECT id, from_account_id, to_account_id, amount, to_amount, exchange_rate, initiator, reviewer, status, reason, transfer_id, expires_at, created_at, updated_at, initiator_role, description, external_reference, metadata
+FROM pending_transfers
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetPendingTransfer(ctx context.Context, id int64) (PendingTransfer, error) {
+	row := q.db.QueryRow(ctx, getPendingTransfer, id)
+	var i PendingTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.ToAmount,
+		&i.ExchangeRate,
+		&i.Initiator,
+		&i.Reviewer,
+		&i.Status,
+		&i.Reason,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.InitiatorRole,
+		&i.Description,
+		&i.ExternalReference,
+		&i.Metadata,
+	)
+	return i, err
+}
+
+const getPendingTransferForUpdate = `-- name: GetPendingTransferForUpdate :one
+SELECT id, from_account_id, to_account_id, amount, to_amount, exchange_rate, initiator, reviewer, status, reason, transfer_id, expires_at, created_at, updated_at, initiator_role, description, external_reference, metadata
+FROM pending_transfers
+WHERE id = $1
+LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetPendingTransferForUpdate(ctx context.Context, id int64) (PendingTransfer, error) {
+	row := q.db.QueryRow(ctx, getPendingTransferForUpdate, id)
+	var i PendingTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.ToAmount,
+		&i.ExchangeRate,
+		&i.Initiator,
+		&i.Reviewer,
+		&i.Status,
+		&i.Reason,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.InitiatorRole,
+		&i.Description,
+		&i.ExternalReference,
+		&i.Metadata,
+	)
+	return i, err
+}
+
+const listPendingTransfers = `-- name: ListPendingTransfers :many
+SELECT id, from_account_id, to_account_id, amount, to_amount, exchange_rate, initiator, reviewer, status, reason, transfer_id, expires_at, created_at, updated_at, initiator_role, description, external_reference, metadata
+FROM pending_transfers
+WHERE status = 'pending'
+ORDER BY id
+LIMIT $1 OFFSET $2
+`
+
+type ListPendingTransfersParams struct {
+	Limit  int32 `json:"limit"`
+	Offset int32 `json:"offset"`
+}
+
+func (q *Queries) ListPendingTransfers(ctx context.Context, arg ListPendingTransfersParams) ([]PendingTransfer, error) {
+	rows, err := q.db.Query(ctx, listPendingTransfers, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PendingTransfer{}
+	for rows.Next() {
+		var i PendingTransfer
+		if err := rows.Scan(
+			&i.ID,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.ToAmount,
+			&i.ExchangeRate,
+			&i.Initiator,
+			&i.Reviewer,
+			&i.Status,
+			&i.Reason,
+			&i.TransferID,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.InitiatorRole,
+			&i.Description,
+			&i.ExternalReference,
+			&i.Metadata,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updatePendingTransferStatus = `-- name: UpdatePendingTransferStatus :one
+UPDATE pending_transfers
+SET status      = $1,
+    reviewer    = $2,
+    reason      = $3,
+    transfer_id = $4,
+    updated_at  = now()
+WHERE id = $5
+RETURNING id, from_account_id, to_account_id, amount, to_amount, exchange_rate, initiator, reviewer, status, reason, transfer_id, expires_at, created_at, updated_at, initiator_role, description, external_reference, metadata
+`
+
+type UpdatePendingTransferStatusParams struct {
+	Status     string      `json:"status"`
+	Reviewer   pgtype.Text `json:"reviewer"`
+	Reason     string      `json:"reason"`
+	TransferID pgtype.Int8 `json:"transfer_id"`
+	ID         int64       `json:"id"`
+}
+
+func (q *Queries) UpdatePendingTransferStatus(ctx context.Context, arg UpdatePendingTransferStatusParams) (PendingTransfer, error) {
+	row := q.db.QueryRow(ctx, updatePendingTransferStatus,
+		arg.Status,
+		arg.Reviewer,
+		arg.Reason,
+		arg.TransferID,
+		arg.ID,
+	)
+	var i PendingTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.ToAmount,
+		&i.ExchangeRate,
+		&i.Initiator,
+		&i.Reviewer,
+		&i.Status,
+		&i.Reason,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.InitiatorRole,
+		&i.Description,
+		&i.ExternalReference,
+		&i.Metadata,
+	)
+	return i, err
+}
@@ -0,0 +1,61 @@
+package db
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func createRandomPendingTransfer(t *testing.T, account1, account2 Account, expiresAt time.Time) PendingTransfer {
+	arg := CreatePendingTransferParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+		ToAmount:      10,
+		ExchangeRate:  IdentityExchangeRate,
+		Initiator:     account1.Owner,
+		ExpiresAt:     expiresAt,
+	}
+
+	pending, err := testStore.CreatePendingTransfer(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, pending)
+
+	require.Equal(t, arg.FromAccountID, pending.FromAccountID)
+	require.Equal(t, arg.ToAccountID, pending.ToAccountID)
+	require.Equal(t, arg.Amount, pending.Amount)
+	require.Equal(t, arg.Initiator, pending.Initiator)
+	require.Equal(t, PendingTransferStatusPending, pending.Status)
+	require.False(t, pending.Reviewer.Valid)
+	require.False(t, pending.TransferID.Valid)
+
+	return pending
+}
+
+func TestCreatePendingTransfer(t *testing.T) {
+	createRandomPendingTransfer(t, createRandomAccount(t), createRandomAccount(t), time.Now().Add(time.Hour))
+}
+
+func TestExpirePendingTransfers(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	expired := createRandomPendingTransfer(t, account1, account2, time.Now().Add(-time.Minute))
+	notExpired := createRandomPendingTransfer(t, account1, account2, time.Now().Add(time.Hour))
+
+	pendings, err := testStore.ExpirePendingTransfers(context.Background(), 1000)
+	require.NoError(t, err)
+
+	ids := make(map[int64]bool)
+	for _, pending := range pendings {
+		require.Equal(t, PendingTransferStatusExpired, pending.Status)
+		ids[pending.ID] = true
+	}
+	require.True(t, ids[expired.ID])
+	require.False(t, ids[notExpired.ID])
+
+	pending, err := testStore.GetPendingTransfer(context.Background(), notExpired.ID)
+	require.NoError(t, err)
+	require.Equal(t, PendingTransferStatusPending, pending.Status)
+}
@@ -8,30 +8,128 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
 	AddAccountBalance(ctx context.Context, arg AddAccountBalanceParams) (Account, error)
+	AddAccountHeldBalance(ctx context.Context, arg AddAccountHeldBalanceParams) (Account, error)
+	AddTransferReversedAmount(ctx context.Context, arg AddTransferReversedAmountParams) (Transfer, error)
+	BlockSession(ctx context.Context, arg BlockSessionParams) (Session, error)
+	BlockSessionFamily(ctx context.Context, familyID uuid.UUID) (int64, error)
+	BlockUserSessions(ctx context.Context, username string) (int64, error)
+	CompleteReconciliationReport(ctx context.Context, arg CompleteReconciliationReportParams) (ReconciliationReport, error)
 	CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error)
+	CreateAccountStatusChange(ctx context.Context, arg CreateAccountStatusChangeParams) (AccountStatusChange, error)
+	// The balance at the end of the day is today's balance less every entry posted since, a rerun keeps the first snapshot
+	CreateBalanceSnapshots(ctx context.Context, arg CreateBalanceSnapshotsParams) (int64, error)
+	CreateDeposit(ctx context.Context, arg CreateDepositParams) (Deposit, error)
 	CreateEntry(ctx context.Context, arg CreateEntryParams) (Entry, error)
+	CreateExchangeQuote(ctx context.Context, arg CreateExchangeQuoteParams) (ExchangeQuote, error)
+	CreateExchangeRate(ctx context.Context, arg CreateExchangeRateParams) (ExchangeRate, error)
+	CreateFeeSchedule(ctx context.Context, arg CreateFeeScheduleParams) (FeeSchedule, error)
+	CreateHold(ctx context.Context, arg CreateHoldParams) (Hold, error)
+	// Returns no rows when the user has already claimed the key
+	CreateIdempotencyKey(ctx context.Context, arg CreateIdempotencyKeyParams) (IdempotencyKey, error)
+	// A rerun of the same day keeps the first accrual
+	CreateInterestAccrual(ctx context.Context, arg CreateInterestAccrualParams) (int64, error)
+	CreatePendingTransfer(ctx context.Context, arg CreatePendingTransferParams) (PendingTransfer, error)
+	CreateReconciliationDiscrepancy(ctx context.Context, arg CreateReconciliationDiscrepancyParams) (ReconciliationDiscrepancy, error)
+	CreateReconciliationReport(ctx context.Context, triggeredBy pgtype.Text) (ReconciliationReport, error)
 	CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error)
+	CreateStandingOrder(ctx context.Context, arg CreateStandingOrderParams) (StandingOrder, error)
+	CreateStandingOrderRun(ctx context.Context, arg CreateStandingOrderRunParams) (StandingOrderRun, error)
+	// A rerun of the same period replaces the statement unless it was already emailed
+	CreateStatement(ctx context.Context, arg CreateStatementParams) (Statement, error)
+	// Only run at startup, the no-op update makes the upsert return the row when it already exists
+	CreateSystemAccount(ctx context.Context, arg CreateSystemAccountParams) (Account, error)
 	CreateTransfer(ctx context.Context, arg CreateTransferParams) (Transfer, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
 	CreateVerifyEmail(ctx context.Context, arg CreateVerifyEmailParams) (VerifyEmail, error)
-	DeleteAccount(ctx context.Context, id int64) error
+	CreateWithdrawal(ctx context.Context, arg CreateWithdrawalParams) (Withdrawal, error)
+	DeleteAccountTransferLimits(ctx context.Context, accountID int64) error
+	DeleteFeeSchedules(ctx context.Context, currency string) error
+	DeleteReconciliationDiscrepancies(ctx context.Context, reportID int64) error
+	ExpirePendingTransfers(ctx context.Context, limit int32) ([]PendingTransfer, error)
 	GetAccount(ctx context.Context, id int64) (Account, error)
 	// This NO KEY UPDATE prevents deadlock
 	GetAccountForUpdate(ctx context.Context, id int64) (Account, error)
+	GetAccountTransferLimits(ctx context.Context, accountID int64) (AccountTransferLimit, error)
+	// The latest snapshot taken before the day of at is the starting point and the entries posted since are added to it.
+	// An account without one goes back from its current balance instead.
+	GetBalanceAt(ctx context.Context, arg GetBalanceAtParams) (int64, error)
+	// Reversals refund someone else's transfer, so they don't use up the account's limits
+	GetDailyTransferUsage(ctx context.Context, arg GetDailyTransferUsageParams) (GetDailyTransferUsageRow, error)
+	GetDeposit(ctx context.Context, id int64) (Deposit, error)
 	GetEntry(ctx context.Context, id int64) (Entry, error)
+	GetExchangeQuoteForUpdate(ctx context.Context, id uuid.UUID) (ExchangeQuote, error)
+	GetFeeSchedule(ctx context.Context, arg GetFeeScheduleParams) (FeeSchedule, error)
+	GetHold(ctx context.Context, id int64) (Hold, error)
+	GetHoldForUpdate(ctx context.Context, id int64) (Hold, error)
+	GetIdempotencyKey(ctx context.Context, arg GetIdempotencyKeyParams) (IdempotencyKey, error)
+	GetLatestExchangeRate(ctx context.Context, arg GetLatestExchangeRateParams) (ExchangeRate, error)
+	GetPendingTransfer(ctx context.Context, id int64) (PendingTransfer, error)
+	GetPendingTransferForUpdate(ctx context.Context, id int64) (PendingTransfer, error)
+	GetReconciliationReport(ctx context.Context, id int64) (ReconciliationReport, error)
+	GetRoleTransferLimits(ctx context.Context, arg GetRoleTransferLimitsParams) (RoleTransferLimit, error)
 	GetSession(ctx context.Context, id uuid.UUID) (Session, error)
+	GetStandingOrder(ctx context.Context, id int64) (StandingOrder, error)
+	GetStandingOrderForUpdate(ctx context.Context, id int64) (StandingOrder, error)
+	GetStatementForPeriod(ctx context.Context, arg GetStatementForPeriodParams) (Statement, error)
+	// Doesn't lock the row, moveMoney locks it along with the other account in id order
+	GetSystemAccount(ctx context.Context, arg GetSystemAccountParams) (Account, error)
 	GetTransfer(ctx context.Context, id int64) (Transfer, error)
+	GetTransferForUpdate(ctx context.Context, id int64) (Transfer, error)
 	GetUser(ctx context.Context, username string) (User, error)
+	GetWithdrawal(ctx context.Context, id int64) (Withdrawal, error)
+	GetWithdrawalForUpdate(ctx context.Context, id int64) (Withdrawal, error)
+	ListAccountStatusChanges(ctx context.Context, accountID int64) ([]AccountStatusChange, error)
 	ListAccounts(ctx context.Context, arg ListAccountsParams) ([]Account, error)
+	ListAccountsWithUnpostedInterest(ctx context.Context, arg ListAccountsWithUnpostedInterestParams) ([]int64, error)
+	ListActiveSessions(ctx context.Context, username string) ([]Session, error)
+	// Each day's closing balance, from its snapshot when there is one, today's is the balance so far
+	ListDailyBalances(ctx context.Context, arg ListDailyBalancesParams) ([]ListDailyBalancesRow, error)
+	ListDueStandingOrders(ctx context.Context, limit int32) ([]StandingOrder, error)
 	ListEntries(ctx context.Context, arg ListEntriesParams) ([]Entry, error)
+	ListExpiredHolds(ctx context.Context, limit int32) ([]Hold, error)
+	ListFeeSchedules(ctx context.Context, currency string) ([]FeeSchedule, error)
+	ListHolds(ctx context.Context, arg ListHoldsParams) ([]Hold, error)
+	ListInterestAccruals(ctx context.Context, arg ListInterestAccrualsParams) ([]InterestAccrual, error)
+	// The end of day balance is today's balance less every entry posted since the day ended
+	ListInterestBearingAccounts(ctx context.Context, arg ListInterestBearingAccountsParams) ([]ListInterestBearingAccountsRow, error)
+	ListInterestRates(ctx context.Context) ([]InterestRate, error)
+	ListPendingTransfers(ctx context.Context, arg ListPendingTransfersParams) ([]PendingTransfer, error)
+	ListReconciliationDiscrepancies(ctx context.Context, reportID int64) ([]ReconciliationDiscrepancy, error)
+	ListReconciliationReports(ctx context.Context, arg ListReconciliationReportsParams) ([]ReconciliationReport, error)
+	ListSnapshotAccounts(ctx context.Context, arg ListSnapshotAccountsParams) ([]int64, error)
+	ListStandingOrderRuns(ctx context.Context, arg ListStandingOrderRunsParams) ([]StandingOrderRun, error)
+	ListStandingOrders(ctx context.Context, arg ListStandingOrdersParams) ([]StandingOrder, error)
+	// Customer accounts that can have activity in the period, closed ones only if they had some
+	ListStatementAccounts(ctx context.Context, arg ListStatementAccountsParams) ([]int64, error)
+	ListStatementEntries(ctx context.Context, arg ListStatementEntriesParams) ([]ListStatementEntriesRow, error)
 	ListTransfers(ctx context.Context, arg ListTransfersParams) ([]Transfer, error)
+	ListUsersByRole(ctx context.Context, role string) ([]User, error)
+	MarkInterestPosted(ctx context.Context, arg MarkInterestPostedParams) (int64, error)
+	MarkStatementEmailed(ctx context.Context, id int64) (Statement, error)
+	ReconcileAccounts(ctx context.Context, arg ReconcileAccountsParams) ([]ReconcileAccountsRow, error)
+	ReconcileTransfers(ctx context.Context, arg ReconcileTransfersParams) ([]ReconcileTransfersRow, error)
+	RotateSession(ctx context.Context, id uuid.UUID) (Session, error)
+	SearchTransfers(ctx context.Context, arg SearchTransfersParams) ([]Transfer, error)
+	SettleWithdrawal(ctx context.Context, arg SettleWithdrawalParams) (Withdrawal, error)
+	SumUnpostedInterest(ctx context.Context, arg SumUnpostedInterestParams) (int64, error)
 	UpdateAccount(ctx context.Context, arg UpdateAccountParams) (Account, error)
+	UpdateAccountOverdraftLimit(ctx context.Context, arg UpdateAccountOverdraftLimitParams) (Account, error)
+	UpdateAccountStatus(ctx context.Context, arg UpdateAccountStatusParams) (Account, error)
+	UpdateHoldStatus(ctx context.Context, arg UpdateHoldStatusParams) (Hold, error)
+	UpdateIdempotencyKeyResponse(ctx context.Context, arg UpdateIdempotencyKeyResponseParams) (IdempotencyKey, error)
+	UpdatePendingTransferStatus(ctx context.Context, arg UpdatePendingTransferStatusParams) (PendingTransfer, error)
+	UpdateStandingOrder(ctx context.Context, arg UpdateStandingOrderParams) (StandingOrder, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
 	UpdateVerifyEmail(ctx context.Context, arg UpdateVerifyEmailParams) (VerifyEmail, error)
+	UpsertAccountTransferLimits(ctx context.Context, arg UpsertAccountTransferLimitsParams) (AccountTransferLimit, error)
+	UpsertInterestRate(ctx context.Context, arg UpsertInterestRateParams) (InterestRate, error)
+	UpsertRoleTransferLimits(ctx context.Context, arg UpsertRoleTransferLimitsParams) (RoleTransferLimit, error)
+	UseExchangeQuote(ctx context.Context, id uuid.UUID) (ExchangeQuote, error)
 }
 
 var _ Querier = (*Queries)(nil)
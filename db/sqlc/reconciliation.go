@@ -0,0 +1,15 @@
+package db
+
+// Statuses of a reconciliation report
+const (
+	ReconciliationStatusRunning   = "running"
+	ReconciliationStatusCompleted = "completed"
+)
+
+// Kinds of discrepancy a reconciliation can find
+const (
+	// An account's balance isn't the sum of its entries
+	DiscrepancyKindBalance = "balance"
+	// A transfer doesn't have exactly one debit and one credit entry, plus one fee entry if it charged a fee
+	DiscrepancyKindEntries = "entries"
+)
@@ -0,0 +1,326 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.20.0
+// source: reconciliation.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const completeReconciliationReport = `-- name: CompleteReconciliationReport :one
+UPDATE reconciliation_reports
+SET status            = 'completed',
+    accounts_checked  = $1,
+    transfers_checked = $2,
+    discrepancy_count = $3,
+    completed_at      = now()
+WHERE id = $4
+RETURNING id, triggered_by, status, accounts_checked, transfers_checked, discrepancy_count, created_at, completed_at
+`
+
+type CompleteReconciliationReportParams struct {
+	AccountsChecked  int64 `json:"accounts_checked"`
+	TransfersChecked int64 `json:"transfers_checked"`
+	DiscrepancyCount int64 `json:"discrepancy_count"`
+	ID               int64 `json:"id"`
+}
+
+func (q *Queries) CompleteReconciliationReport(ctx context.Context, arg CompleteReconciliationReportParams) (ReconciliationReport, error) {
+	row := q.db.QueryRow(ctx, completeReconciliationReport,
+		arg.AccountsChecked,
+		arg.TransfersChecked,
+		arg.DiscrepancyCount,
+		arg.ID,
+	)
+	var i ReconciliationReport
+	err := row.Scan(
+		&i.ID,
+		&i.TriggeredBy,
+		&i.Status,
+		&i.AccountsChecked,
+		&i.TransfersChecked,
+		&i.DiscrepancyCount,
+		&i.CreatedAt,
+		&i.CompletedAt,
+	)
+	return i, err
+}
+
+const createReconciliationDiscrepancy = `-- name: CreateReconciliationDiscrepancy :one
+INSERT INTO reconciliation_discrepancies (report_id,
+                                          kind,
+                                          account_id,
+                                          transfer_id,
+                                          expected,
+                                          actual)
+VALUES ($1, $2, $3, $4, $5,
+        $6)
+RETURNING id, report_id, kind, account_id, transfer_id, expected, actual, created_at
+`
+
+type CreateReconciliationDiscrepancyParams struct {
+	ReportID   int64       `json:"report_id"`
+	Kind       string      `json:"kind"`
+	AccountID  pgtype.Int8 `json:"account_id"`
+	TransferID pgtype.Int8 `json:"transfer_id"`
+	Expected   int64       `json:"expected"`
+	Actual     int64       `json:"actual"`
+}
+
+func (q *Queries) CreateReconciliationDiscrepancy(ctx context.Context, arg CreateReconciliationDiscrepancyParams) (ReconciliationDiscrepancy, error) {
+	row := q.db.QueryRow(ctx, createReconciliationDiscrepancy,
+		arg.ReportID,
+		arg.Kind,
+		arg.AccountID,
+		arg.TransferID,
+		arg.Expected,
+		arg.Actual,
+	)
+	var i ReconciliationDiscrepancy
+	err := row.Scan(
+		&i.ID,
+		&i.ReportID,
+		&i.Kind,
+		&i.AccountID,
+		&i.TransferID,
+		&i.Expected,
+		&i.Actual,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createReconciliationReport = `-- name: CreateReconciliationReport :one
+INSERT INTO reconciliation_reports (triggered_by)
+VALUES ($1)
+RETURNING id, triggered_by, status, accounts_checked, transfers_checked, discrepancy_count, created_at, completed_at
+`
+
+func (q *Queries) CreateReconciliationReport(ctx context.Context, triggeredBy pgtype.Text) (ReconciliationReport, error) {
+	row := q.db.QueryRow(ctx, createReconciliationReport, triggeredBy)
+	var i ReconciliationReport
+	err := row.Scan(
+		&i.ID,
+		&i.TriggeredBy,
+		&i.Status,
+		&i.AccountsChecked,
+		&i.TransfersChecked,
+		&i.DiscrepancyCount,
+		&i.CreatedAt,
+		&i.CompletedAt,
+	)
+	return i, err
+}
+
+const deleteReconciliationDiscrepancies = `-- name: DeleteReconciliationDiscrepancies :exec
+DELETE
+FROM reconciliation_discrepancies
+WHERE report_id = $1
+`
+
+func (q *Queries) DeleteReconciliationDiscrepancies(ctx context.Context, reportID int64) error {
+	_, err := q.db.Exec(ctx, deleteReconciliationDiscrepancies, reportID)
+	return err
+}
+
+const getReconciliationReport = `-- name: GetReconciliationReport :one
+SELECT id, triggered_by, status, accounts_checked, transfers_checked, discrepancy_count, created_at, completed_at
+FROM reconciliation_reports
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetReconciliationReport(ctx context.Context, id int64) (ReconciliationReport, error) {
+	row := q.db.QueryRow(ctx, getReconciliationReport, id)
+	var i ReconciliationReport
+	err := row.Scan(
+		&i.ID,
+		&i.TriggeredBy,
+		&i.Status,
+		&i.AccountsChecked,
+		&i.TransfersChecked,
+		&i.DiscrepancyCount,
+		&i.CreatedAt,
+		&i.CompletedAt,
+	)
+	return i, err
+}
+
+const listReconciliationDiscrepancies = `-- name: ListReconciliationDiscrepancies :many
+SELECT id, report_id, kind, account_id, transfer_id, expected, actual, created_at
+FROM reconciliation_discrepancies
+WHERE report_id = $1
+ORDER BY id
+`
+
+func (q *Queries) ListReconciliationDiscrepancies(ctx context.Context, reportID int64) ([]ReconciliationDiscrepancy, error) {
+	rows, err := q.db.Query(ctx, listReconciliationDiscrepancies, reportID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ReconciliationDiscrepancy{}
+	for rows.Next() {
+		var i ReconciliationDiscrepancy
+		if err := rows.Scan(
+			&i.ID,
+			&i.ReportID,
+			&i.Kind,
+			&i.AccountID,
+			&i.TransferID,
+			&i.Expected,
+			&i.Actual,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReconciliationReports = `-- name: ListReconciliationReports :many
+SELECT id, triggered_by, status, accounts_checked, transfers_checked, discrepancy_count, created_at, completed_at
+FROM reconciliation_reports
+ORDER BY id DESC
+LIMIT $1 OFFSET $2
+`
+
+type ListReconciliationReportsParams struct {
+	Limit  int32 `json:"limit"`
+	Offset int32 `json:"offset"`
+}
+
+func (q *Queries) ListReconciliationReports(ctx context.Context, arg ListReconciliationReportsParams) ([]ReconciliationReport, error) {
+	rows, err := q.db.Query(ctx, listReconciliationReports, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ReconciliationReport{}
+	for rows.Next() {
+		var i ReconciliationReport
+		if err := rows.Scan(
+			&i.ID,
+			&i.TriggeredBy,
+			&i.Status,
+			&i.AccountsChecked,
+			&i.TransfersChecked,
+			&i.DiscrepancyCount,
+			&i.CreatedAt,
+			&i.CompletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const reconcileAccounts = `-- name: ReconcileAccounts :many
+SELECT a.id,
+       a.balance,
+       COALESCE((SELECT SUM(e.amount) FROM entries e WHERE e.account_id = a.id), 0)::bigint AS entries_total
+FROM accounts a
+WHERE a.id > $1
+ORDER BY a.id
+LIMIT $2
+`
+
+type ReconcileAccountsParams struct {
+	AfterID int64 `json:"after_id"`
+	Limit   int32 `json:"limit"`
+}
+
+type ReconcileAccountsRow struct {
+	ID           int64 `json:"id"`
+	Balance      int64 `json:"balance"`
+	EntriesTotal int64 `json:"entries_total"`
+}
+
+func (q *Queries) ReconcileAccounts(ctx context.Context, arg ReconcileAccountsParams) ([]ReconcileAccountsRow, error) {
+	rows, err := q.db.Query(ctx, reconcileAccounts, arg.AfterID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ReconcileAccountsRow{}
+	for rows.Next() {
+		var i ReconcileAccountsRow
+		if err := rows.Scan(&i.ID, &i.Balance, &i.EntriesTotal); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const reconcileTransfers = `-- name: ReconcileTransfers :many
+SELECT t.id,
+       t.fee,
+       COUNT(e.id) FILTER (WHERE e.account_id = t.from_account_id AND e.amount = -t.amount)::bigint AS from_entries,
+       COUNT(e.id) FILTER (WHERE e.account_id = t.to_account_id AND e.amount = t.to_amount)::bigint AS to_entries,
+       COUNT(e.id) FILTER (WHERE e.account_id <> t.from_account_id AND e.account_id <> t.to_account_id AND
+                                 e.amount = t.fee)::bigint                                          AS fee_entries,
+       COUNT(e.id)::bigint                                                                          AS total_entries
+FROM transfers t
+         LEFT JOIN entries e ON e.transfer_id = t.id
+WHERE t.id > $1
+GROUP BY t.id
+ORDER BY t.id
+LIMIT $2
+`
+
+type ReconcileTransfersParams struct {
+	AfterID int64 `json:"after_id"`
+	Limit   int32 `json:"limit"`
+}
+
+type ReconcileTransfersRow struct {
+	ID           int64 `json:"id"`
+	Fee          int64 `json:"fee"`
+	FromEntries  int64 `json:"from_entries"`
+	ToEntries    int64 `json:"to_entries"`
+	FeeEntries   int64 `json:"fee_entries"`
+	TotalEntries int64 `json:"total_entries"`
+}
+
+func (q *Queries) ReconcileTransfers(ctx context.Context, arg ReconcileTransfersParams) ([]ReconcileTransfersRow, error) {
+	rows, err := q.db.Query(ctx, reconcileTransfers, arg.AfterID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ReconcileTransfersRow{}
+	for rows.Next() {
+		var i ReconcileTransfersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Fee,
+			&i.FromEntries,
+			&i.ToEntries,
+			&i.FeeEntries,
+			&i.TotalEntries,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
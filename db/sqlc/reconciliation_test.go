@@ -0,0 +1,86 @@
+package db
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestReconcileAccounts(t *testing.T) {
+	account := createRandomAccountWithBalance(t, 0)
+
+	//An entry nothing added to the balance
+	_, err := testStore.CreateEntry(context.Background(), CreateEntryParams{
+		AccountID: account.ID,
+		Amount:    50,
+	})
+	require.NoError(t, err)
+
+	rows, err := testStore.ReconcileAccounts(context.Background(), ReconcileAccountsParams{
+		AfterID: account.ID - 1,
+		Limit:   1,
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.Equal(t, account.ID, rows[0].ID)
+	require.Equal(t, int64(0), rows[0].Balance)
+	require.Equal(t, int64(50), rows[0].EntriesTotal)
+}
+
+func TestReconcileTransfers(t *testing.T) {
+	account1 := createRandomAccountWithBalance(t, 100)
+	account2 := createRandomAccountWithBalance(t, 0)
+
+	result, err := testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	require.NoError(t, err)
+
+	rows, err := testStore.ReconcileTransfers(context.Background(), ReconcileTransfersParams{
+		AfterID: result.Transfer.ID - 1,
+		Limit:   1,
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.Equal(t, result.Transfer.ID, rows[0].ID)
+	require.Equal(t, int64(1), rows[0].FromEntries)
+	require.Equal(t, int64(1), rows[0].ToEntries)
+	require.Equal(t, int64(2), rows[0].TotalEntries)
+}
+
+func TestReconciliationReport(t *testing.T) {
+	user := createRandomUser(t)
+
+	report, err := testStore.CreateReconciliationReport(context.Background(), pgtype.Text{String: user.Username, Valid: true})
+	require.NoError(t, err)
+	require.Equal(t, ReconciliationStatusRunning, report.Status)
+	require.False(t, report.CompletedAt.Valid)
+
+	account := createRandomAccount(t)
+	discrepancy, err := testStore.CreateReconciliationDiscrepancy(context.Background(), CreateReconciliationDiscrepancyParams{
+		ReportID:  report.ID,
+		Kind:      DiscrepancyKindBalance,
+		AccountID: pgtype.Int8{Int64: account.ID, Valid: true},
+		Expected:  0,
+		Actual:    account.Balance,
+	})
+	require.NoError(t, err)
+	require.Equal(t, report.ID, discrepancy.ReportID)
+
+	report, err = testStore.CompleteReconciliationReport(context.Background(), CompleteReconciliationReportParams{
+		ID:               report.ID,
+		AccountsChecked:  1,
+		DiscrepancyCount: 1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, ReconciliationStatusCompleted, report.Status)
+	require.True(t, report.CompletedAt.Valid)
+
+	discrepancies, err := testStore.ListReconciliationDiscrepancies(context.Background(), report.ID)
+	require.NoError(t, err)
+	require.Len(t, discrepancies, 1)
+	require.Equal(t, discrepancy, discrepancies[0])
+}
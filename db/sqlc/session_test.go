@@ -0,0 +1,119 @@
+package db
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"goBank/util"
+	"testing"
+	"time"
+)
+
+func randomSessionParams(username string) CreateSessionParams {
+	id := uuid.New()
+	return CreateSessionParams{
+		ID:           id,
+		Username:     username,
+		RefreshToken: util.RandomString(32),
+		UserAgent:    "test",
+		ClientIp:     "127.0.0.1",
+		ExpiresAt:    time.Now().Add(time.Hour),
+		FamilyID:     id,
+	}
+}
+
+func createRandomSession(t *testing.T, username string) Session {
+	session, err := testStore.CreateSession(context.Background(), randomSessionParams(username))
+	require.NoError(t, err)
+	return session
+}
+
+func TestBlockSession(t *testing.T) {
+	user := createRandomUser(t)
+	other := createRandomUser(t)
+	session1 := createRandomSession(t, user.Username)
+	session2 := createRandomSession(t, user.Username)
+
+	sessions, err := testStore.ListActiveSessions(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	require.Equal(t, session2.ID, sessions[0].ID)
+
+	// only the owner can block a session
+	_, err = testStore.BlockSession(context.Background(), BlockSessionParams{
+		ID:       session1.ID,
+		Username: other.Username,
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	blocked, err := testStore.BlockSession(context.Background(), BlockSessionParams{
+		ID:       session1.ID,
+		Username: user.Username,
+	})
+	require.NoError(t, err)
+	require.True(t, blocked.IsBlocked)
+
+	sessions, err = testStore.ListActiveSessions(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+
+	rows, err := testStore.BlockUserSessions(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rows)
+
+	sessions, err = testStore.ListActiveSessions(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Empty(t, sessions)
+}
+
+func TestRotateSessionTx(t *testing.T) {
+	user := createRandomUser(t)
+	login := createRandomSession(t, user.Username)
+	other := createRandomSession(t, user.Username)
+
+	rotated, err := testStore.RotateSessionTx(context.Background(), RotateSessionTxParams{
+		Session:    login,
+		NewSession: randomSessionParams(user.Username),
+	})
+	require.NoError(t, err)
+	require.Equal(t, login.FamilyID, rotated.FamilyID)
+	require.Equal(t, login.ID, uuid.UUID(rotated.PreviousSessionID.Bytes))
+
+	previous, err := testStore.GetSession(context.Background(), login.ID)
+	require.NoError(t, err)
+	require.True(t, previous.RotatedAt.Valid)
+
+	// a session is only rotated once
+	_, err = testStore.RotateSessionTx(context.Background(), RotateSessionTxParams{
+		Session:    login,
+		NewSession: randomSessionParams(user.Username),
+	})
+	require.ErrorIs(t, err, ErrSessionRotated)
+
+	// the rotated session isn't listed, the one it was rotated into is
+	sessions, err := testStore.ListActiveSessions(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	require.Equal(t, rotated.ID, sessions[0].ID)
+
+	// blocking the family leaves the user's other logins alone
+	blocked, err := testStore.BlockSessionFamily(context.Background(), login.FamilyID)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), blocked)
+
+	sessions, err = testStore.ListActiveSessions(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.Equal(t, other.ID, sessions[0].ID)
+
+	// a renewal that loses the race against a logout can't give the blocked family a live session
+	_, err = testStore.RotateSessionTx(context.Background(), RotateSessionTxParams{
+		Session:    rotated,
+		NewSession: randomSessionParams(user.Username),
+	})
+	require.ErrorIs(t, err, ErrSessionBlocked)
+
+	sessions, err = testStore.ListActiveSessions(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+}
@@ -0,0 +1,330 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.20.0
+// source: standing_order.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createStandingOrder = `-- name: CreateStandingOrder :one
+INSERT INTO standing_orders (owner,
+                             from_account_id,
+                             to_account_id,
+                             amount,
+                             schedule,
+                             next_run_at,
+                             end_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, owner, from_account_id, to_account_id, amount, schedule, next_run_at, end_at, is_active, created_at, updated_at
+`
+
+type CreateStandingOrderParams struct {
+	Owner         string             `json:"owner"`
+	FromAccountID int64              `json:"from_account_id"`
+	ToAccountID   int64              `json:"to_account_id"`
+	Amount        int64              `json:"amount"`
+	Schedule      string             `json:"schedule"`
+	NextRunAt     time.Time          `json:"next_run_at"`
+	EndAt         pgtype.Timestamptz `json:"end_at"`
+}
+
+func (q *Queries) CreateStandingOrder(ctx context.Context, arg CreateStandingOrderParams) (StandingOrder, error) {
+	row := q.db.QueryRow(ctx, createStandingOrder,
+		arg.Owner,
+		arg.FromAccountID,
+		arg.ToAccountID,
+		arg.Amount,
+		arg.Schedule,
+		arg.NextRunAt,
+		arg.EndAt,
+	)
+	var i StandingOrder
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Schedule,
+		&i.NextRunAt,
+		&i.EndAt,
+		&i.IsActive,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createStandingOrderRun = `-- name: CreateStandingOrderRun :one
+INSERT INTO standing_order_runs (standing_order_id,
+                                 scheduled_at,
+                                 transfer_id,
+                                 status,
+                                 error)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, standing_order_id, scheduled_at, transfer_id, status, error, created_at
+`
+
+type CreateStandingOrderRunParams struct {
+	StandingOrderID int64       `json:"standing_order_id"`
+	ScheduledAt     time.Time   `json:"scheduled_at"`
+	TransferID      pgtype.Int8 `json:"transfer_id"`
+	Status          string      `json:"status"`
+	Error           string      `json:"error"`
+}
+
+func (q *Queries) CreateStandingOrderRun(ctx context.Context, arg CreateStandingOrderRunParams) (StandingOrderRun, error) {
+	row := q.db.QueryRow(ctx, createStandingOrderRun,
+		arg.StandingOrderID,
+		arg.ScheduledAt,
+		arg.TransferID,
+		arg.Status,
+		arg.Error,
+	)
+	var i StandingOrderRun
+	err := row.Scan(
+		&i.ID,
+		&i.StandingOrderID,
+		&i.ScheduledAt,
+		&i.TransferID,
+		&i.Status,
+		&i.Error,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getStandingOrder = `-- name: GetStandingOrder :one
+SELECT id, owner, from_account_id, to_account_id, amount, schedule, next_run_at, end_at, is_active, created_at, updated_at
+FROM standing_orders
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetStandingOrder(ctx context.Context, id int64) (StandingOrder, error) {
+	row := q.db.QueryRow(ctx, getStandingOrder, id)
+	var i StandingOrder
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Schedule,
+		&i.NextRunAt,
+		&i.EndAt,
+		&i.IsActive,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getStandingOrderForUpdate = `-- name: GetStandingOrderForUpdate :one
+SELECT id, owner, from_account_id, to_account_id, amount, schedule, next_run_at, end_at, is_active, created_at, updated_at
+FROM standing_orders
+WHERE id = $1
+LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetStandingOrderForUpdate(ctx context.Context, id int64) (StandingOrder, error) {
+	row := q.db.QueryRow(ctx, getStandingOrderForUpdate, id)
+	var i StandingOrder
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Schedule,
+		&i.NextRunAt,
+		&i.EndAt,
+		&i.IsActive,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listDueStandingOrders = `-- name: ListDueStandingOrders :many
+SELECT id, owner, from_account_id, to_account_id, amount, schedule, next_run_at, end_at, is_active, created_at, updated_at
+FROM standing_orders
+WHERE is_active = TRUE
+  AND next_run_at <= now()
+ORDER BY next_run_at
+LIMIT $1
+`
+
+func (q *Queries) ListDueStandingOrders(ctx context.Context, limit int32) ([]StandingOrder, error) {
+	rows, err := q.db.Query(ctx, listDueStandingOrders, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []StandingOrder{}
+	for rows.Next() {
+		var i StandingOrder
+		if err := rows.Scan(
+			&i.ID,
+			&i.Owner,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.Schedule,
+			&i.NextRunAt,
+			&i.EndAt,
+			&i.IsActive,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStandingOrderRuns = `-- name: ListStandingOrderRuns :many
+SELECT id, standing_order_id, scheduled_at, transfer_id, status, error, created_at
+FROM standing_order_runs
+WHERE standing_order_id = $1
+ORDER BY id DESC
+LIMIT $2 OFFSET $3
+`
+
+type ListStandingOrderRunsParams struct {
+	StandingOrderID int64 `json:"standing_order_id"`
+	Limit           int32 `json:"limit"`
+	Offset          int32 `json:"offset"`
+}
+
+func (q *Queries) ListStandingOrderRuns(ctx context.Context, arg ListStandingOrderRunsParams) ([]StandingOrderRun, error) {
+	rows, err := q.db.Query(ctx, listStandingOrderRuns, arg.StandingOrderID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []StandingOrderRun{}
+	for rows.Next() {
+		var i StandingOrderRun
+		if err := rows.Scan(
+			&i.ID,
+			&i.StandingOrderID,
+			&i.ScheduledAt,
+			&i.TransferID,
+			&i.Status,
+			&i.Error,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStandingOrders = `-- name: ListStandingOrders :many
+SELECT id, owner, from_account_id, to_account_id, amount, schedule, next_run_at, end_at, is_active, created_at, updated_at
+FROM standing_orders
+WHERE owner = $1
+ORDER BY id
+LIMIT $2 OFFSET $3
+`
+
+type ListStandingOrdersParams struct {
+	Owner  string `json:"owner"`
+	Limit  int32  `json:"limit"`
+	Offset int32  `json:"offset"`
+}
+
+func (q *Queries) ListStandingOrders(ctx context.Context, arg ListStandingOrdersParams) ([]StandingOrder, error) {
+	rows, err := q.db.Query(ctx, listStandingOrders, arg.Owner, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []StandingOrder{}
+	for rows.Next() {
+		var i StandingOrder
+		if err := rows.Scan(
+			&i.ID,
+			&i.Owner,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.Schedule,
+			&i.NextRunAt,
+			&i.EndAt,
+			&i.IsActive,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateStandingOrder = `-- name: UpdateStandingOrder :one
+UPDATE standing_orders
+SET amount      = COALESCE($1, amount),
+    schedule    = COALESCE($2, schedule),
+    next_run_at = COALESCE($3, next_run_at),
+    end_at      = COALESCE($4, end_at),
+    is_active   = COALESCE($5, is_active),
+    updated_at  = now()
+WHERE id = $6
+RETURNING id, owner, from_account_id, to_account_id, amount, schedule, next_run_at, end_at, is_active, created_at, updated_at
+`
+
+type UpdateStandingOrderParams struct {
+	Amount    pgtype.Int8        `json:"amount"`
+	Schedule  pgtype.Text        `json:"schedule"`
+	NextRunAt pgtype.Timestamptz `json:"next_run_at"`
+	EndAt     pgtype.Timestamptz `json:"end_at"`
+	IsActive  pgtype.Bool        `json:"is_active"`
+	ID        int64              `json:"id"`
+}
+
+func (q *Queries) UpdateStandingOrder(ctx context.Context, arg UpdateStandingOrderParams) (StandingOrder, error) {
+	row := q.db.QueryRow(ctx, updateStandingOrder,
+		arg.Amount,
+		arg.Schedule,
+		arg.NextRunAt,
+		arg.EndAt,
+		arg.IsActive,
+		arg.ID,
+	)
+	var i StandingOrder
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Schedule,
+		&i.NextRunAt,
+		&i.EndAt,
+		&i.IsActive,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,96 @@
+package db
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"goBank/util"
+	"testing"
+	"time"
+)
+
+func createRandomStandingOrder(t *testing.T, nextRunAt time.Time) StandingOrder {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	arg := CreateStandingOrderParams{
+		Owner:         account1.Owner,
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        util.RandomMoney(),
+		Schedule:      "@every 720h",
+		NextRunAt:     nextRunAt,
+	}
+
+	order, err := testStore.CreateStandingOrder(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, order)
+
+	require.Equal(t, arg.Owner, order.Owner)
+	require.Equal(t, arg.FromAccountID, order.FromAccountID)
+	require.Equal(t, arg.ToAccountID, order.ToAccountID)
+	require.Equal(t, arg.Amount, order.Amount)
+	require.Equal(t, arg.Schedule, order.Schedule)
+	require.WithinDuration(t, arg.NextRunAt, order.NextRunAt, time.Second)
+	require.False(t, order.EndAt.Valid)
+	require.True(t, order.IsActive)
+
+	require.NotZero(t, order.ID)
+	require.NotZero(t, order.CreatedAt)
+
+	return order
+}
+
+func TestCreateStandingOrder(t *testing.T) {
+	createRandomStandingOrder(t, time.Now().Add(time.Hour))
+}
+
+func TestGetStandingOrder(t *testing.T) {
+	order1 := createRandomStandingOrder(t, time.Now().Add(time.Hour))
+
+	order2, err := testStore.GetStandingOrder(context.Background(), order1.ID)
+	require.NoError(t, err)
+	require.Equal(t, order1.ID, order2.ID)
+	require.Equal(t, order1.Owner, order2.Owner)
+	require.Equal(t, order1.Amount, order2.Amount)
+	require.WithinDuration(t, order1.NextRunAt, order2.NextRunAt, time.Second)
+}
+
+func TestUpdateStandingOrder(t *testing.T) {
+	order1 := createRandomStandingOrder(t, time.Now().Add(time.Hour))
+
+	newAmount := util.RandomMoney()
+	order2, err := testStore.UpdateStandingOrder(context.Background(), UpdateStandingOrderParams{
+		ID:     order1.ID,
+		Amount: pgtype.Int8{Int64: newAmount, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, newAmount, order2.Amount)
+	require.Equal(t, order1.Schedule, order2.Schedule)
+	require.True(t, order2.IsActive)
+
+	order3, err := testStore.UpdateStandingOrder(context.Background(), UpdateStandingOrderParams{
+		ID:       order1.ID,
+		IsActive: pgtype.Bool{Bool: false, Valid: true},
+	})
+	require.NoError(t, err)
+	require.False(t, order3.IsActive)
+	require.Equal(t, newAmount, order3.Amount)
+}
+
+func TestListDueStandingOrders(t *testing.T) {
+	due := createRandomStandingOrder(t, time.Now().Add(-time.Minute))
+	notDue := createRandomStandingOrder(t, time.Now().Add(time.Hour))
+
+	orders, err := testStore.ListDueStandingOrders(context.Background(), 1000)
+	require.NoError(t, err)
+
+	ids := make(map[int64]bool)
+	for _, order := range orders {
+		require.True(t, order.IsActive)
+		require.False(t, order.NextRunAt.After(time.Now()))
+		ids[order.ID] = true
+	}
+	require.True(t, ids[due.ID])
+	require.False(t, ids[notDue.ID])
+}
@@ -0,0 +1,244 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.20.0
+// source: statement.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createStatement = `-- name: CreateStatement :one
+INSERT INTO statements (account_id, period_start, period_end, opening_balance, closing_balance,
+                        total_credits, total_debits, entry_count, csv, html)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+ON CONFLICT (account_id, period_start, period_end)
+    DO UPDATE SET opening_balance = EXCLUDED.opening_balance,
+                  closing_balance = EXCLUDED.closing_balance,
+                  total_credits   = EXCLUDED.total_credits,
+                  total_debits    = EXCLUDED.total_debits,
+                  entry_count     = EXCLUDED.entry_count,
+                  csv             = EXCLUDED.csv,
+                  html            = EXCLUDED.html
+    WHERE statements.emailed_at IS NULL
+RETURNING id, account_id, period_start, period_end, opening_balance, closing_balance, total_credits, total_debits, entry_count, csv, html, emailed_at, created_at
+`
+
+type CreateStatementParams struct {
+	AccountID      int64     `json:"account_id"`
+	PeriodStart    time.Time `json:"period_start"`
+	PeriodEnd      time.Time `json:"period_end"`
+	OpeningBalance int64     `json:"opening_balance"`
+	ClosingBalance int64     `json:"closing_balance"`
+	TotalCredits   int64     `json:"total_credits"`
+	TotalDebits    int64     `json:"total_debits"`
+	EntryCount     int64     `json:"entry_count"`
+	Csv            string    `json:"csv"`
+	Html           string    `json:"html"`
+}
+
+// A rerun of the same period replaces the statement unless it was already emailed
+func (q *Queries) CreateStatement(ctx context.Context, arg CreateStatementParams) (Statement, error) {
+	row := q.db.QueryRow(ctx, createStatement,
+		arg.AccountID,
+		arg.PeriodStart,
+		arg.PeriodEnd,
+		arg.OpeningBalance,
+		arg.ClosingBalance,
+		arg.TotalCredits,
+		arg.TotalDebits,
+		arg.EntryCount,
+		arg.Csv,
+		arg.Html,
+	)
+	var i Statement
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.PeriodStart,
+		&i.PeriodEnd,
+		&i.OpeningBalance,
+		&i.ClosingBalance,
+		&i.TotalCredits,
+		&i.TotalDebits,
+		&i.EntryCount,
+		&i.Csv,
+		&i.Html,
+		&i.EmailedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getStatementForPeriod = `-- name: GetStatementForPeriod :one
+SELECT id, account_id, period_start, period_end, opening_balance, closing_balance, total_credits, total_debits, entry_count, csv, html, emailed_at, created_at
+FROM statements
+WHERE account_id = $1
+  AND period_start = $2
+  AND period_end = $3
+LIMIT 1
+`
+
+type GetStatementForPeriodParams struct {
+	AccountID   int64     `json:"account_id"`
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+}
+
+func (q *Queries) GetStatementForPeriod(ctx context.Context, arg GetStatementForPeriodParams) (Statement, error) {
+	row := q.db.QueryRow(ctx, getStatementForPeriod, arg.AccountID, arg.PeriodStart, arg.PeriodEnd)
+	var i Statement
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.PeriodStart,
+		&i.PeriodEnd,
+		&i.OpeningBalance,
+		&i.ClosingBalance,
+		&i.TotalCredits,
+		&i.TotalDebits,
+		&i.EntryCount,
+		&i.Csv,
+		&i.Html,
+		&i.EmailedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listStatementAccounts = `-- name: ListStatementAccounts :many
+SELECT a.id
+FROM accounts a
+WHERE a.kind = 'customer'
+  AND a.created_at < $1
+  AND (a.status <> 'closed' OR EXISTS (SELECT 1
+                                       FROM entries e
+                                       WHERE e.account_id = a.id
+                                         AND e.created_at >= $2
+                                         AND e.created_at < $1))
+  AND a.id > $3
+ORDER BY a.id
+LIMIT $4
+`
+
+type ListStatementAccountsParams struct {
+	PeriodEnd   time.Time `json:"period_end"`
+	PeriodStart time.Time `json:"period_start"`
+	AfterID     int64     `json:"after_id"`
+	Limit       int32     `json:"limit"`
+}
+
+// Customer accounts that can have activity in the period, closed ones only if they had some
+func (q *Queries) ListStatementAccounts(ctx context.Context, arg ListStatementAccountsParams) ([]int64, error) {
+	rows, err := q.db.Query(ctx, listStatementAccounts,
+		arg.PeriodEnd,
+		arg.PeriodStart,
+		arg.AfterID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStatementEntries = `-- name: ListStatementEntries :many
+SELECT e.id,
+       e.amount,
+       e.transfer_id,
+       e.created_at,
+       COALESCE(t.description, '')::varchar        AS description,
+       COALESCE(t.external_reference, '')::varchar AS external_reference
+FROM entries e
+         LEFT JOIN transfers t ON t.id = e.transfer_id
+WHERE e.account_id = $1
+  AND e.created_at >= $2
+  AND e.created_at < $3
+ORDER BY e.id
+`
+
+type ListStatementEntriesParams struct {
+	AccountID   int64     `json:"account_id"`
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+}
+
+type ListStatementEntriesRow struct {
+	ID                int64       `json:"id"`
+	Amount            int64       `json:"amount"`
+	TransferID        pgtype.Int8 `json:"transfer_id"`
+	CreatedAt         time.Time   `json:"created_at"`
+	Description       string      `json:"description"`
+	ExternalReference string      `json:"external_reference"`
+}
+
+func (q *Queries) ListStatementEntries(ctx context.Context, arg ListStatementEntriesParams) ([]ListStatementEntriesRow, error) {
+	rows, err := q.db.Query(ctx, listStatementEntries, arg.AccountID, arg.PeriodStart, arg.PeriodEnd)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListStatementEntriesRow{}
+	for rows.Next() {
+		var i ListStatementEntriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Amount,
+			&i.TransferID,
+			&i.CreatedAt,
+			&i.Description,
+			&i.ExternalReference,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markStatementEmailed = `-- name: MarkStatementEmailed :one
+UPDATE statements
+SET emailed_at = now()
+WHERE id = $1
+RETURNING id, account_id, period_start, period_end, opening_balance, closing_balance, total_credits, total_debits, entry_count, csv, html, emailed_at, created_at
+`
+
+func (q *Queries) MarkStatementEmailed(ctx context.Context, id int64) (Statement, error) {
+	row := q.db.QueryRow(ctx, markStatementEmailed, id)
+	var i Statement
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.PeriodStart,
+		&i.PeriodEnd,
+		&i.OpeningBalance,
+		&i.ClosingBalance,
+		&i.TotalCredits,
+		&i.TotalDebits,
+		&i.EntryCount,
+		&i.Csv,
+		&i.Html,
+		&i.EmailedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,86 @@
+package db
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"goBank/util"
+	"testing"
+	"time"
+)
+
+func TestStatementTx(t *testing.T) {
+	account1 := createRandomAccountWithCurrency(t, 1000, util.USD)
+	account2 := createRandomAccountWithCurrency(t, 500, util.USD)
+	periodStart := time.Now().Add(-time.Minute)
+
+	_, err := testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID:   account1.ID,
+		ToAccountID:     account2.ID,
+		Amount:          300,
+		TransferDetails: TransferDetails{Description: "rent"},
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account2.ID,
+		ToAccountID:   account1.ID,
+		Amount:        50,
+	})
+	require.NoError(t, err)
+
+	periodEnd := time.Now().Add(time.Minute)
+	result, err := testStore.StatementTx(context.Background(), StatementTxParams{
+		AccountID:   account1.ID,
+		PeriodStart: periodStart,
+		PeriodEnd:   periodEnd,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), result.OpeningBalance)
+	require.Equal(t, int64(750), result.ClosingBalance)
+	require.Equal(t, int64(50), result.TotalCredits)
+	require.Equal(t, int64(300), result.TotalDebits)
+	require.Len(t, result.Lines, 2)
+	require.Equal(t, int64(-300), result.Lines[0].Amount)
+	require.Equal(t, int64(700), result.Lines[0].Balance)
+	require.Equal(t, "rent", result.Lines[0].Description)
+	require.Equal(t, int64(750), result.Lines[1].Balance)
+
+	// a period that ended before the transfers has the balance they started from and no lines
+	result, err = testStore.StatementTx(context.Background(), StatementTxParams{
+		AccountID:   account1.ID,
+		PeriodStart: periodStart.Add(-time.Hour),
+		PeriodEnd:   periodStart,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), result.OpeningBalance)
+	require.Equal(t, int64(1000), result.ClosingBalance)
+	require.Empty(t, result.Lines)
+
+	stored, err := testStore.CreateStatement(context.Background(), result.CreateStatementParams("csv", "html"))
+	require.NoError(t, err)
+	require.Equal(t, account1.ID, stored.AccountID)
+	require.Zero(t, stored.EntryCount)
+	require.False(t, stored.EmailedAt.Valid)
+
+	// building it again before it's emailed replaces it
+	replaced, err := testStore.CreateStatement(context.Background(), result.CreateStatementParams("csv2", "html2"))
+	require.NoError(t, err)
+	require.Equal(t, stored.ID, replaced.ID)
+	require.Equal(t, "csv2", replaced.Csv)
+
+	emailed, err := testStore.MarkStatementEmailed(context.Background(), stored.ID)
+	require.NoError(t, err)
+	require.True(t, emailed.EmailedAt.Valid)
+
+	// once emailed it's kept as it was sent
+	_, err = testStore.CreateStatement(context.Background(), result.CreateStatementParams("csv3", "html3"))
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	found, err := testStore.GetStatementForPeriod(context.Background(), GetStatementForPeriodParams{
+		AccountID:   account1.ID,
+		PeriodStart: result.PeriodStart,
+		PeriodEnd:   result.PeriodEnd,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "csv2", found.Csv)
+}
@@ -23,8 +23,29 @@ Serialization Anomaly: the result of a group of concurrent committed transaction
 type Store interface {
 	Querier
 	TransferTx(ctx context.Context, arg TransferTxParams) (TransferTxResult, error)
+	BatchTransferTx(ctx context.Context, arg BatchTransferTxParams) (BatchTransferTxResult, error)
+	ExchangeTransferTx(ctx context.Context, arg ExchangeTransferTxParams) (TransferTxResult, error)
+	ReverseTransferTx(ctx context.Context, arg ReverseTransferTxParams) (ReverseTransferTxResult, error)
+	AuthorizeHoldTx(ctx context.Context, arg AuthorizeHoldTxParams) (HoldTxResult, error)
+	CaptureHoldTx(ctx context.Context, arg CaptureHoldTxParams) (CaptureHoldTxResult, error)
+	VoidHoldTx(ctx context.Context, holdID int64) (HoldTxResult, error)
+	ExpireHoldTx(ctx context.Context, holdID int64) (HoldTxResult, error)
+	CreatePendingTransferTx(ctx context.Context, arg CreatePendingTransferTxParams) (PendingTransfer, error)
+	ApprovePendingTransferTx(ctx context.Context, arg ReviewPendingTransferTxParams) (ApprovePendingTransferTxResult, error)
+	RejectPendingTransferTx(ctx context.Context, arg ReviewPendingTransferTxParams) (PendingTransfer, error)
+	ReplaceFeeScheduleTx(ctx context.Context, arg ReplaceFeeScheduleTxParams) ([]FeeSchedule, error)
+	TransferLimitsTx(ctx context.Context, arg TransferLimitsTxParams) (TransferLimitsTxResult, error)
+	PostInterestTx(ctx context.Context, arg PostInterestTxParams) (PostInterestTxResult, error)
+	StatementTx(ctx context.Context, arg StatementTxParams) (StatementTxResult, error)
+	DepositTx(ctx context.Context, arg DepositTxParams) (DepositTxResult, error)
+	WithdrawTx(ctx context.Context, arg WithdrawTxParams) (WithdrawTxResult, error)
+	SettleWithdrawalTx(ctx context.Context, arg SettleWithdrawalTxParams) (WithdrawTxResult, error)
+	ChangeAccountStatusTx(ctx context.Context, arg ChangeAccountStatusTxParams) (ChangeAccountStatusTxResult, error)
+	CompleteStandingOrderRunTx(ctx context.Context, arg CompleteStandingOrderRunTxParams) (CompleteStandingOrderRunTxResult, error)
 	CreateUserTx(ctx context.Context, arg CreateUserTxParams) (CreateUserTxResult, error)
 	VerifyEmailTx(ctx context.Context, arg VerifyEmailTxParams) (VerifyEmailTxResult, error)
+	RotateSessionTx(ctx context.Context, arg RotateSessionTxParams) (Session, error)
+	OpenSystemAccounts(ctx context.Context, currencies []string) error
 }
 
 // SQLStore provides all functions to execute SQL db queries and transactions
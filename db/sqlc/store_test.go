@@ -3,13 +3,16 @@ package db
 import (
 	"context"
 	"fmt"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/require"
+	"goBank/util"
 	"testing"
+	"time"
 )
 
 func TestTransferTx(t *testing.T) {
 
-	account1 := createRandomAccount(t)
+	account1 := createRandomAccountWithBalance(t, 1000)
 	account2 := createRandomAccount(t)
 	fmt.Println(">> before:", account1.Balance, account2.Balance)
 
@@ -60,6 +63,7 @@ func TestTransferTx(t *testing.T) {
 		require.NotEmpty(t, fromEntry)
 		require.Equal(t, account1.ID, fromEntry.AccountID)
 		require.Equal(t, -amount, fromEntry.Amount)
+		require.Equal(t, transfer.ID, fromEntry.TransferID.Int64)
 		require.NotZero(t, fromEntry.CreatedAt)
 
 		_, err = testStore.GetEntry(context.Background(), fromEntry.ID)
@@ -69,6 +73,7 @@ func TestTransferTx(t *testing.T) {
 		require.NotEmpty(t, fromEntry)
 		require.Equal(t, account2.ID, toEntry.AccountID)
 		require.Equal(t, amount, toEntry.Amount)
+		require.Equal(t, transfer.ID, toEntry.TransferID.Int64)
 		require.NotZero(t, toEntry.CreatedAt)
 
 		_, err = testStore.GetEntry(context.Background(), toEntry.ID)
@@ -111,8 +116,8 @@ func TestTransferTx(t *testing.T) {
 }
 
 func TestTransferTxDeadlock(t *testing.T) {
-	account1 := createRandomAccount(t)
-	account2 := createRandomAccount(t)
+	account1 := createRandomAccountWithBalance(t, 1000)
+	account2 := createRandomAccountWithBalance(t, 1000)
 	fmt.Println(">> before:", account1.Balance, account2.Balance)
 
 	// run n concurrent transfer transactions
@@ -162,3 +167,645 @@ func TestTransferTxDeadlock(t *testing.T) {
 	require.Equal(t, account2.Balance, updatedAccount2.Balance)
 
 }
+
+func TestTransferTxInsufficientFunds(t *testing.T) {
+	account1 := createRandomAccountWithBalance(t, 100)
+	account2 := createRandomAccount(t)
+
+	result, err := testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        account1.Balance + 1,
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+	require.Empty(t, result)
+
+	// nothing should have moved
+	updatedAccount1, err := testStore.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance, updatedAccount1.Balance)
+
+	updatedAccount2, err := testStore.GetAccount(context.Background(), account2.ID)
+	require.NoError(t, err)
+	require.Equal(t, account2.Balance, updatedAccount2.Balance)
+}
+
+func TestTransferTxOverdraftLimit(t *testing.T) {
+	account1 := createRandomAccountWithBalance(t, 100)
+	account2 := createRandomAccount(t)
+
+	account1, err := testStore.UpdateAccountOverdraftLimit(context.Background(), UpdateAccountOverdraftLimitParams{
+		ID:             account1.ID,
+		OverdraftLimit: 50,
+	})
+	require.NoError(t, err)
+
+	// balance plus overdraft covers it exactly
+	result, err := testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        150,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(-50), result.FromAccount.Balance)
+
+	// overdraft is used up now
+	_, err = testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        1,
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+}
+
+func TestTransferTxIdempotencyKey(t *testing.T) {
+	account1 := createRandomAccountWithBalance(t, 1000)
+	account2 := createRandomAccount(t)
+
+	arg := TransferTxParams{
+		FromAccountID:  account1.ID,
+		ToAccountID:    account2.ID,
+		Amount:         10,
+		Username:       account1.Owner,
+		IdempotencyKey: util.RandomString(32),
+	}
+
+	result1, err := testStore.TransferTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, result1)
+
+	// replaying the same request returns the original result without moving money again
+	result2, err := testStore.TransferTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, result1.Transfer.ID, result2.Transfer.ID)
+	require.Equal(t, result1.FromEntry.ID, result2.FromEntry.ID)
+	require.Equal(t, result1.ToEntry.ID, result2.ToEntry.ID)
+	require.Equal(t, result1.FromAccount.Balance, result2.FromAccount.Balance)
+	require.Equal(t, result1.ToAccount.Balance, result2.ToAccount.Balance)
+
+	updatedAccount1, err := testStore.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance-arg.Amount, updatedAccount1.Balance)
+
+	// same key with a different body is rejected
+	arg.Amount++
+	result3, err := testStore.TransferTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrIdempotencyKeyConflict)
+	require.Empty(t, result3)
+
+	// keys are only unique per user, another user's request with the same key is a new one
+	account3 := createRandomAccountWithBalance(t, 1000)
+	result4, err := testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID:  account3.ID,
+		ToAccountID:    account2.ID,
+		Amount:         10,
+		Username:       account3.Owner,
+		IdempotencyKey: arg.IdempotencyKey,
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, result1.Transfer.ID, result4.Transfer.ID)
+	require.Equal(t, account3.ID, result4.Transfer.FromAccountID)
+}
+
+func createRandomAccountWithCurrency(t *testing.T, balance int64, currency string) Account {
+	user := createRandomUser(t)
+
+	account, err := testStore.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    user.Username,
+		Balance:  balance,
+		Currency: currency,
+	})
+	require.NoError(t, err)
+	require.Equal(t, currency, account.Currency)
+
+	return account
+}
+
+func TestExchangeTransferTx(t *testing.T) {
+	account1 := createRandomAccountWithCurrency(t, 1000, util.USD)
+	account2 := createRandomAccountWithCurrency(t, 0, util.EUR)
+
+	rate := createRandomExchangeRate(t, util.USD, util.EUR)
+	quote := createRandomExchangeQuote(t, account1.Owner, rate, time.Minute)
+
+	arg := ExchangeTransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        100,
+		QuoteID:       quote.ID,
+		Username:      account1.Owner,
+	}
+
+	result, err := testStore.ExchangeTransferTx(context.Background(), arg)
+	require.NoError(t, err)
+
+	usd, _ := util.LookupCurrency(util.USD)
+	eur, _ := util.LookupCurrency(util.EUR)
+	toAmount, err := ConvertAmount(arg.Amount, rate.Rate, usd, eur)
+	require.NoError(t, err)
+	require.Equal(t, arg.Amount, result.Transfer.Amount)
+	require.Equal(t, toAmount, result.Transfer.ToAmount)
+	require.Equal(t, rate.Rate, result.Transfer.ExchangeRate)
+	require.Equal(t, -arg.Amount, result.FromEntry.Amount)
+	require.Equal(t, toAmount, result.ToEntry.Amount)
+	require.Equal(t, account1.Balance-arg.Amount, result.FromAccount.Balance)
+	require.Equal(t, account2.Balance+toAmount, result.ToAccount.Balance)
+
+	// quotes are single use
+	_, err = testStore.ExchangeTransferTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrQuoteUsed)
+}
+
+func TestExchangeTransferTxExpiredQuote(t *testing.T) {
+	account1 := createRandomAccountWithCurrency(t, 1000, util.USD)
+	account2 := createRandomAccountWithCurrency(t, 0, util.CAD)
+
+	rate := createRandomExchangeRate(t, util.USD, util.CAD)
+	quote := createRandomExchangeQuote(t, account1.Owner, rate, -time.Minute)
+
+	_, err := testStore.ExchangeTransferTx(context.Background(), ExchangeTransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        100,
+		QuoteID:       quote.ID,
+		Username:      account1.Owner,
+	})
+	require.ErrorIs(t, err, ErrQuoteExpired)
+}
+
+func TestExchangeTransferTxQuoteMismatch(t *testing.T) {
+	account1 := createRandomAccountWithCurrency(t, 1000, util.USD)
+	account2 := createRandomAccountWithCurrency(t, 0, util.EUR)
+
+	// the quote is for a different currency pair
+	rate := createRandomExchangeRate(t, util.USD, util.CAD)
+	quote := createRandomExchangeQuote(t, account1.Owner, rate, time.Minute)
+
+	_, err := testStore.ExchangeTransferTx(context.Background(), ExchangeTransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        100,
+		QuoteID:       quote.ID,
+		Username:      account1.Owner,
+	})
+	require.ErrorIs(t, err, ErrQuoteMismatch)
+}
+
+func TestHoldTxCapture(t *testing.T) {
+	account1 := createRandomAccountWithBalance(t, 100)
+	account2 := createRandomAccount(t)
+
+	authorized, err := testStore.AuthorizeHoldTx(context.Background(), AuthorizeHoldTxParams{
+		AccountID: account1.ID,
+		Amount:    60,
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.Equal(t, HoldStatusAuthorized, authorized.Hold.Status)
+	require.Equal(t, int64(100), authorized.Account.Balance)
+	require.Equal(t, int64(40), authorized.Account.AvailableBalance())
+
+	// the held money can't be spent by a regular transfer
+	_, err = testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        50,
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	_, err = testStore.CaptureHoldTx(context.Background(), CaptureHoldTxParams{
+		HoldID:      authorized.Hold.ID,
+		ToAccountID: account2.ID,
+		Amount:      61,
+	})
+	require.ErrorIs(t, err, ErrCaptureExceedsHold)
+
+	// capturing part of the hold releases the rest
+	captured, err := testStore.CaptureHoldTx(context.Background(), CaptureHoldTxParams{
+		HoldID:      authorized.Hold.ID,
+		ToAccountID: account2.ID,
+		Amount:      45,
+	})
+	require.NoError(t, err)
+	require.Equal(t, HoldStatusCaptured, captured.Hold.Status)
+	require.Equal(t, int64(45), captured.Hold.CapturedAmount)
+	require.Equal(t, captured.Transfer.ID, captured.Hold.TransferID.Int64)
+	require.Equal(t, int64(55), captured.FromAccount.Balance)
+	require.Zero(t, captured.FromAccount.HeldBalance)
+	require.Equal(t, account2.Balance+45, captured.ToAccount.Balance)
+
+	_, err = testStore.VoidHoldTx(context.Background(), authorized.Hold.ID)
+	require.ErrorIs(t, err, ErrHoldNotAuthorized)
+}
+
+func TestHoldTxVoid(t *testing.T) {
+	account := createRandomAccountWithBalance(t, 100)
+
+	_, err := testStore.AuthorizeHoldTx(context.Background(), AuthorizeHoldTxParams{
+		AccountID: account.ID,
+		Amount:    101,
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	authorized, err := testStore.AuthorizeHoldTx(context.Background(), AuthorizeHoldTxParams{
+		AccountID: account.ID,
+		Amount:    100,
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.ExpireHoldTx(context.Background(), authorized.Hold.ID)
+	require.ErrorIs(t, err, ErrHoldNotExpired)
+
+	voided, err := testStore.VoidHoldTx(context.Background(), authorized.Hold.ID)
+	require.NoError(t, err)
+	require.Equal(t, HoldStatusVoided, voided.Hold.Status)
+	require.Equal(t, int64(100), voided.Account.Balance)
+	require.Zero(t, voided.Account.HeldBalance)
+}
+
+func TestHoldTxExpire(t *testing.T) {
+	account1 := createRandomAccountWithBalance(t, 100)
+	account2 := createRandomAccount(t)
+
+	authorized, err := testStore.AuthorizeHoldTx(context.Background(), AuthorizeHoldTxParams{
+		AccountID: account1.ID,
+		Amount:    30,
+		ExpiresAt: time.Now().Add(-time.Minute),
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.CaptureHoldTx(context.Background(), CaptureHoldTxParams{
+		HoldID:      authorized.Hold.ID,
+		ToAccountID: account2.ID,
+		Amount:      30,
+	})
+	require.ErrorIs(t, err, ErrHoldExpired)
+
+	holds, err := testStore.ListExpiredHolds(context.Background(), 1000)
+	require.NoError(t, err)
+
+	found := false
+	for _, hold := range holds {
+		if hold.ID == authorized.Hold.ID {
+			found = true
+		}
+	}
+	require.True(t, found)
+
+	expired, err := testStore.ExpireHoldTx(context.Background(), authorized.Hold.ID)
+	require.NoError(t, err)
+	require.Equal(t, HoldStatusExpired, expired.Hold.Status)
+	require.Zero(t, expired.Account.HeldBalance)
+}
+
+func TestReverseTransferTx(t *testing.T) {
+	account1 := createRandomAccountWithBalance(t, 1000)
+	account2 := createRandomAccountWithBalance(t, 1000)
+
+	transferred, err := testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        100,
+	})
+	require.NoError(t, err)
+
+	// partial refund
+	reversed, err := testStore.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID: transferred.Transfer.ID,
+		Amount:     40,
+	})
+	require.NoError(t, err)
+	require.Equal(t, transferred.Transfer.ID, reversed.Transfer.ReversalOf.Int64)
+	require.Equal(t, account2.ID, reversed.Transfer.FromAccountID)
+	require.Equal(t, account1.ID, reversed.Transfer.ToAccountID)
+	require.Equal(t, int64(40), reversed.Transfer.Amount)
+	require.Equal(t, int64(-40), reversed.FromEntry.Amount)
+	require.Equal(t, int64(40), reversed.ToEntry.Amount)
+	require.Equal(t, int64(40), reversed.OriginalTransfer.ReversedAmount)
+	require.Equal(t, int64(940), reversed.ToAccount.Balance)
+	require.Equal(t, int64(1060), reversed.FromAccount.Balance)
+
+	_, err = testStore.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID: transferred.Transfer.ID,
+		Amount:     61,
+	})
+	require.ErrorIs(t, err, ErrReversalExceedsTransfer)
+
+	_, err = testStore.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID: reversed.Transfer.ID,
+		Amount:     10,
+	})
+	require.ErrorIs(t, err, ErrTransferIsReversal)
+
+	// refund the rest
+	reversed, err = testStore.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID: transferred.Transfer.ID,
+		Amount:     60,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(100), reversed.OriginalTransfer.ReversedAmount)
+	require.Equal(t, int64(1000), reversed.ToAccount.Balance)
+	require.Equal(t, int64(1000), reversed.FromAccount.Balance)
+
+	_, err = testStore.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID: transferred.Transfer.ID,
+		Amount:     1,
+	})
+	require.ErrorIs(t, err, ErrTransferAlreadyReversed)
+}
+
+func TestCompleteStandingOrderRunTx(t *testing.T) {
+	order := createRandomStandingOrder(t, time.Now().Add(-time.Minute))
+	nextRunAt := order.NextRunAt.Add(720 * time.Hour)
+
+	result, err := testStore.CompleteStandingOrderRunTx(context.Background(), CompleteStandingOrderRunTxParams{
+		StandingOrderID: order.ID,
+		ScheduledAt:     order.NextRunAt,
+		NextRunAt:       nextRunAt,
+		Error:           ErrInsufficientFunds.Error(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, StandingOrderRunFailed, result.StandingOrderRun.Status)
+	require.Equal(t, ErrInsufficientFunds.Error(), result.StandingOrderRun.Error)
+	require.False(t, result.StandingOrderRun.TransferID.Valid)
+	require.WithinDuration(t, nextRunAt, result.StandingOrder.NextRunAt, time.Second)
+	require.True(t, result.StandingOrder.IsActive)
+
+	// the same run can only be recorded once
+	_, err = testStore.CompleteStandingOrderRunTx(context.Background(), CompleteStandingOrderRunTxParams{
+		StandingOrderID: order.ID,
+		ScheduledAt:     order.NextRunAt,
+		NextRunAt:       nextRunAt,
+	})
+	require.ErrorIs(t, err, ErrStandingOrderRunDone)
+
+	runs, err := testStore.ListStandingOrderRuns(context.Background(), ListStandingOrderRunsParams{
+		StandingOrderID: order.ID,
+		Limit:           5,
+		Offset:          0,
+	})
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+}
+
+func TestCompleteStandingOrderRunTxEndAt(t *testing.T) {
+	order := createRandomStandingOrder(t, time.Now().Add(-time.Minute))
+
+	order, err := testStore.UpdateStandingOrder(context.Background(), UpdateStandingOrderParams{
+		ID:    order.ID,
+		EndAt: pgtype.Timestamptz{Time: time.Now().Add(time.Hour), Valid: true},
+	})
+	require.NoError(t, err)
+
+	result, err := testStore.CompleteStandingOrderRunTx(context.Background(), CompleteStandingOrderRunTxParams{
+		StandingOrderID: order.ID,
+		ScheduledAt:     order.NextRunAt,
+		NextRunAt:       order.NextRunAt.Add(720 * time.Hour),
+		TransferID:      createRandomTransfer(t, createRandomAccount(t), createRandomAccount(t)).ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, StandingOrderRunSucceeded, result.StandingOrderRun.Status)
+	require.False(t, result.StandingOrder.IsActive)
+}
+
+func TestApprovePendingTransferTx(t *testing.T) {
+	account1 := createRandomAccountWithBalance(t, 1000)
+	account2 := createRandomAccountWithBalance(t, 0)
+	banker := createRandomUser(t)
+
+	pending, err := testStore.CreatePendingTransferTx(context.Background(), CreatePendingTransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        500,
+		Initiator:     account1.Owner,
+		InitiatorRole: util.DepositorRole,
+		ExpiresAt:     time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(500), pending.ToAmount)
+	require.Equal(t, int64(IdentityExchangeRate), pending.ExchangeRate)
+
+	// nothing moves until it's approved
+	account, err := testStore.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), account.Balance)
+
+	_, err = testStore.ApprovePendingTransferTx(context.Background(), ReviewPendingTransferTxParams{
+		PendingTransferID: pending.ID,
+		Reviewer:          account1.Owner,
+	})
+	require.ErrorIs(t, err, ErrSelfReview)
+
+	result, err := testStore.ApprovePendingTransferTx(context.Background(), ReviewPendingTransferTxParams{
+		PendingTransferID: pending.ID,
+		Reviewer:          banker.Username,
+	})
+	require.NoError(t, err)
+	require.Equal(t, PendingTransferStatusApproved, result.PendingTransfer.Status)
+	require.Equal(t, banker.Username, result.PendingTransfer.Reviewer.String)
+	require.Equal(t, result.Transfer.ID, result.PendingTransfer.TransferID.Int64)
+	require.Equal(t, int64(500), result.FromAccount.Balance)
+	require.Equal(t, int64(500), result.ToAccount.Balance)
+
+	_, err = testStore.RejectPendingTransferTx(context.Background(), ReviewPendingTransferTxParams{
+		PendingTransferID: pending.ID,
+		Reviewer:          banker.Username,
+	})
+	require.ErrorIs(t, err, ErrPendingTransferReviewed)
+}
+
+func TestRejectPendingTransferTx(t *testing.T) {
+	account1 := createRandomAccountWithBalance(t, 1000)
+	account2 := createRandomAccountWithBalance(t, 0)
+	banker := createRandomUser(t)
+
+	pending, err := testStore.CreatePendingTransferTx(context.Background(), CreatePendingTransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        500,
+		Initiator:     account1.Owner,
+		InitiatorRole: util.DepositorRole,
+		ExpiresAt:     time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	rejected, err := testStore.RejectPendingTransferTx(context.Background(), ReviewPendingTransferTxParams{
+		PendingTransferID: pending.ID,
+		Reviewer:          banker.Username,
+		Reason:            "unusual activity",
+	})
+	require.NoError(t, err)
+	require.Equal(t, PendingTransferStatusRejected, rejected.Status)
+	require.Equal(t, "unusual activity", rejected.Reason)
+	require.False(t, rejected.TransferID.Valid)
+
+	account, err := testStore.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), account.Balance)
+}
+
+func TestChangeAccountStatusTx(t *testing.T) {
+	account1 := createRandomAccountWithBalance(t, 100)
+	account2 := createRandomAccountWithBalance(t, 0)
+	banker := createRandomUser(t)
+
+	result, err := testStore.ChangeAccountStatusTx(context.Background(), ChangeAccountStatusTxParams{
+		AccountID: account1.ID,
+		Status:    AccountStatusFrozen,
+		ChangedBy: banker.Username,
+		Reason:    "suspected fraud",
+	})
+	require.NoError(t, err)
+	require.Equal(t, AccountStatusFrozen, result.Account.Status)
+	require.Equal(t, AccountStatusActive, result.Change.FromStatus)
+	require.Equal(t, AccountStatusFrozen, result.Change.ToStatus)
+	require.Equal(t, banker.Username, result.Change.ChangedBy)
+	require.Equal(t, "suspected fraud", result.Change.Reason)
+
+	// a frozen account can neither send nor receive
+	_, err = testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	require.ErrorIs(t, err, ErrAccountFrozen)
+
+	_, err = testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account2.ID,
+		ToAccountID:   account1.ID,
+		Amount:        10,
+	})
+	require.ErrorIs(t, err, ErrAccountFrozen)
+
+	// money left on it keeps it from being closed
+	_, err = testStore.ChangeAccountStatusTx(context.Background(), ChangeAccountStatusTxParams{
+		AccountID: account1.ID,
+		Status:    AccountStatusClosed,
+		ChangedBy: banker.Username,
+		Reason:    "customer request",
+	})
+	require.ErrorIs(t, err, ErrAccountNotEmpty)
+
+	result, err = testStore.ChangeAccountStatusTx(context.Background(), ChangeAccountStatusTxParams{
+		AccountID: account2.ID,
+		Status:    AccountStatusClosed,
+		ChangedBy: banker.Username,
+		Reason:    "customer request",
+	})
+	require.NoError(t, err)
+	require.Equal(t, AccountStatusClosed, result.Account.Status)
+
+	// closed is final
+	_, err = testStore.ChangeAccountStatusTx(context.Background(), ChangeAccountStatusTxParams{
+		AccountID: account2.ID,
+		Status:    AccountStatusActive,
+		ChangedBy: banker.Username,
+		Reason:    "reopen",
+	})
+	require.ErrorIs(t, err, ErrInvalidStatusTransition)
+
+	changes, err := testStore.ListAccountStatusChanges(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+}
+
+func TestTransferTxWithFee(t *testing.T) {
+	// XTS is reserved for testing, so no other test's transfers pick up this schedule
+	currency := "XTS"
+	account1 := createRandomAccountWithCurrency(t, 10000, currency)
+	account2 := createRandomAccountWithCurrency(t, 0, currency)
+
+	schedules, err := testStore.ReplaceFeeScheduleTx(context.Background(), ReplaceFeeScheduleTxParams{
+		Currency: currency,
+		Tiers: []FeeTier{
+			{MinAmount: 0, FlatFee: 5},
+			{MinAmount: 1000, FlatFee: 10, BasisPoints: 100},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, schedules, 2)
+
+	revenue, err := testStore.GetSystemAccount(context.Background(), GetSystemAccountParams{
+		Kind:     AccountKindRevenue,
+		Currency: currency,
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		amount int64
+		fee    int64
+	}{
+		{amount: 500, fee: 5},
+		{amount: 2000, fee: 10 + 20},
+	}
+
+	for _, tc := range testCases {
+		result, err := testStore.TransferTx(context.Background(), TransferTxParams{
+			FromAccountID: account1.ID,
+			ToAccountID:   account2.ID,
+			Amount:        tc.amount,
+		})
+		require.NoError(t, err)
+
+		require.Equal(t, tc.amount, result.Transfer.Amount)
+		require.Equal(t, tc.fee, result.Transfer.Fee)
+		require.Equal(t, tc.amount-tc.fee, result.Transfer.ToAmount)
+		require.Equal(t, -tc.amount, result.FromEntry.Amount)
+		require.Equal(t, tc.amount-tc.fee, result.ToEntry.Amount)
+		require.Equal(t, revenue.ID, result.FeeEntry.AccountID)
+		require.Equal(t, tc.fee, result.FeeEntry.Amount)
+		require.Equal(t, result.Transfer.ID, result.FeeEntry.TransferID.Int64)
+	}
+
+	updatedRevenue, err := testStore.GetAccount(context.Background(), revenue.ID)
+	require.NoError(t, err)
+	require.Equal(t, revenue.Balance+5+30, updatedRevenue.Balance)
+
+	// a fee that eats the whole amount is refused
+	_, err = testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        5,
+	})
+	require.ErrorIs(t, err, ErrFeeExceedsAmount)
+
+	_, err = testStore.ReplaceFeeScheduleTx(context.Background(), ReplaceFeeScheduleTxParams{Currency: currency})
+	require.NoError(t, err)
+}
+
+func TestHoldTxCaptureWithFee(t *testing.T) {
+	currency := "XTS"
+	account1 := createRandomAccountWithCurrency(t, 1000, currency)
+	account2 := createRandomAccountWithCurrency(t, 0, currency)
+
+	_, err := testStore.ReplaceFeeScheduleTx(context.Background(), ReplaceFeeScheduleTxParams{
+		Currency: currency,
+		Tiers:    []FeeTier{{MinAmount: 0, FlatFee: 5}},
+	})
+	require.NoError(t, err)
+
+	authorized, err := testStore.AuthorizeHoldTx(context.Background(), AuthorizeHoldTxParams{
+		AccountID: account1.ID,
+		Amount:    100,
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	// a capture is charged like any other transfer
+	captured, err := testStore.CaptureHoldTx(context.Background(), CaptureHoldTxParams{
+		HoldID:      authorized.Hold.ID,
+		ToAccountID: account2.ID,
+		Amount:      80,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(5), captured.Transfer.Fee)
+	require.Equal(t, int64(75), captured.Transfer.ToAmount)
+	require.Equal(t, int64(1000-80), captured.FromAccount.Balance)
+	require.Equal(t, int64(75), captured.ToAccount.Balance)
+	require.Equal(t, int64(5), captured.FeeEntry.Amount)
+
+	_, err = testStore.ReplaceFeeScheduleTx(context.Background(), ReplaceFeeScheduleTxParams{Currency: currency})
+	require.NoError(t, err)
+}
@@ -7,22 +7,76 @@ package db
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const addTransferReversedAmount = `-- name: AddTransferReversedAmount :one
+UPDATE transfers
+SET reversed_amount = reversed_amount + $1
+WHERE id = $2
+RETURNING id, from_account_id, to_account_id, amount, created_at, to_amount, exchange_rate, reversal_of, reversed_amount, fee, description, external_reference, metadata
+`
+
+type AddTransferReversedAmountParams struct {
+	Amount int64 `json:"amount"`
+	ID     int64 `json:"id"`
+}
+
+func (q *Queries) AddTransferReversedAmount(ctx context.Context, arg AddTransferReversedAmountParams) (Transfer, error) {
+	row := q.db.QueryRow(ctx, addTransferReversedAmount, arg.Amount, arg.ID)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.ToAmount,
+		&i.ExchangeRate,
+		&i.ReversalOf,
+		&i.ReversedAmount,
+		&i.Fee,
+		&i.Description,
+		&i.ExternalReference,
+		&i.Metadata,
+	)
+	return i, err
+}
+
 const createTransfer = `-- name: CreateTransfer :one
-INSERT INTO transfers (from_account_id, to_account_id, amount)
-VALUES ($1, $2, $3)
-RETURNING id, from_account_id, to_account_id, amount, created_at
+INSERT INTO transfers (from_account_id, to_account_id, amount, to_amount, exchange_rate, fee, reversal_of,
+                       description, external_reference, metadata)
+VALUES ($1, $2, $3, $4, $5, $6, $9, $7, $8, COALESCE($10::jsonb, '{}'))
+RETURNING id, from_account_id, to_account_id, amount, created_at, to_amount, exchange_rate, reversal_of, reversed_amount, fee, description, external_reference, metadata
 `
 
 type CreateTransferParams struct {
-	FromAccountID int64 `json:"from_account_id"`
-	ToAccountID   int64 `json:"to_account_id"`
-	Amount        int64 `json:"amount"`
+	FromAccountID     int64       `json:"from_account_id"`
+	ToAccountID       int64       `json:"to_account_id"`
+	Amount            int64       `json:"amount"`
+	ToAmount          int64       `json:"to_amount"`
+	ExchangeRate      int64       `json:"exchange_rate"`
+	Fee               int64       `json:"fee"`
+	Description       string      `json:"description"`
+	ExternalReference string      `json:"external_reference"`
+	ReversalOf        pgtype.Int8 `json:"reversal_of"`
+	Metadata          []byte      `json:"metadata"`
 }
 
 func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams) (Transfer, error) {
-	row := q.db.QueryRow(ctx, createTransfer, arg.FromAccountID, arg.ToAccountID, arg.Amount)
+	row := q.db.QueryRow(ctx, createTransfer,
+		arg.FromAccountID,
+		arg.ToAccountID,
+		arg.Amount,
+		arg.ToAmount,
+		arg.ExchangeRate,
+		arg.Fee,
+		arg.Description,
+		arg.ExternalReference,
+		arg.ReversalOf,
+		arg.Metadata,
+	)
 	var i Transfer
 	err := row.Scan(
 		&i.ID,
@@ -30,12 +84,20 @@ func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams)
 		&i.ToAccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.ToAmount,
+		&i.ExchangeRate,
+		&i.ReversalOf,
+		&i.ReversedAmount,
+		&i.Fee,
+		&i.Description,
+		&i.ExternalReference,
+		&i.Metadata,
 	)
 	return i, err
 }
 
 const getTransfer = `-- name: GetTransfer :one
-SELECT id, from_account_id, to_account_id, amount, created_at FROM transfers
+SELECT id, from_account_id, to_account_id, amount, created_at, to_amount, exchange_rate, reversal_of, reversed_amount, fee, description, external_reference, metadata FROM transfers
 WHERE id = $1 LIMIT 1
 `
 
@@ -48,33 +110,141 @@ func (q *Queries) GetTransfer(ctx context.Context, id int64) (Transfer, error) {
 		&i.ToAccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.ToAmount,
+		&i.ExchangeRate,
+		&i.ReversalOf,
+		&i.ReversedAmount,
+		&i.Fee,
+		&i.Description,
+		&i.ExternalReference,
+		&i.Metadata,
+	)
+	return i, err
+}
+
+const getTransferForUpdate = `-- name: GetTransferForUpdate :one
+SELECT id, from_account_id, to_account_id, amount, created_at, to_amount, exchange_rate, reversal_of, reversed_amount, fee, description, external_reference, metadata FROM transfers
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetTransferForUpdate(ctx context.Context, id int64) (Transfer, error) {
+	row := q.db.QueryRow(ctx, getTransferForUpdate, id)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.ToAmount,
+		&i.ExchangeRate,
+		&i.ReversalOf,
+		&i.ReversedAmount,
+		&i.Fee,
+		&i.Description,
+		&i.ExternalReference,
+		&i.Metadata,
 	)
 	return i, err
 }
 
 const listTransfers = `-- name: ListTransfers :many
-SELECT id, from_account_id, to_account_id, amount, created_at FROM transfers
-WHERE
-    from_account_id = $1 OR
-    to_account_id = $2
+SELECT id, from_account_id, to_account_id, amount, created_at, to_amount, exchange_rate, reversal_of, reversed_amount, fee, description, external_reference, metadata FROM transfers
+WHERE (($1::boolean AND from_account_id = $2) OR
+       ($3::boolean AND to_account_id = $2))
+  AND id > $4
+  AND ($5::timestamptz IS NULL OR created_at >= $5::timestamptz)
+  AND ($6::timestamptz IS NULL OR created_at < $6::timestamptz)
+  AND ($7::bigint IS NULL OR amount >= $7::bigint)
+  AND ($8::bigint IS NULL OR amount <= $8::bigint)
 ORDER BY id
-LIMIT $3
-OFFSET $4
+LIMIT $9
 `
 
 type ListTransfersParams struct {
-	FromAccountID int64 `json:"from_account_id"`
-	ToAccountID   int64 `json:"to_account_id"`
-	Limit         int32 `json:"limit"`
-	Offset        int32 `json:"offset"`
+	Outgoing    bool               `json:"outgoing"`
+	AccountID   int64              `json:"account_id"`
+	Incoming    bool               `json:"incoming"`
+	AfterID     int64              `json:"after_id"`
+	CreatedFrom pgtype.Timestamptz `json:"created_from"`
+	CreatedTo   pgtype.Timestamptz `json:"created_to"`
+	MinAmount   pgtype.Int8        `json:"min_amount"`
+	MaxAmount   pgtype.Int8        `json:"max_amount"`
+	Limit       int32              `json:"limit"`
 }
 
 func (q *Queries) ListTransfers(ctx context.Context, arg ListTransfersParams) ([]Transfer, error) {
 	rows, err := q.db.Query(ctx, listTransfers,
-		arg.FromAccountID,
-		arg.ToAccountID,
+		arg.Outgoing,
+		arg.AccountID,
+		arg.Incoming,
+		arg.AfterID,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.MinAmount,
+		arg.MaxAmount,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Transfer{}
+	for rows.Next() {
+		var i Transfer
+		if err := rows.Scan(
+			&i.ID,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.ToAmount,
+			&i.ExchangeRate,
+			&i.ReversalOf,
+			&i.ReversedAmount,
+			&i.Fee,
+			&i.Description,
+			&i.ExternalReference,
+			&i.Metadata,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchTransfers = `-- name: SearchTransfers :many
+SELECT t.id, t.from_account_id, t.to_account_id, t.amount, t.created_at, t.to_amount, t.exchange_rate, t.reversal_of, t.reversed_amount, t.fee, t.description, t.external_reference, t.metadata FROM transfers t
+WHERE (t.from_account_id IN (SELECT a.id FROM accounts a WHERE a.owner = $1::varchar) OR
+       t.to_account_id IN (SELECT a.id FROM accounts a WHERE a.owner = $1::varchar))
+  AND ($2::text IS NULL OR
+       to_tsvector('english', t.description) @@ websearch_to_tsquery('english', $2::text))
+  AND ($3::varchar IS NULL OR t.external_reference = $3::varchar)
+  AND t.id > $4
+ORDER BY t.id
+LIMIT $5
+`
+
+type SearchTransfersParams struct {
+	Owner             string      `json:"owner"`
+	Query             pgtype.Text `json:"query"`
+	ExternalReference pgtype.Text `json:"external_reference"`
+	AfterID           int64       `json:"after_id"`
+	Limit             int32       `json:"limit"`
+}
+
+func (q *Queries) SearchTransfers(ctx context.Context, arg SearchTransfersParams) ([]Transfer, error) {
+	rows, err := q.db.Query(ctx, searchTransfers,
+		arg.Owner,
+		arg.Query,
+		arg.ExternalReference,
+		arg.AfterID,
 		arg.Limit,
-		arg.Offset,
 	)
 	if err != nil {
 		return nil, err
@@ -89,6 +259,14 @@ func (q *Queries) ListTransfers(ctx context.Context, arg ListTransfersParams) ([
 			&i.ToAccountID,
 			&i.Amount,
 			&i.CreatedAt,
+			&i.ToAmount,
+			&i.ExchangeRate,
+			&i.ReversalOf,
+			&i.ReversedAmount,
+			&i.Fee,
+			&i.Description,
+			&i.ExternalReference,
+			&i.Metadata,
 		); err != nil {
 			return nil, err
 		}
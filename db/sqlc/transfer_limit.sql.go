@@ -0,0 +1,180 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.20.0
+// source: transfer_limit.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const deleteAccountTransferLimits = `-- name: DeleteAccountTransferLimits :exec
+DELETE
+FROM account_transfer_limits
+WHERE account_id = $1
+`
+
+func (q *Queries) DeleteAccountTransferLimits(ctx context.Context, accountID int64) error {
+	_, err := q.db.Exec(ctx, deleteAccountTransferLimits, accountID)
+	return err
+}
+
+const getAccountTransferLimits = `-- name: GetAccountTransferLimits :one
+SELECT account_id, max_amount, daily_amount, daily_count, updated_by, updated_at
+FROM account_transfer_limits
+WHERE account_id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetAccountTransferLimits(ctx context.Context, accountID int64) (AccountTransferLimit, error) {
+	row := q.db.QueryRow(ctx, getAccountTransferLimits, accountID)
+	var i AccountTransferLimit
+	err := row.Scan(
+		&i.AccountID,
+		&i.MaxAmount,
+		&i.DailyAmount,
+		&i.DailyCount,
+		&i.UpdatedBy,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getDailyTransferUsage = `-- name: GetDailyTransferUsage :one
+SELECT COALESCE(SUM(amount), 0)::bigint AS amount,
+       COUNT(*)::bigint                 AS count
+FROM transfers
+WHERE from_account_id = $1
+  AND created_at >= $2
+  AND reversal_of IS NULL
+`
+
+type GetDailyTransferUsageParams struct {
+	FromAccountID int64     `json:"from_account_id"`
+	Since         time.Time `json:"since"`
+}
+
+type GetDailyTransferUsageRow struct {
+	Amount int64 `json:"amount"`
+	Count  int64 `json:"count"`
+}
+
+// Reversals refund someone else's transfer, so they don't use up the account's limits
+func (q *Queries) GetDailyTransferUsage(ctx context.Context, arg GetDailyTransferUsageParams) (GetDailyTransferUsageRow, error) {
+	row := q.db.QueryRow(ctx, getDailyTransferUsage, arg.FromAccountID, arg.Since)
+	var i GetDailyTransferUsageRow
+	err := row.Scan(&i.Amount, &i.Count)
+	return i, err
+}
+
+const getRoleTransferLimits = `-- name: GetRoleTransferLimits :one
+SELECT id, role, currency, max_amount, daily_amount, daily_count, updated_at
+FROM role_transfer_limits
+WHERE role = $1
+  AND currency = $2
+LIMIT 1
+`
+
+type GetRoleTransferLimitsParams struct {
+	Role     string `json:"role"`
+	Currency string `json:"currency"`
+}
+
+func (q *Queries) GetRoleTransferLimits(ctx context.Context, arg GetRoleTransferLimitsParams) (RoleTransferLimit, error) {
+	row := q.db.QueryRow(ctx, getRoleTransferLimits, arg.Role, arg.Currency)
+	var i RoleTransferLimit
+	err := row.Scan(
+		&i.ID,
+		&i.Role,
+		&i.Currency,
+		&i.MaxAmount,
+		&i.DailyAmount,
+		&i.DailyCount,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertAccountTransferLimits = `-- name: UpsertAccountTransferLimits :one
+INSERT INTO account_transfer_limits (account_id, max_amount, daily_amount, daily_count, updated_by)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (account_id)
+    DO UPDATE SET max_amount   = EXCLUDED.max_amount,
+                  daily_amount = EXCLUDED.daily_amount,
+                  daily_count  = EXCLUDED.daily_count,
+                  updated_by   = EXCLUDED.updated_by,
+                  updated_at   = now()
+RETURNING account_id, max_amount, daily_amount, daily_count, updated_by, updated_at
+`
+
+type UpsertAccountTransferLimitsParams struct {
+	AccountID   int64       `json:"account_id"`
+	MaxAmount   pgtype.Int8 `json:"max_amount"`
+	DailyAmount pgtype.Int8 `json:"daily_amount"`
+	DailyCount  pgtype.Int8 `json:"daily_count"`
+	UpdatedBy   string      `json:"updated_by"`
+}
+
+func (q *Queries) UpsertAccountTransferLimits(ctx context.Context, arg UpsertAccountTransferLimitsParams) (AccountTransferLimit, error) {
+	row := q.db.QueryRow(ctx, upsertAccountTransferLimits,
+		arg.AccountID,
+		arg.MaxAmount,
+		arg.DailyAmount,
+		arg.DailyCount,
+		arg.UpdatedBy,
+	)
+	var i AccountTransferLimit
+	err := row.Scan(
+		&i.AccountID,
+		&i.MaxAmount,
+		&i.DailyAmount,
+		&i.DailyCount,
+		&i.UpdatedBy,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertRoleTransferLimits = `-- name: UpsertRoleTransferLimits :one
+INSERT INTO role_transfer_limits (role, currency, max_amount, daily_amount, daily_count)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (role, currency)
+    DO UPDATE SET max_amount   = EXCLUDED.max_amount,
+                  daily_amount = EXCLUDED.daily_amount,
+                  daily_count  = EXCLUDED.daily_count,
+                  updated_at   = now()
+RETURNING id, role, currency, max_amount, daily_amount, daily_count, updated_at
+`
+
+type UpsertRoleTransferLimitsParams struct {
+	Role        string      `json:"role"`
+	Currency    string      `json:"currency"`
+	MaxAmount   pgtype.Int8 `json:"max_amount"`
+	DailyAmount pgtype.Int8 `json:"daily_amount"`
+	DailyCount  pgtype.Int8 `json:"daily_count"`
+}
+
+func (q *Queries) UpsertRoleTransferLimits(ctx context.Context, arg UpsertRoleTransferLimitsParams) (RoleTransferLimit, error) {
+	row := q.db.QueryRow(ctx, upsertRoleTransferLimits,
+		arg.Role,
+		arg.Currency,
+		arg.MaxAmount,
+		arg.DailyAmount,
+		arg.DailyCount,
+	)
+	var i RoleTransferLimit
+	err := row.Scan(
+		&i.ID,
+		&i.Role,
+		&i.Currency,
+		&i.MaxAmount,
+		&i.DailyAmount,
+		&i.DailyCount,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
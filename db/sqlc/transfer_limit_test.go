@@ -0,0 +1,146 @@
+package db
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"goBank/util"
+	"testing"
+	"time"
+)
+
+func TestTransferLimitsCheck(t *testing.T) {
+	limits := TransferLimits{
+		MaxAmount:   pgtype.Int8{Int64: 100, Valid: true},
+		DailyAmount: pgtype.Int8{Int64: 250, Valid: true},
+		DailyCount:  pgtype.Int8{Int64: 3, Valid: true},
+	}
+
+	require.NoError(t, limits.Check(TransferUsage{}, 100))
+	require.ErrorIs(t, limits.Check(TransferUsage{}, 101), ErrTransferAmountLimit)
+	require.NoError(t, limits.Check(TransferUsage{Amount: 150, Count: 2}, 100))
+	require.ErrorIs(t, limits.Check(TransferUsage{Amount: 200, Count: 2}, 100), ErrDailyAmountLimit)
+	require.ErrorIs(t, limits.Check(TransferUsage{Amount: 30, Count: 3}, 10), ErrDailyCountLimit)
+	require.NoError(t, TransferLimits{}.Check(TransferUsage{Amount: 1e9, Count: 1e6}, 1e9))
+	require.NoError(t, limits.CheckAmount(100))
+	require.ErrorIs(t, limits.CheckAmount(101), ErrTransferAmountLimit)
+}
+
+func TestTransferTxWithLimits(t *testing.T) {
+	// XTS is reserved for testing, so these role limits don't reach any other test's transfers
+	currency := "XTS"
+	account1 := createRandomAccountWithCurrency(t, 1000, currency)
+	account2 := createRandomAccountWithCurrency(t, 0, currency)
+
+	_, err := testStore.UpsertRoleTransferLimits(context.Background(), UpsertRoleTransferLimitsParams{
+		Role:        util.DepositorRole,
+		Currency:    currency,
+		MaxAmount:   pgtype.Int8{Int64: 100, Valid: true},
+		DailyAmount: pgtype.Int8{Int64: 150, Valid: true},
+	})
+	require.NoError(t, err)
+
+	transfer := func(amount int64) error {
+		_, err := testStore.TransferTx(context.Background(), TransferTxParams{
+			FromAccountID: account1.ID,
+			ToAccountID:   account2.ID,
+			Amount:        amount,
+			Role:          util.DepositorRole,
+		})
+		return err
+	}
+
+	require.ErrorIs(t, transfer(101), ErrTransferAmountLimit)
+	require.NoError(t, transfer(100))
+	require.ErrorIs(t, transfer(51), ErrDailyAmountLimit)
+
+	// holds can't get around the limits either
+	_, err = testStore.AuthorizeHoldTx(context.Background(), AuthorizeHoldTxParams{
+		AccountID: account1.ID,
+		Amount:    101,
+		ExpiresAt: time.Now().Add(time.Hour),
+		Role:      util.DepositorRole,
+	})
+	require.ErrorIs(t, err, ErrTransferAmountLimit)
+
+	authorized, err := testStore.AuthorizeHoldTx(context.Background(), AuthorizeHoldTxParams{
+		AccountID: account1.ID,
+		Amount:    51,
+		ExpiresAt: time.Now().Add(time.Hour),
+		Role:      util.DepositorRole,
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.CaptureHoldTx(context.Background(), CaptureHoldTxParams{
+		HoldID:      authorized.Hold.ID,
+		ToAccountID: account2.ID,
+		Amount:      51,
+		Role:        util.DepositorRole,
+	})
+	require.ErrorIs(t, err, ErrDailyAmountLimit)
+
+	_, err = testStore.VoidHoldTx(context.Background(), authorized.Hold.ID)
+	require.NoError(t, err)
+
+	// nor can transfers that wait for approval, the initiator's limits apply when they're requested and approved
+	requestPending := func(amount int64) (PendingTransfer, error) {
+		return testStore.CreatePendingTransferTx(context.Background(), CreatePendingTransferTxParams{
+			FromAccountID: account1.ID,
+			ToAccountID:   account2.ID,
+			Amount:        amount,
+			Initiator:     account1.Owner,
+			InitiatorRole: util.DepositorRole,
+			ExpiresAt:     time.Now().Add(time.Hour),
+		})
+	}
+
+	_, err = requestPending(101)
+	require.ErrorIs(t, err, ErrTransferAmountLimit)
+
+	pending, err := requestPending(50)
+	require.NoError(t, err)
+	require.Equal(t, util.DepositorRole, pending.InitiatorRole)
+
+	require.NoError(t, transfer(50))
+
+	_, err = testStore.ApprovePendingTransferTx(context.Background(), ReviewPendingTransferTxParams{
+		PendingTransferID: pending.ID,
+		Reviewer:          createRandomUser(t).Username,
+	})
+	require.ErrorIs(t, err, ErrDailyAmountLimit)
+
+	// the account's own limits take over from the role's
+	_, err = testStore.UpsertAccountTransferLimits(context.Background(), UpsertAccountTransferLimitsParams{
+		AccountID:   account1.ID,
+		DailyAmount: pgtype.Int8{Int64: 200, Valid: true},
+		DailyCount:  pgtype.Int8{Int64: 3, Valid: true},
+		UpdatedBy:   account1.Owner,
+	})
+	require.NoError(t, err)
+
+	result, err := testStore.TransferLimitsTx(context.Background(), TransferLimitsTxParams{
+		AccountID: account1.ID,
+		Role:      util.DepositorRole,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(100), result.Limits.MaxAmount.Int64)
+	require.Equal(t, int64(200), result.Limits.DailyAmount.Int64)
+	require.Equal(t, TransferUsage{Amount: 150, Count: 2}, result.Usage)
+
+	require.NoError(t, transfer(50))
+	require.ErrorIs(t, transfer(1), ErrDailyCountLimit)
+
+	// money the bank moves itself isn't limited
+	_, err = testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        1,
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.UpsertRoleTransferLimits(context.Background(), UpsertRoleTransferLimitsParams{
+		Role:     util.DepositorRole,
+		Currency: currency,
+	})
+	require.NoError(t, err)
+}
@@ -2,10 +2,12 @@ package db
 
 import (
 	"context"
+	"encoding/json"
 	"goBank/util"
 	"testing"
 	"time"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/require"
 )
 
@@ -14,7 +16,9 @@ func createRandomTransfer(t *testing.T, account1, account2 Account) Transfer {
 		FromAccountID: account1.ID,
 		ToAccountID:   account2.ID,
 		Amount:        util.RandomMoney(),
+		ExchangeRate:  IdentityExchangeRate,
 	}
+	arg.ToAmount = arg.Amount
 
 	transfer, err := testStore.CreateTransfer(context.Background(), arg)
 	require.NoError(t, err)
@@ -23,6 +27,8 @@ func createRandomTransfer(t *testing.T, account1, account2 Account) Transfer {
 	require.Equal(t, arg.FromAccountID, transfer.FromAccountID)
 	require.Equal(t, arg.ToAccountID, transfer.ToAccountID)
 	require.Equal(t, arg.Amount, transfer.Amount)
+	require.Equal(t, arg.ToAmount, transfer.ToAmount)
+	require.Equal(t, arg.ExchangeRate, transfer.ExchangeRate)
 
 	require.NotZero(t, transfer.ID)
 	require.NotZero(t, transfer.CreatedAt)
@@ -62,18 +68,95 @@ func TestListTransfer(t *testing.T) {
 	}
 
 	arg := ListTransfersParams{
-		FromAccountID: account1.ID,
-		ToAccountID:   account1.ID,
-		Limit:         5,
-		Offset:        5,
+		AccountID: account1.ID,
+		Outgoing:  true,
+		Incoming:  true,
+		Limit:     5,
 	}
 
 	transfers, err := testStore.ListTransfers(context.Background(), arg)
 	require.NoError(t, err)
 	require.Len(t, transfers, 5)
 
+	arg.AfterID = transfers[len(transfers)-1].ID
+	transfers, err = testStore.ListTransfers(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, transfers, 5)
+
 	for _, transfer := range transfers {
 		require.NotEmpty(t, transfer)
+		require.Greater(t, transfer.ID, arg.AfterID)
 		require.True(t, transfer.FromAccountID == account1.ID || transfer.ToAccountID == account1.ID)
 	}
+
+	arg = ListTransfersParams{
+		AccountID: account1.ID,
+		Incoming:  true,
+		Limit:     10,
+	}
+	transfers, err = testStore.ListTransfers(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, transfers, 5)
+
+	for _, transfer := range transfers {
+		require.Equal(t, account1.ID, transfer.ToAccountID)
+	}
+}
+
+func TestSearchTransfers(t *testing.T) {
+	account1 := createRandomAccountWithBalance(t, 1000)
+	account2 := createRandomAccountWithBalance(t, 1000)
+	reference := util.RandomString(12)
+
+	result, err := testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+		TransferDetails: TransferDetails{
+			Description:       "Paying the March rent for the flat",
+			ExternalReference: reference,
+			Metadata:          json.RawMessage(`{"invoice": 42}`),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Paying the March rent for the flat", result.Transfer.Description)
+	require.Equal(t, reference, result.Transfer.ExternalReference)
+	require.JSONEq(t, `{"invoice": 42}`, string(result.Transfer.Metadata))
+
+	other, err := testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account2.ID,
+		ToAccountID:   account1.ID,
+		Amount:        5,
+	})
+	require.NoError(t, err)
+	require.JSONEq(t, `{}`, string(other.Transfer.Metadata))
+
+	// Stemming matches "rents" to "rent", and the receiver finds it in their history too
+	for _, owner := range []string{account1.Owner, account2.Owner} {
+		transfers, err := testStore.SearchTransfers(context.Background(), SearchTransfersParams{
+			Owner: owner,
+			Query: pgtype.Text{String: "rents march", Valid: true},
+			Limit: 10,
+		})
+		require.NoError(t, err)
+		require.Len(t, transfers, 1)
+		require.Equal(t, result.Transfer.ID, transfers[0].ID)
+	}
+
+	transfers, err := testStore.SearchTransfers(context.Background(), SearchTransfersParams{
+		Owner:             account1.Owner,
+		ExternalReference: pgtype.Text{String: reference, Valid: true},
+		Limit:             10,
+	})
+	require.NoError(t, err)
+	require.Len(t, transfers, 1)
+
+	// Someone else's transfers never show up
+	transfers, err = testStore.SearchTransfers(context.Background(), SearchTransfersParams{
+		Owner:             createRandomAccount(t).Owner,
+		ExternalReference: pgtype.Text{String: reference, Valid: true},
+		Limit:             10,
+	})
+	require.NoError(t, err)
+	require.Empty(t, transfers)
 }
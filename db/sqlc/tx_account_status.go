@@ -0,0 +1,102 @@
+package db
+
+import (
+	"context"
+	"errors"
+)
+
+// Statuses an account moves through, closed is final
+const (
+	AccountStatusActive = "active"
+	AccountStatusFrozen = "frozen"
+	AccountStatusClosed = "closed"
+)
+
+// Different types of error returned when an account can't take part in a transfer or change status
+var (
+	ErrAccountFrozen           = errors.New("account is frozen")
+	ErrAccountClosed           = errors.New("account is closed")
+	ErrInvalidStatusTransition = errors.New("account can't move to that status")
+	ErrAccountNotEmpty         = errors.New("account must have a zero balance and nothing held to be closed")
+)
+
+// accountStatusTransitions lists the statuses each status can move to
+var accountStatusTransitions = map[string][]string{
+	AccountStatusActive: {AccountStatusFrozen, AccountStatusClosed},
+	AccountStatusFrozen: {AccountStatusActive, AccountStatusClosed},
+}
+
+// CheckActive returns ErrAccountFrozen or ErrAccountClosed unless money can move in and out of the account
+func (account Account) CheckActive() error {
+	switch account.Status {
+	case AccountStatusFrozen:
+		return ErrAccountFrozen
+	case AccountStatusClosed:
+		return ErrAccountClosed
+	}
+	return nil
+}
+
+// ChangeAccountStatusTxParams contains the input parameters of the change account status transaction
+type ChangeAccountStatusTxParams struct {
+	AccountID int64  `json:"account_id"`
+	Status    string `json:"status"`
+	ChangedBy string `json:"changed_by"`
+	Reason    string `json:"reason"`
+}
+
+// ChangeAccountStatusTxResult is the result of the change account status transaction
+type ChangeAccountStatusTxResult struct {
+	Account Account             `json:"account"`
+	Change  AccountStatusChange `json:"change"`
+}
+
+// ChangeAccountStatusTx moves an account to another status and records who did it and why.
+// An account can only be closed once its balance is zero and nothing is held on it.
+func (store *SQLStore) ChangeAccountStatusTx(ctx context.Context, arg ChangeAccountStatusTxParams) (ChangeAccountStatusTxResult, error) {
+	var result ChangeAccountStatusTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		//Locked so a transfer can't land between the balance check and the status change
+		account, err := q.GetAccountForUpdate(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		if !canChangeAccountStatus(account.Status, arg.Status) {
+			return ErrInvalidStatusTransition
+		}
+
+		if arg.Status == AccountStatusClosed && (account.Balance != 0 || account.HeldBalance != 0) {
+			return ErrAccountNotEmpty
+		}
+
+		result.Account, err = q.UpdateAccountStatus(ctx, UpdateAccountStatusParams{
+			ID:     arg.AccountID,
+			Status: arg.Status,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Change, err = q.CreateAccountStatusChange(ctx, CreateAccountStatusChangeParams{
+			AccountID:  arg.AccountID,
+			FromStatus: account.Status,
+			ToStatus:   arg.Status,
+			ChangedBy:  arg.ChangedBy,
+			Reason:     arg.Reason,
+		})
+		return err
+	})
+
+	return result, err
+}
+
+func canChangeAccountStatus(from, to string) bool {
+	for _, status := range accountStatusTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
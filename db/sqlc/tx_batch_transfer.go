@@ -0,0 +1,148 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Different types of error returned when a batch can't be paid as a whole
+var (
+	ErrBatchEmpty            = errors.New("batch has no transfers")
+	ErrBatchCurrencyMismatch = errors.New("every account in a batch must share the from account's currency")
+	ErrBatchSelfTransfer     = errors.New("a batch can't pay the account it's paid from")
+)
+
+// BatchTransferLeg is one payment of a batch
+type BatchTransferLeg struct {
+	ToAccountID int64 `json:"to_account_id"`
+	Amount      int64 `json:"amount"`
+}
+
+// BatchTransferTxParams contains the input parameters of the batch transfer transaction
+type BatchTransferTxParams struct {
+	FromAccountID int64              `json:"from_account_id"`
+	Legs          []BatchTransferLeg `json:"legs"`
+	// Role of the user sending the money, every leg counts towards its transfer limits
+	Role string `json:"-"`
+	// The user sending the money, required with an IdempotencyKey since keys are only unique per user
+	Username string `json:"-"`
+	// Optional, a replay with the same key returns the original result instead of paying the batch again
+	IdempotencyKey string `json:"-"`
+}
+
+// BatchTransferTxResult is the result of the batch transfer transaction
+type BatchTransferTxResult struct {
+	// The from account after every leg was paid
+	FromAccount Account `json:"from_account"`
+	// One result per leg, in the order of the legs
+	Transfers   []TransferTxResult `json:"transfers"`
+	TotalAmount int64              `json:"total_amount"`
+	TotalFee    int64              `json:"total_fee"`
+}
+
+// BatchTransferTx pays every leg of a batch from one account within a single DB transaction, so either all of them
+// go through or none do. Every account is locked up front in ID order, the same order TransferTx locks in,
+// before the currencies and the from account's funds are checked against the whole batch.
+// An error about a single leg is wrapped with its index and still matches the error TransferTx would return.
+func (store *SQLStore) BatchTransferTx(ctx context.Context, arg BatchTransferTxParams) (BatchTransferTxResult, error) {
+	var result BatchTransferTxResult
+
+	if len(arg.Legs) == 0 {
+		return result, ErrBatchEmpty
+	}
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		if arg.IdempotencyKey != "" {
+			replayed, err := claimIdempotencyKey(ctx, q, arg.Username, arg.IdempotencyKey, arg, &result)
+			if err != nil || replayed {
+				return err
+			}
+		}
+
+		accounts, err := lockBatchAccounts(ctx, q, arg)
+		if err != nil {
+			return err
+		}
+
+		fromAccount := accounts[arg.FromAccountID]
+		if err = fromAccount.CheckActive(); err != nil {
+			return err
+		}
+
+		var total int64
+		for i, leg := range arg.Legs {
+			if leg.ToAccountID == arg.FromAccountID {
+				return fmt.Errorf("leg %d: %w", i, ErrBatchSelfTransfer)
+			}
+
+			toAccount := accounts[leg.ToAccountID]
+			if toAccount.Currency != fromAccount.Currency {
+				return fmt.Errorf("leg %d: %w", i, ErrBatchCurrencyMismatch)
+			}
+			if err = toAccount.CheckActive(); err != nil {
+				return fmt.Errorf("leg %d: %w", i, err)
+			}
+			total += leg.Amount
+		}
+
+		//Fees come out of what each leg credits, so the batch debits exactly its total
+		if fromAccount.AvailableBalance()+fromAccount.OverdraftLimit < total {
+			return ErrInsufficientFunds
+		}
+
+		result.Transfers = make([]TransferTxResult, len(arg.Legs))
+		for i, leg := range arg.Legs {
+			result.Transfers[i], err = moveMoney(ctx, q, moveMoneyParams{
+				FromAccountID: arg.FromAccountID,
+				ToAccountID:   leg.ToAccountID,
+				Amount:        leg.Amount,
+				ToAmount:      leg.Amount,
+				ExchangeRate:  IdentityExchangeRate,
+				ChargeFee:     true,
+				Role:          arg.Role,
+			})
+			if err != nil {
+				return fmt.Errorf("leg %d: %w", i, err)
+			}
+
+			result.TotalAmount += result.Transfers[i].Transfer.Amount
+			result.TotalFee += result.Transfers[i].Transfer.Fee
+		}
+		result.FromAccount = result.Transfers[len(result.Transfers)-1].FromAccount
+
+		if arg.IdempotencyKey == "" {
+			return nil
+		}
+
+		return saveIdempotencyKeyResult(ctx, q, arg.Username, arg.IdempotencyKey, result.Transfers[0].Transfer.ID, result)
+	})
+
+	return result, err
+}
+
+// lockBatchAccounts locks the from account and every to account of a batch in ID order and returns them by ID.
+// It must run inside execTx.
+func lockBatchAccounts(ctx context.Context, q *Queries, arg BatchTransferTxParams) (map[int64]Account, error) {
+	accounts := map[int64]Account{arg.FromAccountID: {}}
+	for _, leg := range arg.Legs {
+		accounts[leg.ToAccountID] = Account{}
+	}
+
+	ids := make([]int64, 0, len(accounts))
+	for id := range accounts {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	for _, id := range ids {
+		account, err := q.GetAccountForUpdate(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		accounts[id] = account
+	}
+
+	return accounts, nil
+}
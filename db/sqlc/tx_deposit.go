@@ -0,0 +1,78 @@
+package db
+
+import (
+	"context"
+)
+
+// DepositTxParams contains the input parameters of the deposit transaction
+type DepositTxParams struct {
+	AccountID int64 `json:"account_id"`
+	Amount    int64 `json:"amount"`
+	// The banker who took in the funds
+	DepositedBy string `json:"deposited_by"`
+	Reference   string `json:"reference"`
+	// Optional, a replay with the same key returns the original deposit instead of depositing again
+	IdempotencyKey string `json:"-"`
+}
+
+// DepositTxResult is the result of the deposit transaction
+type DepositTxResult struct {
+	Deposit Deposit `json:"deposit"`
+	TransferTxResult
+}
+
+// DepositTx credits funds taken in from outside the bank to an account with a transfer
+// from the bank's cash account in the account's currency, the cash account going negative by what it owes.
+// Returns ErrAccountFrozen or ErrAccountClosed if the account isn't active.
+func (store *SQLStore) DepositTx(ctx context.Context, arg DepositTxParams) (DepositTxResult, error) {
+	var result DepositTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		if arg.IdempotencyKey != "" {
+			replayed, err := claimIdempotencyKey(ctx, q, arg.DepositedBy, arg.IdempotencyKey, arg, &result)
+			if err != nil || replayed {
+				return err
+			}
+		}
+
+		account, err := q.GetAccount(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		cash, err := lookupSystemAccount(ctx, q, AccountKindCash, account.Currency)
+		if err != nil {
+			return err
+		}
+
+		result.TransferTxResult, err = moveMoney(ctx, q, moveMoneyParams{
+			FromAccountID: cash.ID,
+			ToAccountID:   account.ID,
+			Amount:        arg.Amount,
+			ToAmount:      arg.Amount,
+			ExchangeRate:  IdentityExchangeRate,
+			Details: TransferDetails{
+				Description:       "Deposit",
+				ExternalReference: arg.Reference,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Deposit, err = q.CreateDeposit(ctx, CreateDepositParams{
+			AccountID:   account.ID,
+			Amount:      arg.Amount,
+			TransferID:  result.Transfer.ID,
+			DepositedBy: arg.DepositedBy,
+			Reference:   arg.Reference,
+		})
+		if err != nil || arg.IdempotencyKey == "" {
+			return err
+		}
+
+		return saveIdempotencyKeyResult(ctx, q, arg.DepositedBy, arg.IdempotencyKey, result.Transfer.ID, result)
+	})
+
+	return result, err
+}
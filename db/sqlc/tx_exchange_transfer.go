@@ -0,0 +1,184 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"goBank/util"
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// ExchangeRateScale is the fixed-point scale of every stored exchange rate, 1.0 is 100000000
+	ExchangeRateScale = 100_000_000
+	// IdentityExchangeRate is recorded on transfers between accounts of the same currency
+	IdentityExchangeRate = ExchangeRateScale
+)
+
+// Different types of error returned when an exchange quote can't be applied
+var (
+	ErrQuoteExpired   = errors.New("exchange quote has expired")
+	ErrQuoteUsed      = errors.New("exchange quote has already been used")
+	ErrQuoteMismatch  = errors.New("exchange quote doesn't match the transfer")
+	ErrAmountTooSmall = errors.New("amount is too small to convert")
+	ErrAmountOverflow = errors.New("converted amount is too large")
+)
+
+// ConvertAmount converts an amount in from's minor units to to's minor units, rounding down.
+// Rates are quoted per major unit, so the result is rescaled when the currencies have different minor units:
+// 1.00 USD (100) at 150 JPY per USD is 150 JPY (150), not 15000.
+// It returns ErrAmountOverflow when the result doesn't fit in an int64.
+func ConvertAmount(amount int64, rate int64, from util.Currency, to util.Currency) (int64, error) {
+	converted := new(big.Int).Mul(big.NewInt(amount), big.NewInt(rate))
+	divisor := big.NewInt(ExchangeRateScale)
+
+	exponent := to.MinorUnits - from.MinorUnits
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(exponent))), nil)
+	if exponent > 0 {
+		converted.Mul(converted, scale)
+	} else {
+		divisor.Mul(divisor, scale)
+	}
+
+	converted.Quo(converted, divisor)
+	if !converted.IsInt64() {
+		return 0, ErrAmountOverflow
+	}
+	return converted.Int64(), nil
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// ExchangeTransferTxParams contains the input parameters of the cross-currency transfer transaction
+type ExchangeTransferTxParams struct {
+	FromAccountID int64     `json:"from_account_id"`
+	ToAccountID   int64     `json:"to_account_id"`
+	Amount        int64     `json:"amount"`
+	QuoteID       uuid.UUID `json:"quote_id"`
+	TransferDetails
+	// Username must own the quote
+	Username string `json:"username"`
+	// Role of the user sending the money, its transfer limits apply, see checkTransferLimits
+	Role string `json:"-"`
+	// Optional, a replay with the same key returns the original result instead of transferring again
+	IdempotencyKey string `json:"-"`
+}
+
+// ExchangeTransferTx moves money between accounts of different currencies at the rate locked by an exchange quote.
+// It debits Amount in the from account's currency, credits the converted amount in the to account's currency
+// and uses up the quote, all within a single DB transaction.
+func (store *SQLStore) ExchangeTransferTx(ctx context.Context, arg ExchangeTransferTxParams) (TransferTxResult, error) {
+	var result TransferTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		if arg.IdempotencyKey != "" {
+			replayed, err := claimIdempotencyKey(ctx, q, arg.Username, arg.IdempotencyKey, arg, &result)
+			if err != nil || replayed {
+				return err
+			}
+		}
+
+		toAmount, rate, err := applyExchangeQuote(ctx, q, arg.QuoteID, arg.Username, arg.FromAccountID, arg.ToAccountID, arg.Amount)
+		if err != nil {
+			return err
+		}
+
+		result, err = moveMoney(ctx, q, moveMoneyParams{
+			FromAccountID: arg.FromAccountID,
+			ToAccountID:   arg.ToAccountID,
+			Amount:        arg.Amount,
+			ToAmount:      toAmount,
+			ExchangeRate:  rate,
+			Role:          arg.Role,
+			Details:       arg.TransferDetails,
+		})
+
+		if err != nil || arg.IdempotencyKey == "" {
+			return err
+		}
+
+		return saveIdempotencyKeyResult(ctx, q, arg.Username, arg.IdempotencyKey, result.Transfer.ID, result)
+	})
+
+	return result, err
+}
+
+// applyExchangeQuote checks a quote against the transfer it's used for, marks it used and converts the amount.
+// It returns the amount to credit and the applied rate, and must run inside execTx.
+func applyExchangeQuote(
+	ctx context.Context,
+	q *Queries,
+	quoteID uuid.UUID,
+	username string,
+	fromAccountID int64,
+	toAccountID int64,
+	amount int64,
+) (toAmount int64, rate int64, err error) {
+	//Locking the quote so two transfers can't both use it
+	quote, err := q.GetExchangeQuoteForUpdate(ctx, quoteID)
+	if err != nil {
+		return
+	}
+
+	if quote.Username != username {
+		err = ErrQuoteMismatch
+		return
+	}
+
+	if quote.IsUsed {
+		err = ErrQuoteUsed
+		return
+	}
+
+	if time.Now().After(quote.ExpiresAt) {
+		err = ErrQuoteExpired
+		return
+	}
+
+	fromAccount, err := q.GetAccount(ctx, fromAccountID)
+	if err != nil {
+		return
+	}
+
+	toAccount, err := q.GetAccount(ctx, toAccountID)
+	if err != nil {
+		return
+	}
+
+	if fromAccount.Currency != quote.FromCurrency || toAccount.Currency != quote.ToCurrency {
+		err = ErrQuoteMismatch
+		return
+	}
+
+	from, ok := util.LookupCurrency(quote.FromCurrency)
+	if !ok {
+		err = fmt.Errorf("unsupported currency %s", quote.FromCurrency)
+		return
+	}
+
+	to, ok := util.LookupCurrency(quote.ToCurrency)
+	if !ok {
+		err = fmt.Errorf("unsupported currency %s", quote.ToCurrency)
+		return
+	}
+
+	toAmount, err = ConvertAmount(amount, quote.Rate, from, to)
+	if err != nil {
+		return
+	}
+	if toAmount <= 0 {
+		err = ErrAmountTooSmall
+		return
+	}
+
+	_, err = q.UseExchangeQuote(ctx, quote.ID)
+	return toAmount, quote.Rate, err
+}
@@ -0,0 +1,91 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"math/big"
+)
+
+// Kinds of account, customers own the regular ones and the bank owns one of each other kind per currency
+const (
+	AccountKindCustomer = "customer"
+	// Collects the fees charged on transfers
+	AccountKindRevenue = "revenue"
+	// Pays the interest credited to savings accounts
+	AccountKindExpense = "expense"
+	// Stands for the funds the bank holds outside the ledger, deposits come from it and settled withdrawals go to it
+	AccountKindCash = "cash"
+	// Holds withdrawals until the processor settles them
+	AccountKindClearing = "clearing"
+)
+
+// BasisPointsScale is how many basis points make up the whole amount
+const BasisPointsScale = 10_000
+
+// ErrFeeExceedsAmount is returned when the fee would leave nothing for the to account
+var ErrFeeExceedsAmount = errors.New("fee is not less than the amount")
+
+// Fee is the tier's flat fee plus its percentage of amount, rounded down to the nearest minor unit
+func (schedule FeeSchedule) Fee(amount int64) int64 {
+	percent := new(big.Int).Mul(big.NewInt(amount), big.NewInt(schedule.BasisPoints))
+	percent.Quo(percent, big.NewInt(BasisPointsScale))
+	return schedule.FlatFee + percent.Int64()
+}
+
+// transferFee looks up the tier that covers amount in currency, a currency without a schedule is free
+func transferFee(ctx context.Context, q *Queries, currency string, amount int64) (int64, error) {
+	schedule, err := q.GetFeeSchedule(ctx, GetFeeScheduleParams{
+		Currency: currency,
+		Amount:   amount,
+	})
+	if err != nil {
+		if errors.Is(err, ErrRecordNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return schedule.Fee(amount), nil
+}
+
+// FeeTier is one tier of a currency's fee schedule
+type FeeTier struct {
+	MinAmount   int64 `json:"min_amount"`
+	FlatFee     int64 `json:"flat_fee"`
+	BasisPoints int64 `json:"basis_points"`
+}
+
+// ReplaceFeeScheduleTxParams contains the input parameters of the replace fee schedule transaction
+type ReplaceFeeScheduleTxParams struct {
+	Currency string `json:"currency"`
+	// A flat or percentage fee is a single tier from 0, no tiers makes the currency free
+	Tiers []FeeTier `json:"tiers"`
+}
+
+// ReplaceFeeScheduleTx swaps a currency's fee schedule for a new one in a single step,
+// so no transfer sees half of each.
+func (store *SQLStore) ReplaceFeeScheduleTx(ctx context.Context, arg ReplaceFeeScheduleTxParams) ([]FeeSchedule, error) {
+	var result []FeeSchedule
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		err := q.DeleteFeeSchedules(ctx, arg.Currency)
+		if err != nil {
+			return err
+		}
+
+		for _, tier := range arg.Tiers {
+			schedule, err := q.CreateFeeSchedule(ctx, CreateFeeScheduleParams{
+				Currency:    arg.Currency,
+				MinAmount:   tier.MinAmount,
+				FlatFee:     tier.FlatFee,
+				BasisPoints: tier.BasisPoints,
+			})
+			if err != nil {
+				return err
+			}
+			result = append(result, schedule)
+		}
+		return nil
+	})
+
+	return result, err
+}
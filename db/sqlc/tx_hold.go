@@ -0,0 +1,226 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Statuses a hold moves through, a hold starts out authorized and ends in exactly one of the others
+const (
+	HoldStatusAuthorized = "authorized"
+	HoldStatusCaptured   = "captured"
+	HoldStatusVoided     = "voided"
+	HoldStatusExpired    = "expired"
+)
+
+// Different types of error returned when a hold can't be captured, voided or expired
+var (
+	ErrHoldNotAuthorized  = errors.New("hold is no longer authorized")
+	ErrHoldExpired        = errors.New("hold has expired")
+	ErrHoldNotExpired     = errors.New("hold has not expired yet")
+	ErrCaptureExceedsHold = errors.New("capture amount exceeds the held amount")
+)
+
+// AvailableBalance is the part of the balance that isn't reserved by authorized holds
+func (account Account) AvailableBalance() int64 {
+	return account.Balance - account.HeldBalance
+}
+
+// AuthorizeHoldTxParams contains the input parameters of the authorize hold transaction
+type AuthorizeHoldTxParams struct {
+	AccountID int64     `json:"account_id"`
+	Amount    int64     `json:"amount"`
+	ExpiresAt time.Time `json:"expires_at"`
+	// Role of the account's owner, a hold can't be for more than its per-transfer limit
+	Role string `json:"-"`
+}
+
+// HoldTxResult is the result of the hold transactions that don't move money
+type HoldTxResult struct {
+	Hold    Hold    `json:"hold"`
+	Account Account `json:"account"`
+}
+
+// AuthorizeHoldTx reserves an amount on an account without moving any money.
+// The held amount comes off the available balance until the hold is captured, voided or expires.
+// Returns ErrInsufficientFunds if the available balance plus the overdraft limit doesn't cover the amount,
+// or ErrTransferAmountLimit if it's over the per-transfer limit
+func (store *SQLStore) AuthorizeHoldTx(ctx context.Context, arg AuthorizeHoldTxParams) (HoldTxResult, error) {
+	var result HoldTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		account, err := q.GetAccountForUpdate(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		if err = account.CheckActive(); err != nil {
+			return err
+		}
+
+		if account.AvailableBalance()+account.OverdraftLimit < arg.Amount {
+			return ErrInsufficientFunds
+		}
+
+		//The daily limits are checked when the hold is captured and the money moves
+		if arg.Role != "" {
+			limits, err := transferLimits(ctx, q, account, arg.Role)
+			if err != nil {
+				return err
+			}
+
+			if err = limits.CheckAmount(arg.Amount); err != nil {
+				return err
+			}
+		}
+
+		result.Hold, err = q.CreateHold(ctx, CreateHoldParams{
+			AccountID: arg.AccountID,
+			Amount:    arg.Amount,
+			ExpiresAt: arg.ExpiresAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Account, err = q.AddAccountHeldBalance(ctx, AddAccountHeldBalanceParams{
+			ID:     arg.AccountID,
+			Amount: arg.Amount,
+		})
+		return err
+	})
+
+	return result, err
+}
+
+// CaptureHoldTxParams contains the input parameters of the capture hold transaction
+type CaptureHoldTxParams struct {
+	HoldID      int64 `json:"hold_id"`
+	ToAccountID int64 `json:"to_account_id"`
+	// Amount can be less than the held amount, the remainder is released
+	Amount int64 `json:"amount"`
+	// Role of the user capturing the hold, its transfer limits apply, see checkTransferLimits
+	Role string `json:"-"`
+}
+
+// CaptureHoldTxResult is the result of the capture hold transaction
+type CaptureHoldTxResult struct {
+	Hold Hold `json:"hold"`
+	TransferTxResult
+}
+
+// CaptureHoldTx turns all or part of an authorized hold into a real transfer to another account.
+// The whole hold is released in the same DB transaction, so a partial capture frees up the rest.
+func (store *SQLStore) CaptureHoldTx(ctx context.Context, arg CaptureHoldTxParams) (CaptureHoldTxResult, error) {
+	var result CaptureHoldTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		hold, err := lockAuthorizedHold(ctx, q, arg.HoldID)
+		if err != nil {
+			return err
+		}
+
+		if time.Now().After(hold.ExpiresAt) {
+			return ErrHoldExpired
+		}
+
+		if arg.Amount > hold.Amount {
+			return ErrCaptureExceedsHold
+		}
+
+		result.TransferTxResult, err = moveMoney(ctx, q, moveMoneyParams{
+			FromAccountID: hold.AccountID,
+			ToAccountID:   arg.ToAccountID,
+			Amount:        arg.Amount,
+			ToAmount:      arg.Amount,
+			ExchangeRate:  IdentityExchangeRate,
+			ReleaseHeld:   hold.Amount,
+			ChargeFee:     true,
+			Role:          arg.Role,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Hold, err = q.UpdateHoldStatus(ctx, UpdateHoldStatusParams{
+			ID:             hold.ID,
+			Status:         HoldStatusCaptured,
+			CapturedAmount: arg.Amount,
+			TransferID:     pgtype.Int8{Int64: result.Transfer.ID, Valid: true},
+		})
+		return err
+	})
+
+	return result, err
+}
+
+// VoidHoldTx cancels an authorized hold and gives the held amount back to the available balance
+func (store *SQLStore) VoidHoldTx(ctx context.Context, holdID int64) (HoldTxResult, error) {
+	var result HoldTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		hold, err := lockAuthorizedHold(ctx, q, holdID)
+		if err != nil {
+			return err
+		}
+
+		result, err = releaseHold(ctx, q, hold, HoldStatusVoided)
+		return err
+	})
+
+	return result, err
+}
+
+// ExpireHoldTx releases an authorized hold that is past its expiry time.
+// Returns ErrHoldNotExpired if the hold is still valid, it's run by the worker's periodic expiry task.
+func (store *SQLStore) ExpireHoldTx(ctx context.Context, holdID int64) (HoldTxResult, error) {
+	var result HoldTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		hold, err := lockAuthorizedHold(ctx, q, holdID)
+		if err != nil {
+			return err
+		}
+
+		if time.Now().Before(hold.ExpiresAt) {
+			return ErrHoldNotExpired
+		}
+
+		result, err = releaseHold(ctx, q, hold, HoldStatusExpired)
+		return err
+	})
+
+	return result, err
+}
+
+// lockAuthorizedHold locks a hold so it can only be captured, voided or expired once
+func lockAuthorizedHold(ctx context.Context, q *Queries, holdID int64) (Hold, error) {
+	hold, err := q.GetHoldForUpdate(ctx, holdID)
+	if err != nil {
+		return hold, err
+	}
+
+	if hold.Status != HoldStatusAuthorized {
+		return hold, ErrHoldNotAuthorized
+	}
+	return hold, nil
+}
+
+func releaseHold(ctx context.Context, q *Queries, hold Hold, status string) (result HoldTxResult, err error) {
+	result.Hold, err = q.UpdateHoldStatus(ctx, UpdateHoldStatusParams{
+		ID:     hold.ID,
+		Status: status,
+	})
+	if err != nil {
+		return
+	}
+
+	result.Account, err = q.AddAccountHeldBalance(ctx, AddAccountHeldBalanceParams{
+		ID:     hold.AccountID,
+		Amount: -hold.Amount,
+	})
+	return
+}
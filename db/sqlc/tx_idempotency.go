@@ -0,0 +1,89 @@
+package db
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ErrIdempotencyKeyConflict is returned when an idempotency key is reused with a different request
+var ErrIdempotencyKeyConflict = errors.New("idempotency key was already used with a different request")
+
+// hashRequest fingerprints the request an idempotency key is used with
+func hashRequest(request any) (string, error) {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// claimIdempotencyKey records username's key for a new request within the current transaction.
+// Keys are only unique per user, the same key from another user is a different request.
+// If the user claimed the key before, the original result is loaded into result and replayed is true.
+// Concurrent requests with the same key block on the primary key until the first one commits or rolls back.
+func claimIdempotencyKey(ctx context.Context, q *Queries, username string, key string, request any, result any) (replayed bool, err error) {
+	requestHash, err := hashRequest(request)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = q.CreateIdempotencyKey(ctx, CreateIdempotencyKeyParams{
+		Username:    username,
+		Key:         key,
+		RequestHash: requestHash,
+	})
+	if err == nil {
+		return false, nil
+	}
+
+	if !errors.Is(err, ErrRecordNotFound) {
+		return false, err
+	}
+
+	//The key is taken, so this is a replay
+	idempotencyKey, err := q.GetIdempotencyKey(ctx, GetIdempotencyKeyParams{
+		Username: username,
+		Key:      key,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if idempotencyKey.RequestHash != requestHash {
+		return false, ErrIdempotencyKeyConflict
+	}
+
+	if err = json.Unmarshal(idempotencyKey.Response, result); err != nil {
+		return false, fmt.Errorf("failed to unmarshal stored response: %w", err)
+	}
+
+	return true, nil
+}
+
+// saveIdempotencyKeyResult stores the result of the request that claimed the key so it can be replayed.
+// transferID is zero when the request didn't create a transfer.
+func saveIdempotencyKeyResult(ctx context.Context, q *Queries, username string, key string, transferID int64, result any) error {
+	response, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	_, err = q.UpdateIdempotencyKeyResponse(ctx, UpdateIdempotencyKeyResponseParams{
+		Username: username,
+		Key:      key,
+		TransferID: pgtype.Int8{
+			Int64: transferID,
+			Valid: transferID != 0,
+		},
+		Response: response,
+	})
+	return err
+}
@@ -0,0 +1,103 @@
+package db
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// InterestScale is how many parts of a minor unit accruals are kept in, so a day's interest
+// on a small balance isn't lost to rounding before the month is added up
+const InterestScale = 1_000_000
+
+// DaysPerYear turns a yearly rate into a daily one (actual/365)
+const DaysPerYear = 365
+
+// DailyInterest is one day of interest on balance at a yearly rate of basisPoints, in InterestScale parts of a minor unit.
+// Overdrawn and empty balances earn nothing.
+func DailyInterest(balance int64, basisPoints int64) int64 {
+	if balance <= 0 || basisPoints <= 0 {
+		return 0
+	}
+
+	interest := new(big.Int).Mul(big.NewInt(balance), big.NewInt(basisPoints))
+	interest.Mul(interest, big.NewInt(InterestScale))
+	interest.Quo(interest, big.NewInt(BasisPointsScale*DaysPerYear))
+	return interest.Int64()
+}
+
+// RoundInterest rounds accrued interest to the nearest whole minor unit of the currency, halves round up
+func RoundInterest(accrued int64) int64 {
+	return (accrued + InterestScale/2) / InterestScale
+}
+
+// PostInterestTxParams contains the input parameters of the post interest transaction
+type PostInterestTxParams struct {
+	AccountID int64 `json:"account_id"`
+	// Only accruals dated before this day are paid, usually the first of the month
+	Before time.Time `json:"before"`
+}
+
+// PostInterestTxResult is the result of the post interest transaction
+type PostInterestTxResult struct {
+	TransferTxResult
+	// How many daily accruals the transfer paid, zero when nothing was posted
+	Accruals int64 `json:"accruals"`
+}
+
+// PostInterestTx pays an account the interest it accrued before arg.Before with one transfer
+// from the bank's expense account in the account's currency, and marks those accruals paid by it.
+// When the accruals round to less than a minor unit nothing is posted and they carry over to the next run.
+// Returns ErrAccountFrozen or ErrAccountClosed if the account isn't active, its accruals are then left unpaid.
+func (store *SQLStore) PostInterestTx(ctx context.Context, arg PostInterestTxParams) (PostInterestTxResult, error) {
+	var result PostInterestTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		//Locked first so two runs can't both pay the same accruals
+		account, err := q.GetAccountForUpdate(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		before := pgtype.Date{Time: arg.Before, Valid: true}
+		accrued, err := q.SumUnpostedInterest(ctx, SumUnpostedInterestParams{
+			AccountID: account.ID,
+			Before:    before,
+		})
+		if err != nil {
+			return err
+		}
+
+		amount := RoundInterest(accrued)
+		if amount == 0 {
+			return nil
+		}
+
+		expense, err := lookupSystemAccount(ctx, q, AccountKindExpense, account.Currency)
+		if err != nil {
+			return err
+		}
+
+		result.TransferTxResult, err = moveMoney(ctx, q, moveMoneyParams{
+			FromAccountID: expense.ID,
+			ToAccountID:   account.ID,
+			Amount:        amount,
+			ToAmount:      amount,
+			ExchangeRate:  IdentityExchangeRate,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Accruals, err = q.MarkInterestPosted(ctx, MarkInterestPostedParams{
+			TransferID: pgtype.Int8{Int64: result.Transfer.ID, Valid: true},
+			AccountID:  account.ID,
+			Before:     before,
+		})
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,196 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Statuses a pending transfer moves through, it starts out pending and ends in exactly one of the others
+const (
+	PendingTransferStatusPending  = "pending"
+	PendingTransferStatusApproved = "approved"
+	PendingTransferStatusRejected = "rejected"
+	PendingTransferStatusExpired  = "expired"
+)
+
+// Different types of error returned when a pending transfer can't be reviewed
+var (
+	ErrPendingTransferReviewed = errors.New("pending transfer has already been reviewed")
+	ErrPendingTransferExpired  = errors.New("pending transfer has expired")
+	ErrSelfReview              = errors.New("a transfer can't be reviewed by the user who initiated it")
+)
+
+// CreatePendingTransferTxParams contains the input parameters of the create pending transfer transaction
+type CreatePendingTransferTxParams struct {
+	FromAccountID int64 `json:"from_account_id"`
+	ToAccountID   int64 `json:"to_account_id"`
+	Amount        int64 `json:"amount"`
+	// Stored with the pending transfer and copied onto the transfer once it's approved
+	TransferDetails
+	// Optional, locks in the rate of a cross-currency transfer until it's approved
+	QuoteID   uuid.NullUUID `json:"quote_id"`
+	Initiator string        `json:"initiator"`
+	// Role of the initiator, its transfer limits apply when the transfer is requested and again when it's approved
+	InitiatorRole string    `json:"-"`
+	ExpiresAt     time.Time `json:"-"`
+	// Optional, a replay with the same key returns the original pending transfer instead of creating another
+	IdempotencyKey string `json:"-"`
+}
+
+// CreatePendingTransferTx records a transfer that has to wait for a banker's approval before any money moves.
+// A quote is used up here, so the rate that was agreed on is the one applied on approval.
+// Returns ErrTransferAmountLimit, ErrDailyAmountLimit or ErrDailyCountLimit if the transfer is already over the initiator's limits,
+// they're checked again on approval against what has been sent by then.
+func (store *SQLStore) CreatePendingTransferTx(ctx context.Context, arg CreatePendingTransferTxParams) (PendingTransfer, error) {
+	var result PendingTransfer
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		if arg.IdempotencyKey != "" {
+			replayed, err := claimIdempotencyKey(ctx, q, arg.Initiator, arg.IdempotencyKey, arg, &result)
+			if err != nil || replayed {
+				return err
+			}
+		}
+
+		fromAccount, err := q.GetAccount(ctx, arg.FromAccountID)
+		if err != nil {
+			return err
+		}
+		if err = checkTransferLimits(ctx, q, fromAccount, arg.InitiatorRole, arg.Amount); err != nil {
+			return err
+		}
+
+		toAmount, rate := arg.Amount, int64(IdentityExchangeRate)
+		if arg.QuoteID.Valid {
+			toAmount, rate, err = applyExchangeQuote(ctx, q, arg.QuoteID.UUID, arg.Initiator, arg.FromAccountID, arg.ToAccountID, arg.Amount)
+			if err != nil {
+				return err
+			}
+		}
+
+		result, err = q.CreatePendingTransfer(ctx, CreatePendingTransferParams{
+			FromAccountID:     arg.FromAccountID,
+			ToAccountID:       arg.ToAccountID,
+			Amount:            arg.Amount,
+			ToAmount:          toAmount,
+			ExchangeRate:      rate,
+			Initiator:         arg.Initiator,
+			InitiatorRole:     arg.InitiatorRole,
+			ExpiresAt:         arg.ExpiresAt,
+			Description:       arg.Description,
+			ExternalReference: arg.ExternalReference,
+			Metadata:          arg.metadata(),
+		})
+
+		if err != nil || arg.IdempotencyKey == "" {
+			return err
+		}
+
+		return saveIdempotencyKeyResult(ctx, q, arg.Initiator, arg.IdempotencyKey, 0, result)
+	})
+
+	return result, err
+}
+
+// ReviewPendingTransferTxParams contains the input parameters of the approve and reject pending transfer transactions
+type ReviewPendingTransferTxParams struct {
+	PendingTransferID int64  `json:"pending_transfer_id"`
+	Reviewer          string `json:"reviewer"`
+	Reason            string `json:"reason"`
+}
+
+// ApprovePendingTransferTxResult is the result of the approve pending transfer transaction
+type ApprovePendingTransferTxResult struct {
+	PendingTransfer PendingTransfer `json:"pending_transfer"`
+	TransferTxResult
+}
+
+// ApprovePendingTransferTx moves the money of a pending transfer the same way TransferTx does and marks it approved.
+// The initiator's transfer limits apply, not the reviewer's.
+// If the transfer fails, e.g. with ErrInsufficientFunds or ErrDailyAmountLimit, nothing changes and it stays pending.
+func (store *SQLStore) ApprovePendingTransferTx(ctx context.Context, arg ReviewPendingTransferTxParams) (ApprovePendingTransferTxResult, error) {
+	var result ApprovePendingTransferTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		pending, err := lockReviewablePendingTransfer(ctx, q, arg)
+		if err != nil {
+			return err
+		}
+
+		result.TransferTxResult, err = moveMoney(ctx, q, moveMoneyParams{
+			FromAccountID: pending.FromAccountID,
+			ToAccountID:   pending.ToAccountID,
+			Amount:        pending.Amount,
+			ToAmount:      pending.ToAmount,
+			ExchangeRate:  pending.ExchangeRate,
+			ChargeFee:     true,
+			Role:          pending.InitiatorRole,
+			Details: TransferDetails{
+				Description:       pending.Description,
+				ExternalReference: pending.ExternalReference,
+				Metadata:          pending.Metadata,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		result.PendingTransfer, err = q.UpdatePendingTransferStatus(ctx, UpdatePendingTransferStatusParams{
+			ID:         pending.ID,
+			Status:     PendingTransferStatusApproved,
+			Reviewer:   pgtype.Text{String: arg.Reviewer, Valid: true},
+			Reason:     arg.Reason,
+			TransferID: pgtype.Int8{Int64: result.Transfer.ID, Valid: true},
+		})
+		return err
+	})
+
+	return result, err
+}
+
+// RejectPendingTransferTx marks a pending transfer rejected without moving any money
+func (store *SQLStore) RejectPendingTransferTx(ctx context.Context, arg ReviewPendingTransferTxParams) (PendingTransfer, error) {
+	var result PendingTransfer
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		pending, err := lockReviewablePendingTransfer(ctx, q, arg)
+		if err != nil {
+			return err
+		}
+
+		result, err = q.UpdatePendingTransferStatus(ctx, UpdatePendingTransferStatusParams{
+			ID:       pending.ID,
+			Status:   PendingTransferStatusRejected,
+			Reviewer: pgtype.Text{String: arg.Reviewer, Valid: true},
+			Reason:   arg.Reason,
+		})
+		return err
+	})
+
+	return result, err
+}
+
+// lockReviewablePendingTransfer locks a pending transfer so it's only reviewed once, and by someone other than its initiator
+func lockReviewablePendingTransfer(ctx context.Context, q *Queries, arg ReviewPendingTransferTxParams) (PendingTransfer, error) {
+	pending, err := q.GetPendingTransferForUpdate(ctx, arg.PendingTransferID)
+	if err != nil {
+		return pending, err
+	}
+
+	if pending.Status != PendingTransferStatusPending {
+		return pending, ErrPendingTransferReviewed
+	}
+
+	if time.Now().After(pending.ExpiresAt) {
+		return pending, ErrPendingTransferExpired
+	}
+
+	if pending.Initiator == arg.Reviewer {
+		return pending, ErrSelfReview
+	}
+	return pending, nil
+}
@@ -0,0 +1,88 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Different types of error returned when a transfer can't be reversed
+var (
+	ErrTransferIsReversal      = errors.New("a reversal can't be reversed")
+	ErrTransferAlreadyReversed = errors.New("transfer has already been fully reversed")
+	ErrReversalExceedsTransfer = errors.New("reversal amount exceeds what is left of the transfer")
+)
+
+// ReverseTransferTxParams contains the input parameters of the reverse transfer transaction
+type ReverseTransferTxParams struct {
+	TransferID int64 `json:"transfer_id"`
+	// Amount is in the original to account's currency, up to what hasn't been reversed yet
+	Amount int64 `json:"amount"`
+}
+
+// ReverseTransferTxResult is the result of the reverse transfer transaction
+type ReverseTransferTxResult struct {
+	OriginalTransfer Transfer `json:"original_transfer"`
+	TransferTxResult
+}
+
+// ReverseTransferTx refunds all or part of a transfer by moving money back from its to account.
+// The refund is a new transfer with compensating entries that references the original through reversal_of,
+// and the original's reversed_amount keeps the refunds from adding up to more than was transferred.
+func (store *SQLStore) ReverseTransferTx(ctx context.Context, arg ReverseTransferTxParams) (ReverseTransferTxResult, error) {
+	var result ReverseTransferTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		//Locking the original so two refunds can't both pass the remaining amount check
+		original, err := q.GetTransferForUpdate(ctx, arg.TransferID)
+		if err != nil {
+			return err
+		}
+
+		if original.ReversalOf.Valid {
+			return ErrTransferIsReversal
+		}
+
+		remaining := original.ToAmount - original.ReversedAmount
+		if remaining <= 0 {
+			return ErrTransferAlreadyReversed
+		}
+
+		if arg.Amount > remaining {
+			return ErrReversalExceedsTransfer
+		}
+
+		//Cross-currency transfers are refunded at the rate they were made at, the fee isn't refunded
+		net := original.Amount - original.Fee
+		refund := new(big.Int).Mul(big.NewInt(arg.Amount), big.NewInt(net))
+		refund.Quo(refund, big.NewInt(original.ToAmount))
+		if refund.Int64() <= 0 {
+			return ErrAmountTooSmall
+		}
+
+		rate := new(big.Int).Mul(big.NewInt(ExchangeRateScale), big.NewInt(net))
+		rate.Quo(rate, big.NewInt(original.ToAmount))
+
+		result.TransferTxResult, err = moveMoney(ctx, q, moveMoneyParams{
+			FromAccountID: original.ToAccountID,
+			ToAccountID:   original.FromAccountID,
+			Amount:        arg.Amount,
+			ToAmount:      refund.Int64(),
+			ExchangeRate:  rate.Int64(),
+			ReversalOf:    pgtype.Int8{Int64: original.ID, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+
+		result.OriginalTransfer, err = q.AddTransferReversedAmount(ctx, AddTransferReversedAmountParams{
+			ID:     original.ID,
+			Amount: arg.Amount,
+		})
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,62 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Different types of error returned when a session's refresh token can't be exchanged
+var (
+	ErrSessionRotated = errors.New("session has already been rotated")
+	ErrSessionBlocked = errors.New("session has been blocked")
+)
+
+// RotateSessionTxParams contains the input parameters of the rotate session transaction
+type RotateSessionTxParams struct {
+	// The session whose refresh token is being exchanged
+	Session Session `json:"session"`
+	// The new session, its family and previous session are taken from Session
+	NewSession CreateSessionParams `json:"new_session"`
+}
+
+// RotateSessionTx marks a session's refresh token as used and creates the session of the one replacing it,
+// chained to it in the same family. Only one renewal can win a session, any other gets ErrSessionRotated.
+// A session blocked in the meantime, e.g. by a logout, gets ErrSessionBlocked, so a blocked family never gets a live session.
+func (store *SQLStore) RotateSessionTx(ctx context.Context, arg RotateSessionTxParams) (Session, error) {
+	var session Session
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		_, err := q.RotateSession(ctx, arg.Session.ID)
+		if err != nil {
+			if errors.Is(err, ErrRecordNotFound) {
+				return sessionNotRotatable(ctx, q, arg.Session.ID)
+			}
+			return err
+		}
+
+		newSession := arg.NewSession
+		newSession.FamilyID = arg.Session.FamilyID
+		newSession.PreviousSessionID = pgtype.UUID{Bytes: arg.Session.ID, Valid: true}
+
+		session, err = q.CreateSession(ctx, newSession)
+		return err
+	})
+
+	return session, err
+}
+
+// sessionNotRotatable tells why a session couldn't be rotated, blocking wins over having been rotated already
+func sessionNotRotatable(ctx context.Context, q *Queries, sessionID uuid.UUID) error {
+	session, err := q.GetSession(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+
+	if session.IsBlocked {
+		return ErrSessionBlocked
+	}
+	return ErrSessionRotated
+}
@@ -0,0 +1,79 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Outcomes recorded for every standing order run
+const (
+	StandingOrderRunSucceeded = "succeeded"
+	StandingOrderRunFailed    = "failed"
+)
+
+// ErrStandingOrderRunDone is returned when a run has already been recorded or the order was cancelled
+var ErrStandingOrderRunDone = errors.New("standing order run has already been recorded")
+
+// CompleteStandingOrderRunTxParams contains the input parameters of the complete standing order run transaction
+type CompleteStandingOrderRunTxParams struct {
+	StandingOrderID int64     `json:"standing_order_id"`
+	ScheduledAt     time.Time `json:"scheduled_at"`
+	NextRunAt       time.Time `json:"next_run_at"`
+	// TransferID is zero when the run failed
+	TransferID int64  `json:"transfer_id"`
+	Error      string `json:"error"`
+}
+
+// CompleteStandingOrderRunTxResult is the result of the complete standing order run transaction
+type CompleteStandingOrderRunTxResult struct {
+	StandingOrder    StandingOrder    `json:"standing_order"`
+	StandingOrderRun StandingOrderRun `json:"standing_order_run"`
+}
+
+// CompleteStandingOrderRunTx records the outcome of a standing order run and moves the order on to its next run.
+// The order is deactivated once the next run would fall after its end date.
+func (store *SQLStore) CompleteStandingOrderRunTx(ctx context.Context, arg CompleteStandingOrderRunTxParams) (CompleteStandingOrderRunTxResult, error) {
+	var result CompleteStandingOrderRunTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		order, err := q.GetStandingOrderForUpdate(ctx, arg.StandingOrderID)
+		if err != nil {
+			return err
+		}
+
+		//Another worker already moved it on, or the owner cancelled it
+		if !order.IsActive || !order.NextRunAt.Equal(arg.ScheduledAt) {
+			return ErrStandingOrderRunDone
+		}
+
+		run := CreateStandingOrderRunParams{
+			StandingOrderID: order.ID,
+			ScheduledAt:     arg.ScheduledAt,
+			Status:          StandingOrderRunSucceeded,
+			Error:           arg.Error,
+		}
+		if arg.TransferID != 0 {
+			run.TransferID = pgtype.Int8{Int64: arg.TransferID, Valid: true}
+		} else {
+			run.Status = StandingOrderRunFailed
+		}
+
+		result.StandingOrderRun, err = q.CreateStandingOrderRun(ctx, run)
+		if err != nil {
+			return err
+		}
+
+		isActive := !order.EndAt.Valid || !arg.NextRunAt.After(order.EndAt.Time)
+		result.StandingOrder, err = q.UpdateStandingOrder(ctx, UpdateStandingOrderParams{
+			ID:        order.ID,
+			NextRunAt: pgtype.Timestamptz{Time: arg.NextRunAt, Valid: true},
+			IsActive:  pgtype.Bool{Bool: isActive, Valid: true},
+		})
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,101 @@
+package db
+
+import (
+	"context"
+	"time"
+)
+
+// StatementTxParams contains the input parameters of the statement transaction
+type StatementTxParams struct {
+	AccountID int64 `json:"account_id"`
+	// Entries posted from PeriodStart up to but not including PeriodEnd are listed
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+}
+
+// StatementLine is one entry of a statement with the account's balance right after it
+type StatementLine struct {
+	ListStatementEntriesRow
+	Balance int64 `json:"balance"`
+}
+
+// StatementTxResult is the result of the statement transaction
+type StatementTxResult struct {
+	Account        Account         `json:"account"`
+	PeriodStart    time.Time       `json:"period_start"`
+	PeriodEnd      time.Time       `json:"period_end"`
+	OpeningBalance int64           `json:"opening_balance"`
+	ClosingBalance int64           `json:"closing_balance"`
+	TotalCredits   int64           `json:"total_credits"`
+	TotalDebits    int64           `json:"total_debits"`
+	Lines          []StatementLine `json:"lines"`
+}
+
+// StatementTx builds the statement of an account for a period: its balance when the period started,
+// every entry posted in it with the running balance, and its balance when the period ended.
+// It reads from a single snapshot so a transfer posting meanwhile can't make the balances disagree,
+// without locking the account against transfers while the statement is built.
+func (store *SQLStore) StatementTx(ctx context.Context, arg StatementTxParams) (StatementTxResult, error) {
+	result := StatementTxResult{
+		PeriodStart: arg.PeriodStart,
+		PeriodEnd:   arg.PeriodEnd,
+	}
+
+	err := store.execReadTx(ctx, func(q *Queries) error {
+		var err error
+		result.Account, err = q.GetAccount(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		result.OpeningBalance, err = q.GetBalanceAt(ctx, GetBalanceAtParams{
+			At:        arg.PeriodStart,
+			AccountID: arg.AccountID,
+		})
+		if err != nil {
+			return err
+		}
+
+		entries, err := q.ListStatementEntries(ctx, ListStatementEntriesParams{
+			AccountID:   arg.AccountID,
+			PeriodStart: arg.PeriodStart,
+			PeriodEnd:   arg.PeriodEnd,
+		})
+		if err != nil {
+			return err
+		}
+
+		balance := result.OpeningBalance
+		result.Lines = make([]StatementLine, 0, len(entries))
+		for _, entry := range entries {
+			balance += entry.Amount
+			if entry.Amount > 0 {
+				result.TotalCredits += entry.Amount
+			} else {
+				result.TotalDebits -= entry.Amount
+			}
+			result.Lines = append(result.Lines, StatementLine{ListStatementEntriesRow: entry, Balance: balance})
+		}
+		result.ClosingBalance = balance
+
+		return nil
+	})
+
+	return result, err
+}
+
+// CreateStatementParams turns the statement into the row that stores it with its rendered csv and html
+func (result StatementTxResult) CreateStatementParams(csv, html string) CreateStatementParams {
+	return CreateStatementParams{
+		AccountID:      result.Account.ID,
+		PeriodStart:    result.PeriodStart,
+		PeriodEnd:      result.PeriodEnd,
+		OpeningBalance: result.OpeningBalance,
+		ClosingBalance: result.ClosingBalance,
+		TotalCredits:   result.TotalCredits,
+		TotalDebits:    result.TotalDebits,
+		EntryCount:     int64(len(result.Lines)),
+		Csv:            csv,
+		Html:           html,
+	}
+}
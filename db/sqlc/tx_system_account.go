@@ -0,0 +1,43 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// systemAccountKinds are the kinds of account the bank opens in every supported currency
+var systemAccountKinds = []string{AccountKindRevenue, AccountKindExpense, AccountKindCash, AccountKindClearing}
+
+// OpenSystemAccounts opens the bank's accounts of every kind in each currency, the ones already open are left as they are.
+// It runs at startup so transactions only look them up, opening one mid-transaction would lock it
+// before the customer accounts and break the id order moveMoney locks accounts in.
+func (store *SQLStore) OpenSystemAccounts(ctx context.Context, currencies []string) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		for _, currency := range currencies {
+			for _, kind := range systemAccountKinds {
+				_, err := q.CreateSystemAccount(ctx, CreateSystemAccountParams{
+					Currency: currency,
+					Kind:     kind,
+				})
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// lookupSystemAccount looks up the bank's account of kind in currency without locking it
+func lookupSystemAccount(ctx context.Context, q *Queries, kind string, currency string) (Account, error) {
+	account, err := q.GetSystemAccount(ctx, GetSystemAccountParams{
+		Kind:     kind,
+		Currency: currency,
+	})
+	// Not wrapped, a missing system account is the server's fault rather than a resource the client asked for
+	if errors.Is(err, ErrRecordNotFound) {
+		return account, fmt.Errorf("no %s account in %s, system accounts are opened at startup: %v", kind, currency, err)
+	}
+	return account, err
+}
@@ -1,12 +1,40 @@
 package db
 
-import "context"
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// TransferDetails are what a sender notes on a transfer, none of it changes how the money moves
+type TransferDetails struct {
+	Description       string `json:"description"`
+	ExternalReference string `json:"external_reference"`
+	// A JSON object, empty is stored as {}
+	Metadata json.RawMessage `json:"metadata"`
+}
+
+// metadata is the JSON stored for Metadata
+func (details TransferDetails) metadata() json.RawMessage {
+	if len(details.Metadata) == 0 {
+		return json.RawMessage("{}")
+	}
+	return details.Metadata
+}
 
 // TransferTxParams contains the input parameters of the transfer transaction
 type TransferTxParams struct {
 	FromAccountID int64 `json:"from_account_id"`
 	ToAccountID   int64 `json:"to_account_id"`
 	Amount        int64 `json:"amount"`
+	TransferDetails
+	// Role of the user sending the money, its transfer limits apply, see checkTransferLimits
+	Role string `json:"-"`
+	// The user sending the money, required with an IdempotencyKey since keys are only unique per user
+	Username string `json:"-"`
+	// Optional, a replay with the same key returns the original result instead of transferring again
+	IdempotencyKey string `json:"-"`
 }
 
 type TransferTxResult struct {
@@ -15,10 +43,17 @@ type TransferTxResult struct {
 	ToAccount   Account  `json:"to_account"`
 	FromEntry   Entry    `json:"from_entry"`
 	ToEntry     Entry    `json:"to_entry"`
+	// Only set when a fee was charged, it credits the bank's revenue account
+	FeeEntry Entry `json:"fee_entry"`
 }
 
 // TransferTx This method performs a money transfer from one account to another
 // It creates a transfer record, add account entries, and updates accounts' balance within a single DB transaction
+// Returns ErrInsufficientFunds if the from account's available balance plus its overdraft limit doesn't cover the amount
+// Returns ErrAccountFrozen or ErrAccountClosed if either account isn't active
+// Returns ErrTransferAmountLimit, ErrDailyAmountLimit or ErrDailyCountLimit if the transfer is over the sender's limits
+// The fee from the currency's fee schedule comes out of Amount, so the to account is credited Amount less the fee
+// With an IdempotencyKey the key is recorded in the same transaction, see claimIdempotencyKey
 func (store *SQLStore) TransferTx(ctx context.Context, arg TransferTxParams) (TransferTxResult, error) {
 
 	var result TransferTxResult
@@ -26,48 +61,211 @@ func (store *SQLStore) TransferTx(ctx context.Context, arg TransferTxParams) (Tr
 	err := store.execTx(ctx, func(q *Queries) error {
 		var err error
 
-		result.Transfer, err = q.CreateTransfer(ctx, CreateTransferParams{
+		if arg.IdempotencyKey != "" {
+			replayed, err := claimIdempotencyKey(ctx, q, arg.Username, arg.IdempotencyKey, arg, &result)
+			if err != nil || replayed {
+				return err
+			}
+		}
+
+		result, err = moveMoney(ctx, q, moveMoneyParams{
 			FromAccountID: arg.FromAccountID,
 			ToAccountID:   arg.ToAccountID,
 			Amount:        arg.Amount,
+			ToAmount:      arg.Amount,
+			ExchangeRate:  IdentityExchangeRate,
+			ChargeFee:     true,
+			Role:          arg.Role,
+			Details:       arg.TransferDetails,
 		})
 
-		if err != nil {
+		if err != nil || arg.IdempotencyKey == "" {
 			return err
 		}
 
-		result.FromEntry, err = q.CreateEntry(ctx, CreateEntryParams{
-			AccountID: arg.FromAccountID,
-			Amount:    -arg.Amount,
-		})
+		return saveIdempotencyKeyResult(ctx, q, arg.Username, arg.IdempotencyKey, result.Transfer.ID, result)
+	})
+
+	return result, err
+}
+
+// moveMoneyParams describes one leg of money moving between two accounts.
+// Amount is debited in the from account's currency and ToAmount credited in the to account's currency.
+// ReleaseHeld is taken off the from account's held balance in the same step, see CaptureHoldTx.
+// ReversalOf links a refund to the transfer it reverses, see ReverseTransferTx.
+// ChargeFee takes the fee out of ToAmount and credits it to the revenue account, see transferFee.
+// Role enforces that role's transfer limits on the from account, money the bank moves itself leaves it empty.
+// Details are stored on the transfer as they are.
+type moveMoneyParams struct {
+	FromAccountID int64
+	ToAccountID   int64
+	Amount        int64
+	ToAmount      int64
+	ExchangeRate  int64
+	ReleaseHeld   int64
+	ReversalOf    pgtype.Int8
+	ChargeFee     bool
+	Role          string
+	Details       TransferDetails
+}
+
+// moveMoney creates the transfer record and both entries, and updates both balances.
+// It's shared by every transaction that moves money and must run inside execTx.
+func moveMoney(ctx context.Context, q *Queries, arg moveMoneyParams) (result TransferTxResult, err error) {
+	//Locking both accounts in ID order (same as addMoney below) so the balance check can't race another transfer
+	var fromAccount, toAccount Account
+	if arg.FromAccountID < arg.ToAccountID {
+		fromAccount, toAccount, err = lockAccounts(ctx, q, arg.FromAccountID, arg.ToAccountID)
+	} else {
+		toAccount, fromAccount, err = lockAccounts(ctx, q, arg.ToAccountID, arg.FromAccountID)
+	}
+
+	if err != nil {
+		return result, err
+	}
+
+	//Frozen and closed accounts can neither send nor receive
+	if err = fromAccount.CheckActive(); err != nil {
+		return result, err
+	}
+	if err = toAccount.CheckActive(); err != nil {
+		return result, err
+	}
+
+	if arg.Role != "" {
+		if err = checkTransferLimits(ctx, q, fromAccount, arg.Role, arg.Amount); err != nil {
+			return result, err
+		}
+	}
 
+	//Exchange transfers earn on the rate, fees are only charged when both sides share a currency
+	var fee int64
+	if arg.ChargeFee && fromAccount.Currency == toAccount.Currency {
+		fee, err = transferFee(ctx, q, fromAccount.Currency, arg.Amount)
 		if err != nil {
-			return err
+			return result, err
 		}
 
-		result.ToEntry, err = q.CreateEntry(ctx, CreateEntryParams{
-			AccountID: arg.ToAccountID,
-			Amount:    arg.Amount,
-		})
+		if fee >= arg.Amount {
+			return result, ErrFeeExceedsAmount
+		}
+		arg.ToAmount -= fee
+	}
+
+	//Money already held for this transfer counts towards the available balance,
+	//the bank's own accounts are exempt since an expense account only ever pays out
+	if fromAccount.Kind == AccountKindCustomer &&
+		fromAccount.AvailableBalance()+arg.ReleaseHeld+fromAccount.OverdraftLimit < arg.Amount {
+		return result, ErrInsufficientFunds
+	}
+
+	result.Transfer, err = q.CreateTransfer(ctx, CreateTransferParams{
+		FromAccountID:     arg.FromAccountID,
+		ToAccountID:       arg.ToAccountID,
+		Amount:            arg.Amount,
+		ToAmount:          arg.ToAmount,
+		ExchangeRate:      arg.ExchangeRate,
+		Fee:               fee,
+		ReversalOf:        arg.ReversalOf,
+		Description:       arg.Details.Description,
+		ExternalReference: arg.Details.ExternalReference,
+		Metadata:          arg.Details.metadata(),
+	})
+
+	if err != nil {
+		return result, err
+	}
+
+	result.FromEntry, err = q.CreateEntry(ctx, CreateEntryParams{
+		AccountID:  arg.FromAccountID,
+		Amount:     -arg.Amount,
+		TransferID: pgtype.Int8{Int64: result.Transfer.ID, Valid: true},
+	})
 
+	if err != nil {
+		return result, err
+	}
+
+	result.ToEntry, err = q.CreateEntry(ctx, CreateEntryParams{
+		AccountID:  arg.ToAccountID,
+		Amount:     arg.ToAmount,
+		TransferID: pgtype.Int8{Int64: result.Transfer.ID, Valid: true},
+	})
+
+	if err != nil {
+		return result, err
+	}
+
+	//Updating this to swip/swap account updates based upon account ID changes to avoid Deadlock
+	if arg.FromAccountID < arg.ToAccountID {
+		//Go can load three vars at once if three returns are given!
+		result.FromAccount, result.ToAccount, err = addMoney(ctx, q, arg.FromAccountID, -arg.Amount, arg.ToAccountID, arg.ToAmount)
+	} else {
+		result.ToAccount, result.FromAccount, err = addMoney(ctx, q, arg.ToAccountID, arg.ToAmount, arg.FromAccountID, -arg.Amount)
+	}
+
+	if err != nil {
+		return result, err
+	}
+
+	//The revenue account is always locked after the customer accounts, so it can't deadlock with them
+	if fee > 0 {
+		result.FeeEntry, err = creditRevenue(ctx, q, fromAccount.Currency, fee, result.Transfer.ID)
 		if err != nil {
-			return err
+			return result, err
 		}
+	}
 
-		//Updating this to swip/swap account updates based upon account ID changes to avoid Deadlock
-		if arg.FromAccountID < arg.ToAccountID {
-			//Go can load three vars at once if three returns are given!
-			result.FromAccount, result.ToAccount, err = addMoney(ctx, q, arg.FromAccountID, -arg.Amount, arg.ToAccountID, arg.Amount)
-		} else {
-			result.ToAccount, result.FromAccount, err = addMoney(ctx, q, arg.ToAccountID, arg.Amount, arg.FromAccountID, -arg.Amount)
-		}
+	if arg.ReleaseHeld == 0 {
+		return result, nil
+	}
 
-		return err
+	result.FromAccount, err = q.AddAccountHeldBalance(ctx, AddAccountHeldBalanceParams{
+		ID:     arg.FromAccountID,
+		Amount: -arg.ReleaseHeld,
 	})
 
 	return result, err
 }
 
+// creditRevenue posts a transfer's fee to the bank's revenue account in currency
+func creditRevenue(ctx context.Context, q *Queries, currency string, fee int64, transferID int64) (Entry, error) {
+	revenue, err := lookupSystemAccount(ctx, q, AccountKindRevenue, currency)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	entry, err := q.CreateEntry(ctx, CreateEntryParams{
+		AccountID:  revenue.ID,
+		Amount:     fee,
+		TransferID: pgtype.Int8{Int64: transferID, Valid: true},
+	})
+	if err != nil {
+		return entry, err
+	}
+
+	_, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{
+		ID:     revenue.ID,
+		Amount: fee,
+	})
+	return entry, err
+}
+
+func lockAccounts(
+	ctx context.Context,
+	q *Queries,
+	accountID1 int64,
+	accountID2 int64,
+) (account1 Account, account2 Account, err error) {
+	account1, err = q.GetAccountForUpdate(ctx, accountID1)
+	if err != nil {
+		return
+	}
+
+	account2, err = q.GetAccountForUpdate(ctx, accountID2)
+	return
+}
+
 func addMoney(
 	ctx context.Context,
 	q *Queries,
@@ -0,0 +1,152 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Different types of error returned when a transfer would go over the sender's limits
+var (
+	ErrTransferAmountLimit = errors.New("amount is over the per-transfer limit")
+	ErrDailyAmountLimit    = errors.New("transfer would go over today's amount limit")
+	ErrDailyCountLimit     = errors.New("today's transfer count limit has been reached")
+)
+
+// TransferLimits are the limits that apply to one account, an invalid field means no limit
+type TransferLimits struct {
+	MaxAmount   pgtype.Int8 `json:"max_amount"`
+	DailyAmount pgtype.Int8 `json:"daily_amount"`
+	DailyCount  pgtype.Int8 `json:"daily_count"`
+}
+
+// TransferUsage is what an account has sent so far today
+type TransferUsage struct {
+	Amount int64 `json:"amount"`
+	Count  int64 `json:"count"`
+}
+
+// Check returns the first limit a transfer of amount on top of usage would go over
+func (limits TransferLimits) Check(usage TransferUsage, amount int64) error {
+	if err := limits.CheckAmount(amount); err != nil {
+		return err
+	}
+	if limits.DailyCount.Valid && usage.Count+1 > limits.DailyCount.Int64 {
+		return ErrDailyCountLimit
+	}
+	if limits.DailyAmount.Valid && usage.Amount+amount > limits.DailyAmount.Int64 {
+		return ErrDailyAmountLimit
+	}
+	return nil
+}
+
+// CheckAmount only checks the per-transfer limit, the daily limits count money that has actually moved
+func (limits TransferLimits) CheckAmount(amount int64) error {
+	if limits.MaxAmount.Valid && amount > limits.MaxAmount.Int64 {
+		return ErrTransferAmountLimit
+	}
+	return nil
+}
+
+// StartOfDay is midnight UTC of t's day, daily limits reset then
+func StartOfDay(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
+
+// transferLimits looks up the limits of role in the account's currency and lays the account's own overrides on top.
+// A role without limits in that currency is unlimited.
+func transferLimits(ctx context.Context, q *Queries, account Account, role string) (limits TransferLimits, err error) {
+	roleLimits, err := q.GetRoleTransferLimits(ctx, GetRoleTransferLimitsParams{
+		Role:     role,
+		Currency: account.Currency,
+	})
+	if err == nil {
+		limits = TransferLimits{
+			MaxAmount:   roleLimits.MaxAmount,
+			DailyAmount: roleLimits.DailyAmount,
+			DailyCount:  roleLimits.DailyCount,
+		}
+	} else if !errors.Is(err, ErrRecordNotFound) {
+		return
+	}
+
+	override, err := q.GetAccountTransferLimits(ctx, account.ID)
+	if err != nil {
+		if errors.Is(err, ErrRecordNotFound) {
+			err = nil
+		}
+		return
+	}
+
+	if override.MaxAmount.Valid {
+		limits.MaxAmount = override.MaxAmount
+	}
+	if override.DailyAmount.Valid {
+		limits.DailyAmount = override.DailyAmount
+	}
+	if override.DailyCount.Valid {
+		limits.DailyCount = override.DailyCount
+	}
+	return
+}
+
+// dailyTransferUsage adds up what the account has sent since midnight UTC
+func dailyTransferUsage(ctx context.Context, q *Queries, accountID int64) (TransferUsage, error) {
+	row, err := q.GetDailyTransferUsage(ctx, GetDailyTransferUsageParams{
+		FromAccountID: accountID,
+		Since:         StartOfDay(time.Now()),
+	})
+	return TransferUsage{Amount: row.Amount, Count: row.Count}, err
+}
+
+// checkTransferLimits refuses a transfer of amount from account that would go over role's limits.
+// The account must already be locked, so two transfers can't both fit under what's left of today's limits.
+func checkTransferLimits(ctx context.Context, q *Queries, account Account, role string, amount int64) error {
+	limits, err := transferLimits(ctx, q, account, role)
+	if err != nil {
+		return err
+	}
+
+	usage, err := dailyTransferUsage(ctx, q, account.ID)
+	if err != nil {
+		return err
+	}
+
+	return limits.Check(usage, amount)
+}
+
+// TransferLimitsTxParams contains the input parameters of the transfer limits transaction
+type TransferLimitsTxParams struct {
+	AccountID int64  `json:"account_id"`
+	Role      string `json:"role"`
+}
+
+// TransferLimitsTxResult is the result of the transfer limits transaction
+type TransferLimitsTxResult struct {
+	Limits TransferLimits `json:"limits"`
+	Usage  TransferUsage  `json:"usage"`
+}
+
+// TransferLimitsTx reads the limits that apply to an account owned by role together with what it has sent today
+func (store *SQLStore) TransferLimitsTx(ctx context.Context, arg TransferLimitsTxParams) (TransferLimitsTxResult, error) {
+	var result TransferLimitsTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		account, err := q.GetAccount(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		result.Limits, err = transferLimits(ctx, q, account, arg.Role)
+		if err != nil {
+			return err
+		}
+
+		result.Usage, err = dailyTransferUsage(ctx, q, account.ID)
+		return err
+	})
+
+	return result, err
+}
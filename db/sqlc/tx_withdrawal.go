@@ -0,0 +1,167 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Statuses a withdrawal moves through, it starts out pending and the processor settles it or fails it
+const (
+	WithdrawalStatusPending = "pending"
+	WithdrawalStatusSettled = "settled"
+	WithdrawalStatusFailed  = "failed"
+)
+
+// ErrWithdrawalSettled is returned when a withdrawal the processor already settled or failed is reported the other way
+var ErrWithdrawalSettled = errors.New("withdrawal has already been settled")
+
+// WithdrawTxParams contains the input parameters of the withdraw transaction
+type WithdrawTxParams struct {
+	AccountID   int64  `json:"account_id"`
+	Amount      int64  `json:"amount"`
+	RequestedBy string `json:"requested_by"`
+	// Role of the user asking for it, its transfer limits apply the same as to a transfer
+	Role string `json:"-"`
+	// Optional, a replay with the same key returns the original withdrawal instead of withdrawing again
+	IdempotencyKey string `json:"-"`
+}
+
+// WithdrawTxResult is the result of the withdraw and settle withdrawal transactions
+type WithdrawTxResult struct {
+	Withdrawal Withdrawal `json:"withdrawal"`
+	TransferTxResult
+}
+
+// WithdrawTx takes the amount out of an account right away into the bank's clearing account in its currency,
+// where it waits as a pending withdrawal until the processor pays it out and settles it, see SettleWithdrawalTx.
+// It fails the same ways TransferTx does.
+func (store *SQLStore) WithdrawTx(ctx context.Context, arg WithdrawTxParams) (WithdrawTxResult, error) {
+	var result WithdrawTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		if arg.IdempotencyKey != "" {
+			replayed, err := claimIdempotencyKey(ctx, q, arg.RequestedBy, arg.IdempotencyKey, arg, &result)
+			if err != nil || replayed {
+				return err
+			}
+		}
+
+		account, err := q.GetAccount(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		clearing, err := lookupSystemAccount(ctx, q, AccountKindClearing, account.Currency)
+		if err != nil {
+			return err
+		}
+
+		result.TransferTxResult, err = moveMoney(ctx, q, moveMoneyParams{
+			FromAccountID: account.ID,
+			ToAccountID:   clearing.ID,
+			Amount:        arg.Amount,
+			ToAmount:      arg.Amount,
+			ExchangeRate:  IdentityExchangeRate,
+			Role:          arg.Role,
+			Details:       TransferDetails{Description: "Withdrawal"},
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Withdrawal, err = q.CreateWithdrawal(ctx, CreateWithdrawalParams{
+			AccountID:   account.ID,
+			Amount:      arg.Amount,
+			RequestedBy: arg.RequestedBy,
+			TransferID:  result.Transfer.ID,
+		})
+		if err != nil || arg.IdempotencyKey == "" {
+			return err
+		}
+
+		return saveIdempotencyKeyResult(ctx, q, arg.RequestedBy, arg.IdempotencyKey, result.Transfer.ID, result)
+	})
+
+	return result, err
+}
+
+// SettleWithdrawalTxParams contains the input parameters of the settle withdrawal transaction
+type SettleWithdrawalTxParams struct {
+	WithdrawalID int64 `json:"withdrawal_id"`
+	// WithdrawalStatusSettled once the processor paid it out, WithdrawalStatusFailed if it couldn't
+	Status             string `json:"status"`
+	ProcessorReference string `json:"processor_reference"`
+	FailureReason      string `json:"failure_reason"`
+}
+
+// SettleWithdrawalTx records the processor's outcome of a pending withdrawal. A settled one moves the funds
+// from the clearing account to the cash account, they've left the bank. A failed one refunds them to the account.
+// Processors retry callbacks, so reporting the outcome a withdrawal already has returns it unchanged,
+// with no transfer in the result, while reporting the other one returns ErrWithdrawalSettled.
+// A refund to an account that has since been frozen or closed fails with ErrAccountFrozen or ErrAccountClosed
+// and the withdrawal stays pending.
+func (store *SQLStore) SettleWithdrawalTx(ctx context.Context, arg SettleWithdrawalTxParams) (WithdrawTxResult, error) {
+	var result WithdrawTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		withdrawal, err := q.GetWithdrawalForUpdate(ctx, arg.WithdrawalID)
+		if err != nil {
+			return err
+		}
+
+		if withdrawal.Status != WithdrawalStatusPending {
+			result.Withdrawal = withdrawal
+			if withdrawal.Status != arg.Status {
+				return ErrWithdrawalSettled
+			}
+			return nil
+		}
+
+		account, err := q.GetAccount(ctx, withdrawal.AccountID)
+		if err != nil {
+			return err
+		}
+
+		clearing, err := lookupSystemAccount(ctx, q, AccountKindClearing, account.Currency)
+		if err != nil {
+			return err
+		}
+
+		toAccountID, description := account.ID, "Withdrawal refund"
+		if arg.Status == WithdrawalStatusSettled {
+			cash, err := lookupSystemAccount(ctx, q, AccountKindCash, account.Currency)
+			if err != nil {
+				return err
+			}
+			toAccountID, description = cash.ID, "Withdrawal settlement"
+		}
+
+		result.TransferTxResult, err = moveMoney(ctx, q, moveMoneyParams{
+			FromAccountID: clearing.ID,
+			ToAccountID:   toAccountID,
+			Amount:        withdrawal.Amount,
+			ToAmount:      withdrawal.Amount,
+			ExchangeRate:  IdentityExchangeRate,
+			Details: TransferDetails{
+				Description:       description,
+				ExternalReference: arg.ProcessorReference,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Withdrawal, err = q.SettleWithdrawal(ctx, SettleWithdrawalParams{
+			Status:               arg.Status,
+			SettlementTransferID: pgtype.Int8{Int64: result.Transfer.ID, Valid: true},
+			ProcessorReference:   arg.ProcessorReference,
+			FailureReason:        arg.FailureReason,
+			ID:                   withdrawal.ID,
+		})
+		return err
+	})
+
+	return result, err
+}
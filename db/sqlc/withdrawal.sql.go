@@ -0,0 +1,146 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.20.0
+// source: withdrawal.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createWithdrawal = `-- name: CreateWithdrawal :one
+INSERT INTO withdrawals (account_id, amount, requested_by, transfer_id)
+VALUES ($1, $2, $3, $4)
+RETURNING id, account_id, amount, status, requested_by, transfer_id, settlement_transfer_id, processor_reference, failure_reason, created_at, updated_at
+`
+
+type CreateWithdrawalParams struct {
+	AccountID   int64  `json:"account_id"`
+	Amount      int64  `json:"amount"`
+	RequestedBy string `json:"requested_by"`
+	TransferID  int64  `json:"transfer_id"`
+}
+
+func (q *Queries) CreateWithdrawal(ctx context.Context, arg CreateWithdrawalParams) (Withdrawal, error) {
+	row := q.db.QueryRow(ctx, createWithdrawal,
+		arg.AccountID,
+		arg.Amount,
+		arg.RequestedBy,
+		arg.TransferID,
+	)
+	var i Withdrawal
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Amount,
+		&i.Status,
+		&i.RequestedBy,
+		&i.TransferID,
+		&i.SettlementTransferID,
+		&i.ProcessorReference,
+		&i.FailureReason,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getWithdrawal = `-- name: GetWithdrawal :one
+SELECT id, account_id, amount, status, requested_by, transfer_id, settlement_transfer_id, processor_reference, failure_reason, created_at, updated_at
+FROM withdrawals
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetWithdrawal(ctx context.Context, id int64) (Withdrawal, error) {
+	row := q.db.QueryRow(ctx, getWithdrawal, id)
+	var i Withdrawal
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Amount,
+		&i.Status,
+		&i.RequestedBy,
+		&i.TransferID,
+		&i.SettlementTransferID,
+		&i.ProcessorReference,
+		&i.FailureReason,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getWithdrawalForUpdate = `-- name: GetWithdrawalForUpdate :one
+SELECT id, account_id, amount, status, requested_by, transfer_id, settlement_transfer_id, processor_reference, failure_reason, created_at, updated_at
+FROM withdrawals
+WHERE id = $1
+LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetWithdrawalForUpdate(ctx context.Context, id int64) (Withdrawal, error) {
+	row := q.db.QueryRow(ctx, getWithdrawalForUpdate, id)
+	var i Withdrawal
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Amount,
+		&i.Status,
+		&i.RequestedBy,
+		&i.TransferID,
+		&i.SettlementTransferID,
+		&i.ProcessorReference,
+		&i.FailureReason,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const settleWithdrawal = `-- name: SettleWithdrawal :one
+UPDATE withdrawals
+SET status                 = $1,
+    settlement_transfer_id = $2,
+    processor_reference    = $3,
+    failure_reason         = $4,
+    updated_at             = now()
+WHERE id = $5
+RETURNING id, account_id, amount, status, requested_by, transfer_id, settlement_transfer_id, processor_reference, failure_reason, created_at, updated_at
+`
+
+type SettleWithdrawalParams struct {
+	Status               string      `json:"status"`
+	SettlementTransferID pgtype.Int8 `json:"settlement_transfer_id"`
+	ProcessorReference   string      `json:"processor_reference"`
+	FailureReason        string      `json:"failure_reason"`
+	ID                   int64       `json:"id"`
+}
+
+func (q *Queries) SettleWithdrawal(ctx context.Context, arg SettleWithdrawalParams) (Withdrawal, error) {
+	row := q.db.QueryRow(ctx, settleWithdrawal,
+		arg.Status,
+		arg.SettlementTransferID,
+		arg.ProcessorReference,
+		arg.FailureReason,
+		arg.ID,
+	)
+	var i Withdrawal
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Amount,
+		&i.Status,
+		&i.RequestedBy,
+		&i.TransferID,
+		&i.SettlementTransferID,
+		&i.ProcessorReference,
+		&i.FailureReason,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
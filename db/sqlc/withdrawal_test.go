@@ -0,0 +1,79 @@
+package db
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"goBank/util"
+	"testing"
+)
+
+func TestDepositAndWithdrawTx(t *testing.T) {
+	banker := createRandomUser(t)
+	account := createRandomAccountWithCurrency(t, 0, util.USD)
+
+	deposit, err := testStore.DepositTx(context.Background(), DepositTxParams{
+		AccountID:   account.ID,
+		Amount:      1000,
+		DepositedBy: banker.Username,
+		Reference:   "slip-1",
+	})
+	require.NoError(t, err)
+	require.NotZero(t, deposit.Deposit.ID)
+	require.Equal(t, deposit.Transfer.ID, deposit.Deposit.TransferID)
+	require.Equal(t, int64(1000), deposit.ToAccount.Balance)
+	require.Equal(t, AccountKindCash, deposit.FromAccount.Kind)
+
+	withdraw, err := testStore.WithdrawTx(context.Background(), WithdrawTxParams{
+		AccountID:   account.ID,
+		Amount:      300,
+		RequestedBy: account.Owner,
+	})
+	require.NoError(t, err)
+	require.Equal(t, WithdrawalStatusPending, withdraw.Withdrawal.Status)
+	require.Equal(t, int64(700), withdraw.FromAccount.Balance)
+	require.Equal(t, AccountKindClearing, withdraw.ToAccount.Kind)
+
+	settled, err := testStore.SettleWithdrawalTx(context.Background(), SettleWithdrawalTxParams{
+		WithdrawalID:       withdraw.Withdrawal.ID,
+		Status:             WithdrawalStatusSettled,
+		ProcessorReference: "payout-1",
+	})
+	require.NoError(t, err)
+	require.Equal(t, WithdrawalStatusSettled, settled.Withdrawal.Status)
+	require.Equal(t, settled.Transfer.ID, settled.Withdrawal.SettlementTransferID.Int64)
+	require.Equal(t, deposit.FromAccount.ID, settled.ToAccount.ID)
+
+	// a retried callback returns the withdrawal unchanged
+	replayed, err := testStore.SettleWithdrawalTx(context.Background(), SettleWithdrawalTxParams{
+		WithdrawalID: withdraw.Withdrawal.ID,
+		Status:       WithdrawalStatusSettled,
+	})
+	require.NoError(t, err)
+	require.Zero(t, replayed.Transfer.ID)
+	require.Equal(t, settled.Withdrawal.SettlementTransferID, replayed.Withdrawal.SettlementTransferID)
+
+	_, err = testStore.SettleWithdrawalTx(context.Background(), SettleWithdrawalTxParams{
+		WithdrawalID: withdraw.Withdrawal.ID,
+		Status:       WithdrawalStatusFailed,
+	})
+	require.ErrorIs(t, err, ErrWithdrawalSettled)
+
+	// a failed withdrawal is refunded
+	withdraw, err = testStore.WithdrawTx(context.Background(), WithdrawTxParams{
+		AccountID:   account.ID,
+		Amount:      200,
+		RequestedBy: account.Owner,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(500), withdraw.FromAccount.Balance)
+
+	failed, err := testStore.SettleWithdrawalTx(context.Background(), SettleWithdrawalTxParams{
+		WithdrawalID:  withdraw.Withdrawal.ID,
+		Status:        WithdrawalStatusFailed,
+		FailureReason: "account closed at the receiving bank",
+	})
+	require.NoError(t, err)
+	require.Equal(t, WithdrawalStatusFailed, failed.Withdrawal.Status)
+	require.Equal(t, account.ID, failed.ToAccount.ID)
+	require.Equal(t, int64(700), failed.ToAccount.Balance)
+}
@@ -23,19 +23,39 @@ CREATE TABLE "verify_emails" (
   "expired_at" timestamptz NOT NULL DEFAULT (now() + interval '15 minutes')
 );
 
+CREATE TABLE "sessions" (
+  "id" uuid PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "refresh_token" varchar NOT NULL,
+  "user_agent" varchar NOT NULL,
+  "client_ip" varchar NOT NULL,
+  "is_blocked" boolean NOT NULL DEFAULT false,
+  "expires_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "family_id" uuid NOT NULL,
+  "previous_session_id" uuid,
+  "rotated_at" timestamptz
+);
+
 CREATE TABLE "accounts" (
   "id" bigserial PRIMARY KEY,
   "owner" varchar NOT NULL,
   "balance" bigint NOT NULL,
   "currency" varchar NOT NULL,
-  "created_at" timestamptz NOT NULL DEFAULT (now())
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "overdraft_limit" bigint NOT NULL DEFAULT 0,
+  "held_balance" bigint NOT NULL DEFAULT 0,
+  "status" varchar NOT NULL DEFAULT 'active',
+  "kind" varchar NOT NULL DEFAULT 'customer',
+  "product" varchar NOT NULL DEFAULT 'checking'
 );
 
 CREATE TABLE "entries" (
   "id" bigserial PRIMARY KEY,
   "account_id" bigint NOT NULL,
   "amount" bigint NOT NULL,
-  "created_at" timestamptz NOT NULL DEFAULT (now())
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "transfer_id" bigint
 );
 
 CREATE TABLE "transfers" (
@@ -43,12 +63,239 @@ CREATE TABLE "transfers" (
   "from_account_id" bigint NOT NULL,
   "to_account_id" bigint NOT NULL,
   "amount" bigint NOT NULL,
+  "to_amount" bigint NOT NULL,
+  "exchange_rate" bigint NOT NULL DEFAULT 100000000,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "reversal_of" bigint,
+  "reversed_amount" bigint NOT NULL DEFAULT 0,
+  "fee" bigint NOT NULL DEFAULT 0,
+  "description" varchar NOT NULL DEFAULT '',
+  "external_reference" varchar NOT NULL DEFAULT '',
+  "metadata" jsonb NOT NULL DEFAULT '{}'
+);
+
+CREATE TABLE "idempotency_keys" (
+  "username" varchar NOT NULL,
+  "key" varchar NOT NULL,
+  "request_hash" varchar NOT NULL,
+  "transfer_id" bigint,
+  "response" jsonb,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("username", "key")
+);
+
+CREATE TABLE "exchange_rates" (
+  "id" bigserial PRIMARY KEY,
+  "from_currency" varchar NOT NULL,
+  "to_currency" varchar NOT NULL,
+  "rate" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "exchange_quotes" (
+  "id" uuid PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "from_currency" varchar NOT NULL,
+  "to_currency" varchar NOT NULL,
+  "rate" bigint NOT NULL,
+  "is_used" bool NOT NULL DEFAULT false,
+  "expires_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "holds" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "captured_amount" bigint NOT NULL DEFAULT 0,
+  "transfer_id" bigint,
+  "status" varchar NOT NULL DEFAULT 'authorized',
+  "expires_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "standing_orders" (
+  "id" bigserial PRIMARY KEY,
+  "owner" varchar NOT NULL,
+  "from_account_id" bigint NOT NULL,
+  "to_account_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "schedule" varchar NOT NULL,
+  "next_run_at" timestamptz NOT NULL,
+  "end_at" timestamptz,
+  "is_active" bool NOT NULL DEFAULT true,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "standing_order_runs" (
+  "id" bigserial PRIMARY KEY,
+  "standing_order_id" bigint NOT NULL,
+  "scheduled_at" timestamptz NOT NULL,
+  "transfer_id" bigint,
+  "status" varchar NOT NULL,
+  "error" varchar NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "pending_transfers" (
+  "id" bigserial PRIMARY KEY,
+  "from_account_id" bigint NOT NULL,
+  "to_account_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "to_amount" bigint NOT NULL,
+  "exchange_rate" bigint NOT NULL DEFAULT 100000000,
+  "initiator" varchar NOT NULL,
+  "reviewer" varchar,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "reason" varchar NOT NULL DEFAULT '',
+  "transfer_id" bigint,
+  "expires_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  "initiator_role" varchar NOT NULL,
+  "description" varchar NOT NULL DEFAULT '',
+  "external_reference" varchar NOT NULL DEFAULT '',
+  "metadata" jsonb NOT NULL DEFAULT '{}'
+);
+
+CREATE TABLE "reconciliation_reports" (
+  "id" bigserial PRIMARY KEY,
+  "triggered_by" varchar,
+  "status" varchar NOT NULL DEFAULT 'running',
+  "accounts_checked" bigint NOT NULL DEFAULT 0,
+  "transfers_checked" bigint NOT NULL DEFAULT 0,
+  "discrepancy_count" bigint NOT NULL DEFAULT 0,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "completed_at" timestamptz
+);
+
+CREATE TABLE "reconciliation_discrepancies" (
+  "id" bigserial PRIMARY KEY,
+  "report_id" bigint NOT NULL,
+  "kind" varchar NOT NULL,
+  "account_id" bigint,
+  "transfer_id" bigint,
+  "expected" bigint NOT NULL,
+  "actual" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "account_status_changes" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "from_status" varchar NOT NULL,
+  "to_status" varchar NOT NULL,
+  "changed_by" varchar NOT NULL,
+  "reason" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "fee_schedules" (
+  "id" bigserial PRIMARY KEY,
+  "currency" varchar NOT NULL,
+  "min_amount" bigint NOT NULL,
+  "flat_fee" bigint NOT NULL DEFAULT 0,
+  "basis_points" bigint NOT NULL DEFAULT 0,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "interest_rates" (
+  "id" bigserial PRIMARY KEY,
+  "product" varchar NOT NULL,
+  "currency" varchar NOT NULL,
+  "basis_points" bigint NOT NULL,
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "interest_accruals" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "accrual_date" date NOT NULL,
+  "balance" bigint NOT NULL,
+  "basis_points" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "transfer_id" bigint,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "role_transfer_limits" (
+  "id" bigserial PRIMARY KEY,
+  "role" varchar NOT NULL,
+  "currency" varchar NOT NULL,
+  "max_amount" bigint,
+  "daily_amount" bigint,
+  "daily_count" bigint,
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "account_transfer_limits" (
+  "account_id" bigint PRIMARY KEY,
+  "max_amount" bigint,
+  "daily_amount" bigint,
+  "daily_count" bigint,
+  "updated_by" varchar NOT NULL,
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "statements" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "period_start" timestamptz NOT NULL,
+  "period_end" timestamptz NOT NULL,
+  "opening_balance" bigint NOT NULL,
+  "closing_balance" bigint NOT NULL,
+  "total_credits" bigint NOT NULL,
+  "total_debits" bigint NOT NULL,
+  "entry_count" bigint NOT NULL,
+  "csv" text NOT NULL,
+  "html" text NOT NULL,
+  "emailed_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "balance_snapshots" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "snapshot_date" date NOT NULL,
+  "balance" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "deposits" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "transfer_id" bigint NOT NULL,
+  "deposited_by" varchar NOT NULL,
+  "reference" varchar NOT NULL DEFAULT '',
   "created_at" timestamptz NOT NULL DEFAULT (now())
 );
 
+CREATE TABLE "withdrawals" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "requested_by" varchar NOT NULL,
+  "transfer_id" bigint NOT NULL,
+  "settlement_transfer_id" bigint,
+  "processor_reference" varchar NOT NULL DEFAULT '',
+  "failure_reason" varchar NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "sessions" ("username", "created_at");
+
+CREATE INDEX ON "sessions" ("family_id");
+
 CREATE INDEX ON "accounts" ("owner");
 
-CREATE UNIQUE INDEX ON "accounts" ("owner", "currency");
+CREATE UNIQUE INDEX ON "accounts" ("owner", "currency") WHERE "status" <> 'closed' AND "kind" = 'customer';
+
+CREATE UNIQUE INDEX ON "accounts" ("kind", "currency") WHERE "kind" <> 'customer';
 
 CREATE INDEX ON "entries" ("account_id");
 
@@ -58,12 +305,188 @@ CREATE INDEX ON "transfers" ("to_account_id");
 
 CREATE INDEX ON "transfers" ("from_account_id", "to_account_id");
 
+CREATE INDEX ON "exchange_rates" ("from_currency", "to_currency", "created_at");
+
+CREATE INDEX ON "holds" ("account_id");
+
+CREATE INDEX ON "holds" ("status", "expires_at");
+
+CREATE INDEX ON "transfers" ("reversal_of");
+
+CREATE INDEX ON "standing_orders" ("owner");
+
+CREATE INDEX ON "standing_orders" ("is_active", "next_run_at");
+
+CREATE UNIQUE INDEX ON "standing_order_runs" ("standing_order_id", "scheduled_at");
+
+CREATE INDEX ON "pending_transfers" ("status", "expires_at");
+
+CREATE INDEX ON "pending_transfers" ("initiator");
+
+CREATE INDEX ON "entries" ("transfer_id");
+
+CREATE INDEX ON "reconciliation_discrepancies" ("report_id");
+
+CREATE INDEX ON "account_status_changes" ("account_id");
+
+CREATE UNIQUE INDEX ON "fee_schedules" ("currency", "min_amount");
+
+CREATE UNIQUE INDEX ON "interest_rates" ("product", "currency");
+
+CREATE UNIQUE INDEX ON "interest_accruals" ("account_id", "accrual_date");
+
+CREATE INDEX ON "interest_accruals" ("account_id") WHERE "transfer_id" IS NULL;
+
+CREATE INDEX ON "transfers" ("from_account_id", "created_at");
+
+CREATE UNIQUE INDEX ON "role_transfer_limits" ("role", "currency");
+
+CREATE INDEX ON "accounts" ("owner", "id");
+
+CREATE INDEX ON "entries" ("account_id", "id");
+
+CREATE INDEX ON "transfers" ("from_account_id", "id");
+
+CREATE INDEX ON "transfers" ("to_account_id", "id");
+
+CREATE INDEX ON "transfers" USING GIN (to_tsvector('english', "description"));
+
+CREATE INDEX ON "transfers" ("external_reference") WHERE "external_reference" <> '';
+
+CREATE INDEX ON "entries" ("account_id", "created_at");
+
+CREATE UNIQUE INDEX ON "statements" ("account_id", "period_start", "period_end");
+
+CREATE UNIQUE INDEX ON "balance_snapshots" ("account_id", "snapshot_date");
+
+CREATE INDEX ON "deposits" ("account_id");
+
+CREATE INDEX ON "withdrawals" ("account_id");
+
+CREATE INDEX ON "withdrawals" ("status");
+
+COMMENT ON COLUMN "sessions"."family_id" IS 'the session created at login, every session its refresh token was rotated into shares it';
+
+COMMENT ON COLUMN "sessions"."previous_session_id" IS 'the session whose refresh token was exchanged for this one';
+
+COMMENT ON COLUMN "sessions"."rotated_at" IS 'when its refresh token was exchanged for a new one, presenting it again after that means it was stolen';
+
+COMMENT ON COLUMN "accounts"."overdraft_limit" IS 'how far below zero the balance may go';
+
 COMMENT ON COLUMN "entries"."amount" IS 'can be either negative or positive';
 
 COMMENT ON COLUMN "transfers"."amount" IS 'It must be positive';
 
+COMMENT ON COLUMN "transfers"."to_amount" IS 'amount credited in the to account''s currency';
+
+COMMENT ON COLUMN "transfers"."exchange_rate" IS 'applied rate scaled by 10^8, 100000000 when both accounts share a currency';
+
+COMMENT ON COLUMN "idempotency_keys"."username" IS 'keys are chosen by clients, so they''re only unique per user';
+
+COMMENT ON COLUMN "idempotency_keys"."request_hash" IS 'hash of the request the key was first used with';
+
+COMMENT ON COLUMN "exchange_rates"."rate" IS 'to_currency per one from_currency, scaled by 10^8';
+
+COMMENT ON COLUMN "exchange_quotes"."rate" IS 'to_currency per one from_currency, scaled by 10^8';
+
+COMMENT ON COLUMN "accounts"."held_balance" IS 'sum of authorized holds, available balance is balance - held_balance';
+
+COMMENT ON COLUMN "holds"."status" IS 'authorized, captured, voided or expired';
+
+COMMENT ON COLUMN "transfers"."reversal_of" IS 'the original transfer when this one refunds it';
+
+COMMENT ON COLUMN "transfers"."reversed_amount" IS 'how much of to_amount has been refunded so far';
+
+COMMENT ON COLUMN "standing_orders"."schedule" IS 'cron expression or @every interval';
+
+COMMENT ON COLUMN "standing_orders"."end_at" IS 'no runs are scheduled after this time, null runs forever';
+
+COMMENT ON COLUMN "standing_order_runs"."status" IS 'succeeded or failed';
+
+COMMENT ON COLUMN "pending_transfers"."reviewer" IS 'the banker who approved or rejected it, never the initiator';
+
+COMMENT ON COLUMN "pending_transfers"."status" IS 'pending, approved, rejected or expired';
+
+COMMENT ON COLUMN "entries"."transfer_id" IS 'the transfer that posted this entry';
+
+COMMENT ON COLUMN "reconciliation_reports"."triggered_by" IS 'the banker who asked for it, null for scheduled runs';
+
+COMMENT ON COLUMN "reconciliation_reports"."status" IS 'running or completed';
+
+COMMENT ON COLUMN "reconciliation_discrepancies"."kind" IS 'balance when an account''s balance isn''t the sum of its entries, entries when a transfer doesn''t have exactly its two entries';
+
+COMMENT ON COLUMN "reconciliation_discrepancies"."expected" IS 'sum of the entries for balance, 2 for entries or 3 when the transfer charged a fee';
+
+COMMENT ON COLUMN "reconciliation_discrepancies"."actual" IS 'the account balance for balance, the entries pointing at the transfer for entries';
+
+COMMENT ON COLUMN "accounts"."status" IS 'active, frozen or closed';
+
+COMMENT ON COLUMN "account_status_changes"."changed_by" IS 'the banker who changed the status';
+
+COMMENT ON COLUMN "users"."role" IS 'depositor, banker, or system for the bank user that owns its own ledger accounts';
+
+COMMENT ON COLUMN "accounts"."kind" IS 'customer, or the purpose of an account the bank owns';
+
+COMMENT ON COLUMN "transfers"."fee" IS 'taken out of amount and credited to the bank''s revenue account';
+
+COMMENT ON COLUMN "fee_schedules"."min_amount" IS 'the tier applies from this amount up to the next tier''s min_amount';
+
+COMMENT ON COLUMN "fee_schedules"."basis_points" IS 'percentage of the amount in hundredths of a percent, added to flat_fee';
+
+COMMENT ON COLUMN "accounts"."product" IS 'checking or savings, picks the interest rate together with currency';
+
+COMMENT ON COLUMN "interest_rates"."basis_points" IS 'yearly rate in hundredths of a percent';
+
+COMMENT ON COLUMN "interest_accruals"."balance" IS 'the account''s balance at the end of accrual_date';
+
+COMMENT ON COLUMN "interest_accruals"."amount" IS 'one day of interest in millionths of the currency''s minor unit';
+
+COMMENT ON COLUMN "interest_accruals"."transfer_id" IS 'the monthly transfer that paid it, null until then';
+
+COMMENT ON TABLE "account_transfer_limits" IS 'overrides the owner''s role limits for one account, a null column keeps the role''s';
+
+COMMENT ON COLUMN "role_transfer_limits"."max_amount" IS 'largest single transfer, null for no limit';
+
+COMMENT ON COLUMN "role_transfer_limits"."daily_amount" IS 'most sent in total per UTC day, null for no limit';
+
+COMMENT ON COLUMN "role_transfer_limits"."daily_count" IS 'most transfers sent per UTC day, null for no limit';
+
+COMMENT ON COLUMN "transfers"."description" IS 'free text memo from the sender, searchable';
+
+COMMENT ON COLUMN "transfers"."external_reference" IS 'the sender''s own id for the payment, like an invoice number';
+
+COMMENT ON COLUMN "transfers"."metadata" IS 'free-form JSON object the bank stores but never reads';
+
+COMMENT ON COLUMN "statements"."period_end" IS 'exclusive, entries posted at period_end belong to the next statement';
+
+COMMENT ON COLUMN "statements"."total_debits" IS 'sum of the negative entries, stored as a positive number';
+
+COMMENT ON COLUMN "statements"."html" IS 'printable form of the statement, a browser prints it to PDF';
+
+COMMENT ON COLUMN "statements"."emailed_at" IS 'when it was sent to the owner, null until then';
+
+COMMENT ON COLUMN "balance_snapshots"."balance" IS 'the account''s balance at the end of snapshot_date in UTC';
+
+COMMENT ON COLUMN "deposits"."transfer_id" IS 'the transfer from the bank''s cash account in the currency';
+
+COMMENT ON COLUMN "deposits"."deposited_by" IS 'the banker who took in the funds';
+
+COMMENT ON COLUMN "deposits"."reference" IS 'the receipt or slip number the funds came in with';
+
+COMMENT ON COLUMN "withdrawals"."status" IS 'pending until the processor settles it, then settled or failed';
+
+COMMENT ON COLUMN "withdrawals"."transfer_id" IS 'the transfer into the bank''s clearing account that holds the funds while pending';
+
+COMMENT ON COLUMN "withdrawals"."settlement_transfer_id" IS 'from clearing to cash once settled, back to the account once failed';
+
+COMMENT ON COLUMN "withdrawals"."processor_reference" IS 'the processor''s id for the payout';
+
 ALTER TABLE "verify_emails" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
 
+ALTER TABLE "sessions" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "sessions" ADD FOREIGN KEY ("previous_session_id") REFERENCES "sessions" ("id");
+
 ALTER TABLE "accounts" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
 
 ALTER TABLE "entries" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
@@ -73,3 +496,75 @@ ALTER TABLE "transfers" ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts
 ALTER TABLE "transfers" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
 
 ALTER TABLE "accounts" ADD FOREIGN KEY ("owner") REFERENCES "entries" ("account_id");
+
+ALTER TABLE "idempotency_keys" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "idempotency_keys" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "exchange_quotes" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "holds" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "holds" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "transfers" ADD FOREIGN KEY ("reversal_of") REFERENCES "transfers" ("id");
+
+ALTER TABLE "standing_orders" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
+
+ALTER TABLE "standing_orders" ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "standing_orders" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "standing_order_runs" ADD FOREIGN KEY ("standing_order_id") REFERENCES "standing_orders" ("id");
+
+ALTER TABLE "standing_order_runs" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "pending_transfers" ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "pending_transfers" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "pending_transfers" ADD FOREIGN KEY ("initiator") REFERENCES "users" ("username");
+
+ALTER TABLE "pending_transfers" ADD FOREIGN KEY ("reviewer") REFERENCES "users" ("username");
+
+ALTER TABLE "pending_transfers" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "entries" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "reconciliation_reports" ADD FOREIGN KEY ("triggered_by") REFERENCES "users" ("username");
+
+ALTER TABLE "reconciliation_discrepancies" ADD FOREIGN KEY ("report_id") REFERENCES "reconciliation_reports" ("id");
+
+ALTER TABLE "reconciliation_discrepancies" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "reconciliation_discrepancies" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "account_status_changes" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "account_status_changes" ADD FOREIGN KEY ("changed_by") REFERENCES "users" ("username");
+
+ALTER TABLE "interest_accruals" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "interest_accruals" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "account_transfer_limits" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "account_transfer_limits" ADD FOREIGN KEY ("updated_by") REFERENCES "users" ("username");
+
+ALTER TABLE "statements" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "balance_snapshots" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "deposits" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "deposits" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "deposits" ADD FOREIGN KEY ("deposited_by") REFERENCES "users" ("username");
+
+ALTER TABLE "withdrawals" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "withdrawals" ADD FOREIGN KEY ("requested_by") REFERENCES "users" ("username");
+
+ALTER TABLE "withdrawals" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "withdrawals" ADD FOREIGN KEY ("settlement_transfer_id") REFERENCES "transfers" ("id");
@@ -0,0 +1,79 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	db "goBank/db/sqlc"
+	"goBank/token"
+	"goBank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// getAccount loads an account and maps store errors to gRPC status errors.
+func (server *Server) getAccount(ctx context.Context, accountID int64) (db.Account, error) {
+	account, err := server.store.GetAccount(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return account, status.Errorf(codes.NotFound, "account [%d] not found", accountID)
+		}
+		return account, status.Errorf(codes.Internal, "failed to get account: %s", err)
+	}
+	return account, nil
+}
+
+// getOwnedAccount loads an account and makes sure it belongs to the authenticated user.
+func (server *Server) getOwnedAccount(ctx context.Context, accountID int64, username string) (db.Account, error) {
+	account, err := server.getAccount(ctx, accountID)
+	if err != nil {
+		return account, err
+	}
+
+	if account.Owner != username {
+		return account, status.Errorf(codes.PermissionDenied, "account doesn't belong to the authenticated user")
+	}
+	return account, nil
+}
+
+// getAuditableAccount loads an account the authenticated user may look into, any account for a banker
+// looking into an audit or a dispute, only their own for everyone else.
+func (server *Server) getAuditableAccount(ctx context.Context, accountID int64, authPayload *token.Payload) (db.Account, error) {
+	if authPayload.Role == util.BankerRole {
+		return server.getAccount(ctx, accountID)
+	}
+	return server.getOwnedAccount(ctx, accountID, authPayload.Username)
+}
+
+// validAccount loads an account and checks it holds the given currency.
+func (server *Server) validAccount(ctx context.Context, accountID int64, currency string) (db.Account, error) {
+	account, err := server.getAccount(ctx, accountID)
+	if err != nil {
+		return account, err
+	}
+
+	//Currencies don't end up matching
+	if account.Currency != currency {
+		return account, status.Errorf(codes.InvalidArgument, "account [%d] currency mismatch: %s vs %s", account.ID, account.Currency, currency)
+	}
+	return account, nil
+}
+
+// changeAccountStatus moves an account to status on behalf of the authenticated banker.
+func (server *Server) changeAccountStatus(ctx context.Context, accountID int64, accountStatus string, changedBy string, reason string) (db.Account, error) {
+	result, err := server.store.ChangeAccountStatusTx(ctx, db.ChangeAccountStatusTxParams{
+		AccountID: accountID,
+		Status:    accountStatus,
+		ChangedBy: changedBy,
+		Reason:    reason,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return result.Account, status.Errorf(codes.NotFound, "account [%d] not found", accountID)
+		}
+		if errors.Is(err, db.ErrInvalidStatusTransition) || errors.Is(err, db.ErrAccountNotEmpty) {
+			return result.Account, status.Errorf(codes.FailedPrecondition, "cannot change account status: %s", err)
+		}
+		return result.Account, status.Errorf(codes.Internal, "failed to change account status: %s", err)
+	}
+	return result.Account, nil
+}
@@ -3,8 +3,11 @@ package gapi
 import (
 	"context"
 	"fmt"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"goBank/token"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"strings"
 )
 
@@ -13,7 +16,53 @@ const (
 	authorizationBearer = "bearer"
 )
 
-func (server *Server) authorizeUser(ctx context.Context, accessibleRoles []string) (*token.Payload, error) {
+type authPayloadKey struct{}
+
+// authorizeUser returns the payload of the caller's access token, or a status error to return as is.
+// The auth interceptor has already checked it against the method's policy and put it in the context.
+// The HTTP gateway calls the server in-process, which skips the interceptors,
+// so for its requests the policy of the method it serves is checked here.
+func (server *Server) authorizeUser(ctx context.Context) (*token.Payload, error) {
+	if payload, ok := ctx.Value(authPayloadKey{}).(*token.Payload); ok {
+		return payload, nil
+	}
+
+	method, ok := runtime.RPCMethod(ctx)
+	if !ok {
+		return nil, unauthenticatedError(fmt.Errorf("missing method"))
+	}
+
+	payload, err := server.authenticate(ctx, method)
+	if err != nil {
+		return nil, err
+	}
+	if payload == nil {
+		return nil, unauthenticatedError(fmt.Errorf("missing authorization header"))
+	}
+	return payload, nil
+}
+
+// authenticate checks the caller against the method's policy.
+// The payload is nil when the method is public, whether or not an access token was sent.
+// A missing or invalid access token is Unauthenticated, a valid one whose role isn't allowed is PermissionDenied.
+func (server *Server) authenticate(ctx context.Context, method string) (*token.Payload, error) {
+	policy := policyForMethod(method)
+	if policy.public {
+		return nil, nil
+	}
+
+	payload, err := server.verifyAccessToken(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if !policy.allows(payload.Role) {
+		return nil, status.Errorf(codes.PermissionDenied, "role %s isn't allowed to call %s", payload.Role, method)
+	}
+	return payload, nil
+}
+
+func (server *Server) verifyAccessToken(ctx context.Context) (*token.Payload, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 
 	if !ok {
@@ -45,10 +94,6 @@ func (server *Server) authorizeUser(ctx context.Context, accessibleRoles []strin
 	if err != nil {
 		return nil, fmt.Errorf("invalid access token: %s", err)
 	}
-
-	if !hasPermission(payload.Role, accessibleRoles) {
-		return nil, fmt.Errorf("permission denied son!")
-	}
 	return payload, nil
 }
 
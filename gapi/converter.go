@@ -3,6 +3,7 @@ package gapi
 import (
 	db "goBank/db/sqlc"
 	"goBank/pb"
+	"goBank/util"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -15,3 +16,294 @@ func convertUser(user db.User) *pb.User {
 		CreatedAt:         timestamppb.New(user.CreatedAt),
 	}
 }
+
+func convertSession(session db.Session) *pb.Session {
+	return &pb.Session{
+		Id:        session.ID.String(),
+		UserAgent: session.UserAgent,
+		ClientIp:  session.ClientIp,
+		CreatedAt: timestamppb.New(session.CreatedAt),
+		ExpiresAt: timestamppb.New(session.ExpiresAt),
+	}
+}
+
+func convertAccount(account db.Account) *pb.Account {
+	return &pb.Account{
+		Id:               account.ID,
+		Owner:            account.Owner,
+		Balance:          account.Balance,
+		Currency:         account.Currency,
+		CreatedAt:        timestamppb.New(account.CreatedAt),
+		OverdraftLimit:   account.OverdraftLimit,
+		HeldBalance:      account.HeldBalance,
+		AvailableBalance: account.AvailableBalance(),
+		Status:           account.Status,
+		Product:          account.Product,
+
+		BalanceDecimal:          util.FormatAmount(account.Currency, account.Balance),
+		OverdraftLimitDecimal:   util.FormatAmount(account.Currency, account.OverdraftLimit),
+		HeldBalanceDecimal:      util.FormatAmount(account.Currency, account.HeldBalance),
+		AvailableBalanceDecimal: util.FormatAmount(account.Currency, account.AvailableBalance()),
+	}
+}
+
+func convertCurrency(currency util.Currency) *pb.Currency {
+	return &pb.Currency{
+		Code:        currency.Code,
+		NumericCode: currency.NumericCode,
+		MinorUnits:  int32(currency.MinorUnits),
+		Symbol:      currency.Symbol,
+	}
+}
+
+func convertTransfer(transfer db.Transfer) *pb.Transfer {
+	return &pb.Transfer{
+		Id:             transfer.ID,
+		FromAccountId:  transfer.FromAccountID,
+		ToAccountId:    transfer.ToAccountID,
+		Amount:         transfer.Amount,
+		CreatedAt:      timestamppb.New(transfer.CreatedAt),
+		ToAmount:       transfer.ToAmount,
+		ExchangeRate:   transfer.ExchangeRate,
+		ReversalOf:     transfer.ReversalOf.Int64,
+		ReversedAmount: transfer.ReversedAmount,
+		Fee:            transfer.Fee,
+
+		Description:       transfer.Description,
+		ExternalReference: transfer.ExternalReference,
+		Metadata:          convertMetadata(transfer.Metadata),
+	}
+}
+
+func convertEntry(entry db.Entry) *pb.Entry {
+	return &pb.Entry{
+		Id:         entry.ID,
+		AccountId:  entry.AccountID,
+		Amount:     entry.Amount,
+		CreatedAt:  timestamppb.New(entry.CreatedAt),
+		TransferId: entry.TransferID.Int64,
+	}
+}
+
+func convertExchangeRate(rate db.ExchangeRate) *pb.ExchangeRate {
+	return &pb.ExchangeRate{
+		Id:           rate.ID,
+		FromCurrency: rate.FromCurrency,
+		ToCurrency:   rate.ToCurrency,
+		Rate:         rate.Rate,
+		CreatedAt:    timestamppb.New(rate.CreatedAt),
+	}
+}
+
+func convertExchangeQuote(quote db.ExchangeQuote) *pb.ExchangeQuote {
+	return &pb.ExchangeQuote{
+		Id:           quote.ID.String(),
+		FromCurrency: quote.FromCurrency,
+		ToCurrency:   quote.ToCurrency,
+		Rate:         quote.Rate,
+		ExpiresAt:    timestamppb.New(quote.ExpiresAt),
+	}
+}
+
+func convertHold(hold db.Hold) *pb.Hold {
+	return &pb.Hold{
+		Id:             hold.ID,
+		AccountId:      hold.AccountID,
+		Amount:         hold.Amount,
+		CapturedAmount: hold.CapturedAmount,
+		TransferId:     hold.TransferID.Int64,
+		Status:         hold.Status,
+		ExpiresAt:      timestamppb.New(hold.ExpiresAt),
+		CreatedAt:      timestamppb.New(hold.CreatedAt),
+	}
+}
+
+func convertStandingOrder(order db.StandingOrder) *pb.StandingOrder {
+	pbOrder := &pb.StandingOrder{
+		Id:            order.ID,
+		Owner:         order.Owner,
+		FromAccountId: order.FromAccountID,
+		ToAccountId:   order.ToAccountID,
+		Amount:        order.Amount,
+		Schedule:      order.Schedule,
+		NextRunAt:     timestamppb.New(order.NextRunAt),
+		IsActive:      order.IsActive,
+		CreatedAt:     timestamppb.New(order.CreatedAt),
+	}
+	if order.EndAt.Valid {
+		pbOrder.EndAt = timestamppb.New(order.EndAt.Time)
+	}
+	return pbOrder
+}
+
+func convertStandingOrderRun(run db.StandingOrderRun) *pb.StandingOrderRun {
+	return &pb.StandingOrderRun{
+		Id:              run.ID,
+		StandingOrderId: run.StandingOrderID,
+		ScheduledAt:     timestamppb.New(run.ScheduledAt),
+		TransferId:      run.TransferID.Int64,
+		Status:          run.Status,
+		Error:           run.Error,
+		CreatedAt:       timestamppb.New(run.CreatedAt),
+	}
+}
+
+func convertPendingTransfer(pending db.PendingTransfer) *pb.PendingTransfer {
+	return &pb.PendingTransfer{
+		Id:            pending.ID,
+		FromAccountId: pending.FromAccountID,
+		ToAccountId:   pending.ToAccountID,
+		Amount:        pending.Amount,
+		ToAmount:      pending.ToAmount,
+		ExchangeRate:  pending.ExchangeRate,
+		Initiator:     pending.Initiator,
+		Reviewer:      pending.Reviewer.String,
+		Status:        pending.Status,
+		Reason:        pending.Reason,
+		TransferId:    pending.TransferID.Int64,
+		ExpiresAt:     timestamppb.New(pending.ExpiresAt),
+		CreatedAt:     timestamppb.New(pending.CreatedAt),
+
+		Description:       pending.Description,
+		ExternalReference: pending.ExternalReference,
+		Metadata:          convertMetadata(pending.Metadata),
+	}
+}
+
+func convertReconciliationReport(report db.ReconciliationReport) *pb.ReconciliationReport {
+	pbReport := &pb.ReconciliationReport{
+		Id:               report.ID,
+		TriggeredBy:      report.TriggeredBy.String,
+		Status:           report.Status,
+		AccountsChecked:  report.AccountsChecked,
+		TransfersChecked: report.TransfersChecked,
+		DiscrepancyCount: report.DiscrepancyCount,
+		CreatedAt:        timestamppb.New(report.CreatedAt),
+	}
+	if report.CompletedAt.Valid {
+		pbReport.CompletedAt = timestamppb.New(report.CompletedAt.Time)
+	}
+	return pbReport
+}
+
+func convertReconciliationDiscrepancy(discrepancy db.ReconciliationDiscrepancy) *pb.ReconciliationDiscrepancy {
+	return &pb.ReconciliationDiscrepancy{
+		Id:         discrepancy.ID,
+		Kind:       discrepancy.Kind,
+		AccountId:  discrepancy.AccountID.Int64,
+		TransferId: discrepancy.TransferID.Int64,
+		Expected:   discrepancy.Expected,
+		Actual:     discrepancy.Actual,
+	}
+}
+
+func convertFeeSchedules(schedules []db.FeeSchedule) []*pb.FeeTier {
+	tiers := make([]*pb.FeeTier, len(schedules))
+	for i, schedule := range schedules {
+		tiers[i] = &pb.FeeTier{
+			MinAmount:   schedule.MinAmount,
+			FlatFee:     schedule.FlatFee,
+			BasisPoints: schedule.BasisPoints,
+		}
+	}
+	return tiers
+}
+
+func convertInterestRate(rate db.InterestRate) *pb.InterestRate {
+	return &pb.InterestRate{
+		Product:     rate.Product,
+		Currency:    rate.Currency,
+		BasisPoints: rate.BasisPoints,
+		UpdatedAt:   timestamppb.New(rate.UpdatedAt),
+	}
+}
+
+func convertInterestAccrual(accrual db.InterestAccrual) *pb.InterestAccrual {
+	return &pb.InterestAccrual{
+		Id:          accrual.ID,
+		AccountId:   accrual.AccountID,
+		AccrualDate: timestamppb.New(accrual.AccrualDate.Time),
+		Balance:     accrual.Balance,
+		BasisPoints: accrual.BasisPoints,
+		Amount:      accrual.Amount,
+		TransferId:  accrual.TransferID.Int64,
+	}
+}
+
+func convertTransferLimits(limits db.TransferLimits) *pb.TransferLimits {
+	rsp := &pb.TransferLimits{}
+	if limits.MaxAmount.Valid {
+		rsp.MaxAmount = &limits.MaxAmount.Int64
+	}
+	if limits.DailyAmount.Valid {
+		rsp.DailyAmount = &limits.DailyAmount.Int64
+	}
+	if limits.DailyCount.Valid {
+		rsp.DailyCount = &limits.DailyCount.Int64
+	}
+	return rsp
+}
+
+func convertStatement(statement db.StatementTxResult, csv string, html string) *pb.Statement {
+	currency := statement.Account.Currency
+	rsp := &pb.Statement{
+		AccountId:             statement.Account.ID,
+		Currency:              currency,
+		PeriodStart:           timestamppb.New(statement.PeriodStart),
+		PeriodEnd:             timestamppb.New(statement.PeriodEnd),
+		OpeningBalance:        statement.OpeningBalance,
+		ClosingBalance:        statement.ClosingBalance,
+		TotalCredits:          statement.TotalCredits,
+		TotalDebits:           statement.TotalDebits,
+		OpeningBalanceDecimal: util.FormatAmount(currency, statement.OpeningBalance),
+		ClosingBalanceDecimal: util.FormatAmount(currency, statement.ClosingBalance),
+		TotalCreditsDecimal:   util.FormatAmount(currency, statement.TotalCredits),
+		TotalDebitsDecimal:    util.FormatAmount(currency, statement.TotalDebits),
+		Csv:                   csv,
+		Html:                  html,
+	}
+	for _, line := range statement.Lines {
+		rsp.Lines = append(rsp.Lines, &pb.StatementLine{
+			EntryId:           line.ID,
+			TransferId:        line.TransferID.Int64,
+			Amount:            line.Amount,
+			AmountDecimal:     util.FormatAmount(currency, line.Amount),
+			Balance:           line.Balance,
+			BalanceDecimal:    util.FormatAmount(currency, line.Balance),
+			Description:       line.Description,
+			ExternalReference: line.ExternalReference,
+			CreatedAt:         timestamppb.New(line.CreatedAt),
+		})
+	}
+	return rsp
+}
+
+func convertDeposit(deposit db.Deposit, currency string) *pb.Deposit {
+	return &pb.Deposit{
+		Id:            deposit.ID,
+		AccountId:     deposit.AccountID,
+		Amount:        deposit.Amount,
+		AmountDecimal: util.FormatAmount(currency, deposit.Amount),
+		TransferId:    deposit.TransferID,
+		DepositedBy:   deposit.DepositedBy,
+		Reference:     deposit.Reference,
+		CreatedAt:     timestamppb.New(deposit.CreatedAt),
+	}
+}
+
+func convertWithdrawal(withdrawal db.Withdrawal, currency string) *pb.Withdrawal {
+	return &pb.Withdrawal{
+		Id:                   withdrawal.ID,
+		AccountId:            withdrawal.AccountID,
+		Amount:               withdrawal.Amount,
+		AmountDecimal:        util.FormatAmount(currency, withdrawal.Amount),
+		Status:               withdrawal.Status,
+		RequestedBy:          withdrawal.RequestedBy,
+		TransferId:           withdrawal.TransferID,
+		SettlementTransferId: withdrawal.SettlementTransferID.Int64,
+		ProcessorReference:   withdrawal.ProcessorReference,
+		FailureReason:        withdrawal.FailureReason,
+		CreatedAt:            timestamppb.New(withdrawal.CreatedAt),
+		UpdatedAt:            timestamppb.New(withdrawal.UpdatedAt),
+	}
+}
@@ -1,6 +1,8 @@
 package gapi
 
 import (
+	"errors"
+	db "goBank/db/sqlc"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -30,3 +32,49 @@ func invalidArgumentError(violations []*errdetails.BadRequest_FieldViolation) er
 func unauthenticatedError(err error) error {
 	return status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
 }
+
+// transferTxError maps errors returned by the store's transfer, hold and review transactions to gRPC status errors.
+func transferTxError(err error) error {
+	if errors.Is(err, db.ErrInsufficientFunds) {
+		return status.Errorf(codes.FailedPrecondition, "cannot transfer: %s", err)
+	}
+	if errors.Is(err, db.ErrIdempotencyKeyConflict) {
+		return status.Errorf(codes.AlreadyExists, "cannot transfer: %s", err)
+	}
+	if errors.Is(err, db.ErrQuoteExpired) || errors.Is(err, db.ErrQuoteUsed) ||
+		errors.Is(err, db.ErrQuoteMismatch) || errors.Is(err, db.ErrAmountTooSmall) ||
+		errors.Is(err, db.ErrAmountOverflow) || errors.Is(err, db.ErrFeeExceedsAmount) {
+		return status.Errorf(codes.FailedPrecondition, "cannot transfer: %s", err)
+	}
+	if errors.Is(err, db.ErrHoldNotAuthorized) || errors.Is(err, db.ErrHoldExpired) ||
+		errors.Is(err, db.ErrCaptureExceedsHold) {
+		return status.Errorf(codes.FailedPrecondition, "cannot transfer: %s", err)
+	}
+	if errors.Is(err, db.ErrTransferIsReversal) || errors.Is(err, db.ErrTransferAlreadyReversed) ||
+		errors.Is(err, db.ErrReversalExceedsTransfer) {
+		return status.Errorf(codes.FailedPrecondition, "cannot transfer: %s", err)
+	}
+	if errors.Is(err, db.ErrBatchCurrencyMismatch) || errors.Is(err, db.ErrBatchSelfTransfer) {
+		return status.Errorf(codes.FailedPrecondition, "cannot transfer: %s", err)
+	}
+	if errors.Is(err, db.ErrAccountFrozen) || errors.Is(err, db.ErrAccountClosed) {
+		return status.Errorf(codes.FailedPrecondition, "cannot transfer: %s", err)
+	}
+	if errors.Is(err, db.ErrTransferAmountLimit) || errors.Is(err, db.ErrDailyAmountLimit) ||
+		errors.Is(err, db.ErrDailyCountLimit) {
+		return status.Errorf(codes.ResourceExhausted, "cannot transfer: %s", err)
+	}
+	if errors.Is(err, db.ErrPendingTransferReviewed) || errors.Is(err, db.ErrPendingTransferExpired) {
+		return status.Errorf(codes.FailedPrecondition, "cannot transfer: %s", err)
+	}
+	if errors.Is(err, db.ErrWithdrawalSettled) {
+		return status.Errorf(codes.FailedPrecondition, "cannot transfer: %s", err)
+	}
+	if errors.Is(err, db.ErrSelfReview) {
+		return status.Errorf(codes.PermissionDenied, "cannot transfer: %s", err)
+	}
+	if errors.Is(err, db.ErrRecordNotFound) {
+		return status.Errorf(codes.NotFound, "cannot transfer: %s", err)
+	}
+	return status.Errorf(codes.Internal, "failed to transfer: %s", err)
+}
@@ -0,0 +1,23 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	db "goBank/db/sqlc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// getOwnedHold loads a hold and the account it's placed on, which must belong to the authenticated user.
+func (server *Server) getOwnedHold(ctx context.Context, holdID int64, username string) (db.Hold, db.Account, error) {
+	hold, err := server.store.GetHold(ctx, holdID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return hold, db.Account{}, status.Errorf(codes.NotFound, "hold [%d] not found", holdID)
+		}
+		return hold, db.Account{}, status.Errorf(codes.Internal, "failed to get hold: %s", err)
+	}
+
+	account, err := server.getOwnedAccount(ctx, hold.AccountID, username)
+	return hold, account, err
+}
@@ -0,0 +1,56 @@
+package gapi
+
+import (
+	"context"
+	"google.golang.org/grpc"
+)
+
+// UnaryAuthInterceptor checks the caller of a unary method against its policy in methodPolicies
+// and passes the payload of their access token on to the handler in the context.
+func (server *Server) UnaryAuthInterceptor(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (resp any, err error) {
+	ctx, err = server.authenticateContext(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamAuthInterceptor is UnaryAuthInterceptor for streaming methods
+func (server *Server) StreamAuthInterceptor(
+	srv any,
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	ctx, err := server.authenticateContext(stream.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authServerStream{ServerStream: stream, ctx: ctx})
+}
+
+func (server *Server) authenticateContext(ctx context.Context, method string) (context.Context, error) {
+	payload, err := server.authenticate(ctx, method)
+	if err != nil {
+		return nil, err
+	}
+	if payload != nil {
+		ctx = context.WithValue(ctx, authPayloadKey{}, payload)
+	}
+	return ctx, nil
+}
+
+// authServerStream hands the stream's handler the context with the caller's payload in it
+type authServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (stream *authServerStream) Context() context.Context {
+	return stream.ctx
+}
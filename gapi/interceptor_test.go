@@ -0,0 +1,196 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+	"goBank/pb"
+	"goBank/token"
+	"goBank/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestEveryMethodHasPolicy(t *testing.T) {
+	for _, method := range pb.SimpleBank_ServiceDesc.Methods {
+		fullMethod := fmt.Sprintf("/%s/%s", pb.SimpleBank_ServiceDesc.ServiceName, method.MethodName)
+		_, ok := methodPolicies[fullMethod]
+		require.True(t, ok, "%s has no policy", fullMethod)
+	}
+}
+
+func TestUnaryAuthInterceptor(t *testing.T) {
+	user, _ := randomUser(t)
+	banker, _ := randomUser(t)
+	banker.Role = util.BankerRole
+
+	testCases := []struct {
+		name          string
+		method        string
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, payload *token.Payload, called bool, err error)
+	}{
+		{
+			name:   "PublicWithoutToken",
+			method: pb.SimpleBank_ListCurrencies_FullMethodName,
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, payload *token.Payload, called bool, err error) {
+				require.NoError(t, err)
+				require.True(t, called)
+				require.Nil(t, payload)
+			},
+		},
+		{
+			name:   "Authenticated",
+			method: pb.SimpleBank_GetAccount_FullMethodName,
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, payload *token.Payload, called bool, err error) {
+				require.NoError(t, err)
+				require.True(t, called)
+				require.Equal(t, user.Username, payload.Username)
+			},
+		},
+		{
+			name:   "NoAuthorization",
+			method: pb.SimpleBank_GetAccount_FullMethodName,
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, payload *token.Payload, called bool, err error) {
+				require.False(t, called)
+				require.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+		{
+			name:   "ExpiredToken",
+			method: pb.SimpleBank_GetAccount_FullMethodName,
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, -time.Minute)
+			},
+			checkResponse: func(t *testing.T, payload *token.Payload, called bool, err error) {
+				require.False(t, called)
+				require.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+		{
+			name:   "BankerMethod",
+			method: pb.SimpleBank_FreezeAccount_FullMethodName,
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, payload *token.Payload, called bool, err error) {
+				require.NoError(t, err)
+				require.True(t, called)
+				require.Equal(t, util.BankerRole, payload.Role)
+			},
+		},
+		{
+			name:   "DepositorOnBankerMethod",
+			method: pb.SimpleBank_FreezeAccount_FullMethodName,
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, payload *token.Payload, called bool, err error) {
+				require.False(t, called)
+				require.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
+		{
+			name:   "UnlistedMethodNeedsToken",
+			method: "/pb.SimpleBank/NotInThePolicyTable",
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, payload *token.Payload, called bool, err error) {
+				require.False(t, called)
+				require.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			server := newTestServer(t, nil, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+
+			var payload *token.Payload
+			var called bool
+			handler := func(ctx context.Context, req any) (any, error) {
+				called = true
+				payload, _ = ctx.Value(authPayloadKey{}).(*token.Payload)
+				return nil, nil
+			}
+
+			_, err := server.UnaryAuthInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tc.method}, handler)
+			tc.checkResponse(t, payload, called, err)
+		})
+	}
+}
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (stream *testServerStream) Context() context.Context {
+	return stream.ctx
+}
+
+func TestStreamAuthInterceptor(t *testing.T) {
+	user, _ := randomUser(t)
+	server := newTestServer(t, nil, nil)
+	info := &grpc.StreamServerInfo{FullMethod: "/pb.SimpleBank/StreamSomething", IsServerStream: true}
+
+	var payload *token.Payload
+	handler := func(srv any, stream grpc.ServerStream) error {
+		payload, _ = server.authorizeUser(stream.Context())
+		return nil
+	}
+
+	ctx := newContextWithBearerToken(t, server.tokenMaker, user.Username, user.Role, time.Minute)
+	err := server.StreamAuthInterceptor(nil, &testServerStream{ctx: ctx}, info, handler)
+	require.NoError(t, err)
+	require.Equal(t, user.Username, payload.Username)
+
+	err = server.StreamAuthInterceptor(nil, &testServerStream{ctx: context.Background()}, info, handler)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestAuthorizeUserThroughGateway(t *testing.T) {
+	user, _ := randomUser(t)
+	server := newTestServer(t, nil, nil)
+	accessToken, _, err := server.tokenMaker.CreateToken(user.Username, user.Role, time.Minute)
+	require.NoError(t, err)
+
+	// the gateway calls the server in-process, so the method's policy is checked without the interceptor
+	gatewayContext := func(method string) context.Context {
+		req := httptest.NewRequest(http.MethodPost, "/v1/freeze_account", nil)
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", accessToken))
+		ctx, err := runtime.AnnotateIncomingContext(context.Background(), runtime.NewServeMux(), req, method)
+		require.NoError(t, err)
+		return ctx
+	}
+
+	payload, err := server.authorizeUser(gatewayContext(pb.SimpleBank_GetAccount_FullMethodName))
+	require.NoError(t, err)
+	require.Equal(t, user.Username, payload.Username)
+
+	_, err = server.authorizeUser(gatewayContext(pb.SimpleBank_FreezeAccount_FullMethodName))
+	require.Error(t, err)
+
+	// outside the gateway and the interceptor there's no policy to check against
+	_, err = server.authorizeUser(newContextWithBearerToken(t, server.tokenMaker, user.Username, user.Role, time.Minute))
+	require.Error(t, err)
+}
@@ -0,0 +1,42 @@
+package gapi
+
+import (
+	"encoding/json"
+	"goBank/token"
+	"net/http"
+
+	"github.com/rs/zerolog/log"
+)
+
+// JWKSPath is where the public keys our tokens are signed with are published
+const JWKSPath = "/.well-known/jwks.json"
+
+// JWKSHandler serves the public keys tokens are accepted from so other services can verify them.
+// There's nothing to publish when tokens are signed with the symmetric key.
+func (server *Server) JWKSHandler() http.Handler {
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet && req.Method != http.MethodHead {
+			res.Header().Set("Allow", "GET, HEAD")
+			http.Error(res, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		publisher, ok := server.tokenMaker.(token.KeyPublisher)
+		if !ok {
+			http.NotFound(res, req)
+			return
+		}
+
+		data, err := json.Marshal(publisher.JWKS())
+		if err != nil {
+			log.Error().Err(err).Msg("cannot marshal JWKS")
+			http.Error(res, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		res.Header().Set("Content-Type", "application/json")
+		// verifiers refetch every few minutes, so a key published ahead of a rotation is known before it signs anything
+		res.Header().Set("Cache-Control", "public, max-age=300")
+		res.Write(data)
+	})
+}
@@ -0,0 +1,91 @@
+package gapi
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"goBank/token"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJWKSHandler(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.NoError(t, err)
+
+	keyID, err := token.KeyID(publicKey)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name          string
+		method        string
+		buildServer   func(t *testing.T) *Server
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "OK",
+			method: http.MethodGet,
+			buildServer: func(t *testing.T) *Server {
+				config := newTestServer(t, nil, nil).config
+				config.TokenPrivateKey = string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+
+				server, err := NewServer(config, nil, nil)
+				require.NoError(t, err)
+				return server
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+				require.NotEmpty(t, recorder.Header().Get("Cache-Control"))
+
+				var jwks token.JSONWebKeySet
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &jwks))
+				require.Len(t, jwks.Keys, 1)
+				require.Equal(t, keyID, jwks.Keys[0].Kid)
+				require.Equal(t, token.AlgorithmEdDSA, jwks.Keys[0].Alg)
+			},
+		},
+		{
+			name:   "SymmetricKey",
+			method: http.MethodGet,
+			buildServer: func(t *testing.T) *Server {
+				return newTestServer(t, nil, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:   "MethodNotAllowed",
+			method: http.MethodPost,
+			buildServer: func(t *testing.T) *Server {
+				return newTestServer(t, nil, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			server := tc.buildServer(t)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(tc.method, JWKSPath, nil)
+
+			server.JWKSHandler().ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
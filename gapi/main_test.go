@@ -8,6 +8,7 @@ import (
 	"goBank/token"
 	"goBank/util"
 	"goBank/worker"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 	"testing"
 	"time"
@@ -17,6 +18,12 @@ func newTestServer(t *testing.T, store db.Store, taskDistributor worker.TaskDist
 	config := util.Config{
 		TokenSymmetricKey:   util.RandomString(32),
 		AccessTokenDuration: time.Minute,
+		// quotes need a TTL or they'd expire the moment they're created
+		ExchangeQuoteDuration:    time.Minute,
+		HoldDuration:             time.Hour,
+		ApprovalThresholds:       util.CurrencyAmounts{util.USD: 1000},
+		PendingTransferDuration:  time.Hour,
+		SettlementCallbackSecret: util.RandomString(32),
 	}
 
 	server, err := NewServer(config, store, taskDistributor)
@@ -37,3 +44,21 @@ func newContextWithBearerToken(t *testing.T, tokenMaker token.Maker, username st
 	}
 	return metadata.NewIncomingContext(context.Background(), md)
 }
+
+// callRPC calls a handler behind the auth interceptor, the way the gRPC server calls it
+func callRPC[Req any, Res any](
+	ctx context.Context,
+	server *Server,
+	method string,
+	req Req,
+	handler func(context.Context, Req) (Res, error),
+) (Res, error) {
+	var zero Res
+	res, err := server.UnaryAuthInterceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req any) (any, error) {
+		return handler(ctx, req.(Req))
+	})
+	if err != nil {
+		return zero, err
+	}
+	return res.(Res), nil
+}
@@ -2,7 +2,11 @@ package gapi
 
 import (
 	"context"
+	"goBank/val"
+	"strings"
 
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 )
@@ -11,11 +15,13 @@ const (
 	grpcGatewayUserAgentHeader = "grpcgateway-user-agent"
 	userAgentHeader            = "user-agent"
 	xForwardedForHeader        = "x-forwarded-for"
+	idempotencyKeyHeader       = "idempotency-key"
 )
 
 type Metadata struct {
-	UserAgent string
-	ClientIP  string
+	UserAgent      string
+	ClientIP       string
+	IdempotencyKey string
 }
 
 func (server *Server) extractMetadata(ctx context.Context) *Metadata {
@@ -33,6 +39,10 @@ func (server *Server) extractMetadata(ctx context.Context) *Metadata {
 		if clientIPs := md.Get(xForwardedForHeader); len(clientIPs) > 0 {
 			mtdt.ClientIP = clientIPs[0]
 		}
+
+		if idempotencyKeys := md.Get(idempotencyKeyHeader); len(idempotencyKeys) > 0 {
+			mtdt.IdempotencyKey = idempotencyKeys[0]
+		}
 	}
 
 	if p, ok := peer.FromContext(ctx); ok {
@@ -41,3 +51,25 @@ func (server *Server) extractMetadata(ctx context.Context) *Metadata {
 
 	return mtdt
 }
+
+// validateIdempotencyKey checks the request's idempotency key, which is optional
+// and comes in through metadata rather than the message
+func validateIdempotencyKey(mtdt *Metadata) (violations []*errdetails.BadRequest_FieldViolation) {
+	if mtdt.IdempotencyKey == "" {
+		return nil
+	}
+
+	if err := val.ValidateIdempotencyKey(mtdt.IdempotencyKey); err != nil {
+		violations = append(violations, fieldViolation(idempotencyKeyHeader, err))
+	}
+	return violations
+}
+
+// GatewayHeaderMatcher passes the Idempotency-Key HTTP header through the gateway as gRPC metadata.
+// Everything else falls back to the gateway's default matching.
+func GatewayHeaderMatcher(key string) (string, bool) {
+	if strings.ToLower(key) == idempotencyKeyHeader {
+		return idempotencyKeyHeader, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
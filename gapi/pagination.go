@@ -0,0 +1,47 @@
+package gapi
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+// optionalTime is the time of a timestamp a request may leave unset, zero when it does
+func optionalTime(t *timestamppb.Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.AsTime()
+}
+
+// timestamptzArg turns an optional timestamp filter into a query argument, one that isn't set stays invalid
+func timestamptzArg(t *timestamppb.Timestamp) pgtype.Timestamptz {
+	return pgtype.Timestamptz{Time: optionalTime(t), Valid: t != nil}
+}
+
+// int8Arg turns an optional amount filter into a query argument, one that isn't set stays invalid
+func int8Arg(value *int64) pgtype.Int8 {
+	if value == nil {
+		return pgtype.Int8{}
+	}
+	return pgtype.Int8{Int64: *value, Valid: true}
+}
+
+// validatePage checks the page size, page token and creation date range every keyset paginated list takes
+func validatePage(pageSize int32, pageToken string, createdFrom *timestamppb.Timestamp, createdTo *timestamppb.Timestamp) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePageSize(pageSize); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	if err := val.ValidatePageToken(pageToken); err != nil {
+		violations = append(violations, fieldViolation("page_token", err))
+	}
+
+	if err := val.ValidateTimeRange(optionalTime(createdFrom), optionalTime(createdTo)); err != nil {
+		violations = append(violations, fieldViolation("created_to", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,104 @@
+package gapi
+
+import (
+	"goBank/pb"
+	"goBank/util"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+)
+
+// methodPolicy says who may call a method
+type methodPolicy struct {
+	// Public methods are called without an access token
+	public bool
+	// Roles allowed to call the method, any authenticated user when empty
+	roles []string
+}
+
+var (
+	publicMethod        = methodPolicy{public: true}
+	authenticatedMethod = methodPolicy{}
+	bankerMethod        = methodPolicy{roles: []string{util.BankerRole}}
+)
+
+// methodPolicies is checked by the auth interceptor before a method is called.
+// A method missing from it needs an access token with any role, so a new RPC is never public by accident.
+var methodPolicies = map[string]methodPolicy{
+	pb.SimpleBank_CreateUser_FullMethodName:  publicMethod,
+	pb.SimpleBank_Login_FullMethodName:       publicMethod,
+	pb.SimpleBank_VerifyEmail_FullMethodName: publicMethod,
+	// the refresh token in the request is the proof of who's calling
+	pb.SimpleBank_RenewAccessToken_FullMethodName: publicMethod,
+	pb.SimpleBank_Logout_FullMethodName:           publicMethod,
+	// the currency registry is the same for everyone
+	pb.SimpleBank_ListCurrencies_FullMethodName: publicMethod,
+	// the payment processor calls it, the request is signed with the settlement callback secret instead
+	pb.SimpleBank_SettleWithdrawal_FullMethodName: publicMethod,
+
+	pb.SimpleBank_UpdateUser_FullMethodName:            authenticatedMethod,
+	pb.SimpleBank_ListSessions_FullMethodName:          authenticatedMethod,
+	pb.SimpleBank_RevokeSession_FullMethodName:         authenticatedMethod,
+	pb.SimpleBank_RevokeAllSessions_FullMethodName:     authenticatedMethod,
+	pb.SimpleBank_CreateAccount_FullMethodName:         authenticatedMethod,
+	pb.SimpleBank_GetAccount_FullMethodName:            authenticatedMethod,
+	pb.SimpleBank_ListAccounts_FullMethodName:          authenticatedMethod,
+	pb.SimpleBank_CreateTransfer_FullMethodName:        authenticatedMethod,
+	pb.SimpleBank_GetTransfer_FullMethodName:           authenticatedMethod,
+	pb.SimpleBank_ListTransfers_FullMethodName:         authenticatedMethod,
+	pb.SimpleBank_SearchTransfers_FullMethodName:       authenticatedMethod,
+	pb.SimpleBank_CreateBatchTransfer_FullMethodName:   authenticatedMethod,
+	pb.SimpleBank_ListEntries_FullMethodName:           authenticatedMethod,
+	pb.SimpleBank_CreateExchangeQuote_FullMethodName:   authenticatedMethod,
+	pb.SimpleBank_AuthorizeHold_FullMethodName:         authenticatedMethod,
+	pb.SimpleBank_CaptureHold_FullMethodName:           authenticatedMethod,
+	pb.SimpleBank_VoidHold_FullMethodName:              authenticatedMethod,
+	pb.SimpleBank_CreateStandingOrder_FullMethodName:   authenticatedMethod,
+	pb.SimpleBank_GetStandingOrder_FullMethodName:      authenticatedMethod,
+	pb.SimpleBank_ListStandingOrders_FullMethodName:    authenticatedMethod,
+	pb.SimpleBank_UpdateStandingOrder_FullMethodName:   authenticatedMethod,
+	pb.SimpleBank_CancelStandingOrder_FullMethodName:   authenticatedMethod,
+	pb.SimpleBank_ListStandingOrderRuns_FullMethodName: authenticatedMethod,
+	pb.SimpleBank_GetFeeSchedule_FullMethodName:        authenticatedMethod,
+	pb.SimpleBank_ListInterestRates_FullMethodName:     authenticatedMethod,
+	pb.SimpleBank_ListInterestAccruals_FullMethodName:  authenticatedMethod,
+	pb.SimpleBank_GetTransferLimits_FullMethodName:     authenticatedMethod,
+	pb.SimpleBank_GetStatement_FullMethodName:          authenticatedMethod,
+	pb.SimpleBank_GetBalanceAt_FullMethodName:          authenticatedMethod,
+	pb.SimpleBank_GetBalanceHistory_FullMethodName:     authenticatedMethod,
+	pb.SimpleBank_CreateWithdrawal_FullMethodName:      authenticatedMethod,
+	pb.SimpleBank_GetWithdrawal_FullMethodName:         authenticatedMethod,
+
+	pb.SimpleBank_CreateExchangeRate_FullMethodName:       bankerMethod,
+	pb.SimpleBank_ReverseTransfer_FullMethodName:          bankerMethod,
+	pb.SimpleBank_ApprovePendingTransfer_FullMethodName:   bankerMethod,
+	pb.SimpleBank_RejectPendingTransfer_FullMethodName:    bankerMethod,
+	pb.SimpleBank_ListPendingTransfers_FullMethodName:     bankerMethod,
+	pb.SimpleBank_ReconcileLedger_FullMethodName:          bankerMethod,
+	pb.SimpleBank_GetReconciliationReport_FullMethodName:  bankerMethod,
+	pb.SimpleBank_FreezeAccount_FullMethodName:            bankerMethod,
+	pb.SimpleBank_UnfreezeAccount_FullMethodName:          bankerMethod,
+	pb.SimpleBank_CloseAccount_FullMethodName:             bankerMethod,
+	pb.SimpleBank_UpdateFeeSchedule_FullMethodName:        bankerMethod,
+	pb.SimpleBank_SetInterestRate_FullMethodName:          bankerMethod,
+	pb.SimpleBank_SetRoleTransferLimits_FullMethodName:    bankerMethod,
+	pb.SimpleBank_SetAccountTransferLimits_FullMethodName: bankerMethod,
+	pb.SimpleBank_CreateDeposit_FullMethodName:            bankerMethod,
+
+	// lets clients like evans list the services
+	grpc_reflection_v1.ServerReflection_ServerReflectionInfo_FullMethodName:      publicMethod,
+	grpc_reflection_v1alpha.ServerReflection_ServerReflectionInfo_FullMethodName: publicMethod,
+}
+
+func policyForMethod(method string) methodPolicy {
+	if policy, ok := methodPolicies[method]; ok {
+		return policy
+	}
+	return authenticatedMethod
+}
+
+func (policy methodPolicy) allows(role string) bool {
+	if len(policy.roles) == 0 {
+		return util.IsSupportedRole(role)
+	}
+	return hasPermission(role, policy.roles)
+}
@@ -0,0 +1,61 @@
+package gapi
+
+import (
+	"context"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/util"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) ApprovePendingTransfer(ctx context.Context, req *pb.ApprovePendingTransferRequest) (*pb.ApprovePendingTransferResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateApprovePendingTransferRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.ReviewPendingTransferTxParams{
+		PendingTransferID: req.GetPendingTransferId(),
+		Reviewer:          authPayload.Username,
+	}
+
+	result, err := server.store.ApprovePendingTransferTx(ctx, arg)
+	if err != nil {
+		return nil, transferTxError(err)
+	}
+
+	rsp := &pb.ApprovePendingTransferResponse{
+		PendingTransfer: convertPendingTransfer(result.PendingTransfer),
+		Transfer:        convertTransfer(result.Transfer),
+		FromAccount:     convertAccount(result.FromAccount),
+		ToAccount:       convertAccount(result.ToAccount),
+		FromEntry:       convertEntry(result.FromEntry),
+		ToEntry:         convertEntry(result.ToEntry),
+		GrossAmount:     result.Transfer.Amount,
+		Fee:             result.Transfer.Fee,
+		NetAmount:       result.Transfer.Amount - result.Transfer.Fee,
+	}
+	if result.Transfer.Fee > 0 {
+		rsp.FeeEntry = convertEntry(result.FeeEntry)
+	}
+
+	currency, _ := util.LookupCurrency(result.FromAccount.Currency)
+	rsp.GrossAmountDecimal = currency.FormatAmount(rsp.GrossAmount)
+	rsp.FeeDecimal = currency.FormatAmount(rsp.Fee)
+	rsp.NetAmountDecimal = currency.FormatAmount(rsp.NetAmount)
+	return rsp, nil
+}
+
+func validateApprovePendingTransferRequest(req *pb.ApprovePendingTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetPendingTransferId()); err != nil {
+		violations = append(violations, fieldViolation("pending_transfer_id", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,72 @@
+package gapi
+
+import (
+	"context"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+func (server *Server) AuthorizeHold(ctx context.Context, req *pb.AuthorizeHoldRequest) (*pb.AuthorizeHoldResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateAuthorizeHoldRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.validAccount(ctx, req.GetAccountId(), req.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
+
+	if account.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "account doesn't belong to the authenticated user")
+	}
+
+	//Capturing a hold moves money without a banker's review, so amounts that need approval are sent as a single transfer
+	if server.config.RequiresApproval(req.GetCurrency(), req.GetAmount()) {
+		return nil, status.Errorf(codes.FailedPrecondition, "amount needs approval and must be sent as a single transfer")
+	}
+
+	arg := db.AuthorizeHoldTxParams{
+		AccountID: req.GetAccountId(),
+		Amount:    req.GetAmount(),
+		ExpiresAt: time.Now().Add(server.config.HoldDuration),
+		Role:      authPayload.Role,
+	}
+
+	result, err := server.store.AuthorizeHoldTx(ctx, arg)
+	if err != nil {
+		return nil, transferTxError(err)
+	}
+
+	rsp := &pb.AuthorizeHoldResponse{
+		Hold:    convertHold(result.Hold),
+		Account: convertAccount(result.Account),
+	}
+	return rsp, nil
+}
+
+func validateAuthorizeHoldRequest(req *pb.AuthorizeHoldRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	if err := val.ValidateAmount(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	}
+
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,82 @@
+package gapi
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "goBank/db/mock"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+func TestAuthorizeHoldAPI(t *testing.T) {
+	user, _ := randomUser(t)
+	account := randomAccount(user.Username)
+	account.Currency = util.USD
+
+	testCases := []struct {
+		name          string
+		req           *pb.AuthorizeHoldRequest
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.AuthorizeHoldResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.AuthorizeHoldRequest{
+				AccountId: account.ID,
+				Amount:    1000,
+				Currency:  util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					AuthorizeHoldTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.HoldTxResult{Hold: db.Hold{AccountID: account.ID, Amount: 1000}, Account: account}, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.AuthorizeHoldResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int64(1000), res.GetHold().GetAmount())
+			},
+		},
+		{
+			name: "NeedsApproval",
+			req: &pb.AuthorizeHoldRequest{
+				AccountId: account.ID,
+				Amount:    1001,
+				Currency:  util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().AuthorizeHoldTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.AuthorizeHoldResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := newContextWithBearerToken(t, server.tokenMaker, user.Username, user.Role, time.Minute)
+			res, err := callRPC(ctx, server, pb.SimpleBank_AuthorizeHold_FullMethodName, tc.req, server.AuthorizeHold)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package gapi
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CancelStandingOrder(ctx context.Context, req *pb.CancelStandingOrderRequest) (*pb.CancelStandingOrderResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCancelStandingOrderRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	order, err := server.getOwnedStandingOrder(ctx, req.GetId(), authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	//Cancelled orders are kept so their run history stays around
+	order, err = server.store.UpdateStandingOrder(ctx, db.UpdateStandingOrderParams{
+		ID:       order.ID,
+		IsActive: pgtype.Bool{Bool: false, Valid: true},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to cancel standing order: %s", err)
+	}
+
+	rsp := &pb.CancelStandingOrderResponse{
+		StandingOrder: convertStandingOrder(order),
+	}
+	return rsp, nil
+}
+
+func validateCancelStandingOrderRequest(req *pb.CancelStandingOrderRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
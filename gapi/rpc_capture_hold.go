@@ -0,0 +1,88 @@
+package gapi
+
+import (
+	"context"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/util"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CaptureHold(ctx context.Context, req *pb.CaptureHoldRequest) (*pb.CaptureHoldResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCaptureHoldRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	_, fromAccount, err := server.getOwnedHold(ctx, req.GetHoldId(), authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = server.validAccount(ctx, req.GetToAccountId(), fromAccount.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	//The threshold may have been lowered since the hold was authorized
+	if server.config.RequiresApproval(fromAccount.Currency, req.GetAmount()) {
+		return nil, status.Errorf(codes.FailedPrecondition, "amount needs approval and must be sent as a single transfer")
+	}
+
+	arg := db.CaptureHoldTxParams{
+		HoldID:      req.GetHoldId(),
+		ToAccountID: req.GetToAccountId(),
+		Amount:      req.GetAmount(),
+		Role:        authPayload.Role,
+	}
+
+	result, err := server.store.CaptureHoldTx(ctx, arg)
+	if err != nil {
+		return nil, transferTxError(err)
+	}
+
+	rsp := &pb.CaptureHoldResponse{
+		Hold:        convertHold(result.Hold),
+		Transfer:    convertTransfer(result.Transfer),
+		FromAccount: convertAccount(result.FromAccount),
+		ToAccount:   convertAccount(result.ToAccount),
+		FromEntry:   convertEntry(result.FromEntry),
+		ToEntry:     convertEntry(result.ToEntry),
+		GrossAmount: result.Transfer.Amount,
+		Fee:         result.Transfer.Fee,
+		NetAmount:   result.Transfer.Amount - result.Transfer.Fee,
+	}
+	if result.Transfer.Fee > 0 {
+		rsp.FeeEntry = convertEntry(result.FeeEntry)
+	}
+
+	currency, _ := util.LookupCurrency(result.FromAccount.Currency)
+	rsp.GrossAmountDecimal = currency.FormatAmount(rsp.GrossAmount)
+	rsp.FeeDecimal = currency.FormatAmount(rsp.Fee)
+	rsp.NetAmountDecimal = currency.FormatAmount(rsp.NetAmount)
+	return rsp, nil
+}
+
+func validateCaptureHoldRequest(req *pb.CaptureHoldRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetHoldId()); err != nil {
+		violations = append(violations, fieldViolation("hold_id", err))
+	}
+
+	if err := val.ValidateID(req.GetToAccountId()); err != nil {
+		violations = append(violations, fieldViolation("to_account_id", err))
+	}
+
+	if err := val.ValidateAmount(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,217 @@
+package gapi
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "goBank/db/mock"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/token"
+	"goBank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+func TestCaptureHoldAPI(t *testing.T) {
+	user1, _ := randomUser(t)
+	user2, _ := randomUser(t)
+
+	account1 := randomAccount(user1.Username)
+	account2 := randomAccount(user2.Username)
+	account1.Currency = util.USD
+	account2.Currency = util.USD
+
+	hold := db.Hold{
+		ID:        util.RandomInt(1, 1000),
+		AccountID: account1.ID,
+		Amount:    100,
+		Status:    db.HoldStatusAuthorized,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.CaptureHoldRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.CaptureHoldResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.CaptureHoldRequest{
+				HoldId:      hold.ID,
+				ToAccountId: account2.ID,
+				Amount:      60,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetHold(gomock.Any(), gomock.Eq(hold.ID)).Times(1).Return(hold, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+
+				arg := db.CaptureHoldTxParams{
+					HoldID:      hold.ID,
+					ToAccountID: account2.ID,
+					Amount:      60,
+					Role:        user1.Role,
+				}
+				store.EXPECT().
+					CaptureHoldTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.CaptureHoldTxResult{
+						Hold: hold,
+						TransferTxResult: db.TransferTxResult{
+							Transfer:    db.Transfer{FromAccountID: account1.ID, ToAccountID: account2.ID, Amount: 60, ToAmount: 59, Fee: 1},
+							FromAccount: account1,
+							ToAccount:   account2,
+							FeeEntry:    db.Entry{Amount: 1},
+						},
+					}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CaptureHoldResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, hold.ID, res.GetHold().GetId())
+				require.Equal(t, int64(60), res.GetGrossAmount())
+				require.Equal(t, int64(1), res.GetFee())
+				require.Equal(t, int64(59), res.GetNetAmount())
+				require.Equal(t, int64(1), res.GetFeeEntry().GetAmount())
+				require.Equal(t, "0.59", res.GetNetAmountDecimal())
+			},
+		},
+		{
+			name: "NotOwner",
+			req: &pb.CaptureHoldRequest{
+				HoldId:      hold.ID,
+				ToAccountId: account2.ID,
+				Amount:      60,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetHold(gomock.Any(), gomock.Eq(hold.ID)).Times(1).Return(hold, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().CaptureHoldTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user2.Username, user2.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CaptureHoldResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "HoldNotFound",
+			req: &pb.CaptureHoldRequest{
+				HoldId:      hold.ID,
+				ToAccountId: account2.ID,
+				Amount:      60,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetHold(gomock.Any(), gomock.Eq(hold.ID)).Times(1).Return(db.Hold{}, db.ErrRecordNotFound)
+				store.EXPECT().CaptureHoldTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CaptureHoldResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+		{
+			name: "NeedsApproval",
+			req: &pb.CaptureHoldRequest{
+				HoldId:      hold.ID,
+				ToAccountId: account2.ID,
+				Amount:      1001,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetHold(gomock.Any(), gomock.Eq(hold.ID)).Times(1).Return(hold, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().CaptureHoldTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CaptureHoldResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "CaptureExceedsHold",
+			req: &pb.CaptureHoldRequest{
+				HoldId:      hold.ID,
+				ToAccountId: account2.ID,
+				Amount:      101,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetHold(gomock.Any(), gomock.Eq(hold.ID)).Times(1).Return(hold, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().
+					CaptureHoldTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CaptureHoldTxResult{}, db.ErrCaptureExceedsHold)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CaptureHoldResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "InvalidAmount",
+			req: &pb.CaptureHoldRequest{
+				HoldId:      hold.ID,
+				ToAccountId: account2.ID,
+				Amount:      0,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetHold(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CaptureHoldTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CaptureHoldResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := callRPC(ctx, server, pb.SimpleBank_CaptureHold_FullMethodName, tc.req, server.CaptureHold)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package gapi
+
+import (
+	"context"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) CloseAccount(ctx context.Context, req *pb.CloseAccountRequest) (*pb.CloseAccountResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCloseAccountRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.changeAccountStatus(ctx, req.GetAccountId(), db.AccountStatusClosed, authPayload.Username, req.GetReason())
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.CloseAccountResponse{
+		Account: convertAccount(account),
+	}
+	return rsp, nil
+}
+
+func validateCloseAccountRequest(req *pb.CloseAccountRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	if err := val.ValidateReason(req.GetReason()); err != nil {
+		violations = append(violations, fieldViolation("reason", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,61 @@
+package gapi
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateAccount(ctx context.Context, req *pb.CreateAccountRequest) (*pb.CreateAccountResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCreateAccountRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.CreateAccountParams{
+		Owner:    authPayload.Username,
+		Currency: req.GetCurrency(),
+		Balance:  0,
+		Product:  pgtype.Text{String: req.GetProduct(), Valid: req.Product != nil},
+	}
+
+	account, err := server.store.CreateAccount(ctx, arg)
+	if err != nil {
+		switch db.ErrorCode(err) {
+		case db.UniqueViolation:
+			return nil, status.Errorf(codes.AlreadyExists, "account already exists: %s", err)
+		case db.ForeignKeyViolation:
+			return nil, status.Errorf(codes.PermissionDenied, "cannot create account: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create account: %s", err)
+	}
+
+	rsp := &pb.CreateAccountResponse{
+		Account: convertAccount(account),
+	}
+	return rsp, nil
+}
+
+func validateCreateAccountRequest(req *pb.CreateAccountRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+
+	if req.Product != nil {
+		if err := val.ValidateProduct(req.GetProduct()); err != nil {
+			violations = append(violations, fieldViolation("product", err))
+		}
+	}
+
+	return violations
+}
@@ -0,0 +1,117 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/util"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+func (server *Server) CreateBatchTransfer(ctx context.Context, req *pb.CreateBatchTransferRequest) (*pb.CreateBatchTransferResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mtdt := server.extractMetadata(ctx)
+
+	legs, violations := validateCreateBatchTransferRequest(req, mtdt)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	fromAccount, err := server.validAccount(ctx, req.GetFromAccountId(), req.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
+
+	if fromAccount.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "from account doesn't belong to the authenticated user")
+	}
+
+	//A pending transfer pays a single account, so payments that need a banker's approval are sent on their own.
+	//Legs to the same account add up, splitting a payment mustn't get it under the threshold.
+	totals := make(map[int64]int64, len(legs))
+	for _, leg := range legs {
+		totals[leg.ToAccountID] += leg.Amount
+		if server.config.RequiresApproval(req.GetCurrency(), totals[leg.ToAccountID]) {
+			return nil, status.Errorf(codes.FailedPrecondition, "payment to account %d needs approval and must be sent as a single transfer", leg.ToAccountID)
+		}
+	}
+
+	result, err := server.store.BatchTransferTx(ctx, db.BatchTransferTxParams{
+		FromAccountID:  req.GetFromAccountId(),
+		Legs:           legs,
+		Role:           authPayload.Role,
+		Username:       authPayload.Username,
+		IdempotencyKey: mtdt.IdempotencyKey,
+	})
+	if err != nil {
+		return nil, transferTxError(err)
+	}
+
+	rsp := &pb.CreateBatchTransferResponse{
+		FromAccount:    convertAccount(result.FromAccount),
+		TotalAmount:    result.TotalAmount,
+		TotalFee:       result.TotalFee,
+		TotalNetAmount: result.TotalAmount - result.TotalFee,
+	}
+	for _, transfer := range result.Transfers {
+		rsp.Transfers = append(rsp.Transfers, convertTransfer(transfer.Transfer))
+	}
+	return rsp, nil
+}
+
+// validateCreateBatchTransferRequest also returns the legs of the batch, read from the CSV when that's how they were sent
+func validateCreateBatchTransferRequest(req *pb.CreateBatchTransferRequest, mtdt *Metadata) (legs []db.BatchTransferLeg, violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetFromAccountId()); err != nil {
+		violations = append(violations, fieldViolation("from_account_id", err))
+	}
+
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+
+	field := "legs"
+	switch {
+	case len(req.GetLegs()) > 0 && req.GetCsv() != "":
+		violations = append(violations, fieldViolation("csv", fmt.Errorf("can't be set together with legs")))
+	case req.GetCsv() != "":
+		field = "csv"
+		payments, err := util.ParseBatchCSV(strings.NewReader(req.GetCsv()))
+		if err != nil {
+			violations = append(violations, fieldViolation(field, err))
+		}
+		for _, payment := range payments {
+			legs = append(legs, db.BatchTransferLeg{ToAccountID: payment.ToAccountID, Amount: payment.Amount})
+		}
+	default:
+		for _, leg := range req.GetLegs() {
+			legs = append(legs, db.BatchTransferLeg{ToAccountID: leg.GetToAccountId(), Amount: leg.GetAmount()})
+		}
+	}
+
+	if err := val.ValidateBatchSize(len(legs)); err != nil {
+		violations = append(violations, fieldViolation(field, err))
+	}
+
+	for i, leg := range legs {
+		if err := val.ValidateID(leg.ToAccountID); err != nil {
+			violations = append(violations, fieldViolation(fmt.Sprintf("%s[%d].to_account_id", field, i), err))
+		}
+
+		if err := val.ValidateAmount(leg.Amount); err != nil {
+			violations = append(violations, fieldViolation(fmt.Sprintf("%s[%d].amount", field, i), err))
+		}
+	}
+
+	violations = append(violations, validateIdempotencyKey(mtdt)...)
+
+	return legs, violations
+}
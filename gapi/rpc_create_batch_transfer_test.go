@@ -0,0 +1,231 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "goBank/db/mock"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/token"
+	"goBank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+func TestCreateBatchTransferAPI(t *testing.T) {
+	user1, _ := randomUser(t)
+	user2, _ := randomUser(t)
+
+	account1 := randomAccount(user1.Username)
+	account1.Currency = util.USD
+
+	legs := []db.BatchTransferLeg{
+		{ToAccountID: account1.ID + 1, Amount: 10},
+		{ToAccountID: account1.ID + 2, Amount: 20},
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.CreateBatchTransferRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.CreateBatchTransferResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.CreateBatchTransferRequest{
+				FromAccountId: account1.ID,
+				Currency:      util.USD,
+				Legs: []*pb.BatchTransferLeg{
+					{ToAccountId: legs[0].ToAccountID, Amount: legs[0].Amount},
+					{ToAccountId: legs[1].ToAccountID, Amount: legs[1].Amount},
+				},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+
+				arg := db.BatchTransferTxParams{
+					FromAccountID: account1.ID,
+					Legs:          legs,
+					Role:          user1.Role,
+					Username:      user1.Username,
+				}
+				store.EXPECT().
+					BatchTransferTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.BatchTransferTxResult{
+						FromAccount: account1,
+						Transfers:   []db.TransferTxResult{{}, {}},
+						TotalAmount: 30,
+						TotalFee:    2,
+					}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateBatchTransferResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetTransfers(), 2)
+				require.Equal(t, int64(30), res.GetTotalAmount())
+				require.Equal(t, int64(2), res.GetTotalFee())
+				require.Equal(t, int64(28), res.GetTotalNetAmount())
+			},
+		},
+		{
+			name: "CSV",
+			req: &pb.CreateBatchTransferRequest{
+				FromAccountId: account1.ID,
+				Currency:      util.USD,
+				Csv:           fmt.Sprintf("to_account_id,amount\n%d,10\n%d,20\n", legs[0].ToAccountID, legs[1].ToAccountID),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+
+				arg := db.BatchTransferTxParams{
+					FromAccountID: account1.ID,
+					Legs:          legs,
+					Role:          user1.Role,
+					Username:      user1.Username,
+				}
+				store.EXPECT().BatchTransferTx(gomock.Any(), gomock.Eq(arg)).Times(1)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateBatchTransferResponse, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "LegsAndCSV",
+			req: &pb.CreateBatchTransferRequest{
+				FromAccountId: account1.ID,
+				Currency:      util.USD,
+				Legs:          []*pb.BatchTransferLeg{{ToAccountId: legs[0].ToAccountID, Amount: 10}},
+				Csv:           fmt.Sprintf("%d,20", legs[1].ToAccountID),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().BatchTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateBatchTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "EmptyBatch",
+			req: &pb.CreateBatchTransferRequest{
+				FromAccountId: account1.ID,
+				Currency:      util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().BatchTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateBatchTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "NotOwner",
+			req: &pb.CreateBatchTransferRequest{
+				FromAccountId: account1.ID,
+				Currency:      util.USD,
+				Legs:          []*pb.BatchTransferLeg{{ToAccountId: legs[0].ToAccountID, Amount: 10}},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().BatchTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user2.Username, user2.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateBatchTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "SplitPaymentNeedsApproval",
+			req: &pb.CreateBatchTransferRequest{
+				FromAccountId: account1.ID,
+				Currency:      util.USD,
+				Legs: []*pb.BatchTransferLeg{
+					{ToAccountId: legs[0].ToAccountID, Amount: 600},
+					{ToAccountId: legs[1].ToAccountID, Amount: 20},
+					{ToAccountId: legs[0].ToAccountID, Amount: 600},
+				},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().BatchTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateBatchTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "LegFrozen",
+			req: &pb.CreateBatchTransferRequest{
+				FromAccountId: account1.ID,
+				Currency:      util.USD,
+				Legs:          []*pb.BatchTransferLeg{{ToAccountId: legs[0].ToAccountID, Amount: 10}},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().
+					BatchTransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.BatchTransferTxResult{}, fmt.Errorf("leg 0: %w", db.ErrAccountFrozen))
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateBatchTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := callRPC(ctx, server, pb.SimpleBank_CreateBatchTransfer_FullMethodName, tc.req, server.CreateBatchTransfer)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
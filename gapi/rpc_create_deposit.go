@@ -0,0 +1,72 @@
+package gapi
+
+import (
+	"context"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateDeposit lets a banker credit funds taken in at the counter, nobody else can bring money into the bank
+func (server *Server) CreateDeposit(ctx context.Context, req *pb.CreateDepositRequest) (*pb.CreateDepositResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mtdt := server.extractMetadata(ctx)
+
+	violations := validateCreateDepositRequest(req, mtdt)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccount(ctx, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	if account.Kind != db.AccountKindCustomer {
+		return nil, status.Errorf(codes.FailedPrecondition, "account [%d] is one of the bank's own accounts", account.ID)
+	}
+
+	result, err := server.store.DepositTx(ctx, db.DepositTxParams{
+		AccountID:      account.ID,
+		Amount:         req.GetAmount(),
+		DepositedBy:    authPayload.Username,
+		Reference:      req.GetReference(),
+		IdempotencyKey: mtdt.IdempotencyKey,
+	})
+	if err != nil {
+		return nil, transferTxError(err)
+	}
+
+	rsp := &pb.CreateDepositResponse{
+		Deposit: convertDeposit(result.Deposit, account.Currency),
+		Account: convertAccount(result.ToAccount),
+	}
+	return rsp, nil
+}
+
+func validateCreateDepositRequest(req *pb.CreateDepositRequest, mtdt *Metadata) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	if err := val.ValidateAmount(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	}
+
+	if req.GetReference() != "" {
+		if err := val.ValidateExternalReference(req.GetReference()); err != nil {
+			violations = append(violations, fieldViolation("reference", err))
+		}
+	}
+
+	violations = append(violations, validateIdempotencyKey(mtdt)...)
+
+	return violations
+}
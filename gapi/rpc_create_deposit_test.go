@@ -0,0 +1,142 @@
+package gapi
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "goBank/db/mock"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/token"
+	"goBank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+func TestCreateDepositAPI(t *testing.T) {
+	user, _ := randomUser(t)
+	banker, _ := randomUser(t)
+	banker.Role = util.BankerRole
+	account := randomAccount(user.Username)
+	account.Kind = db.AccountKindCustomer
+	amount := int64(2500)
+
+	credited := account
+	credited.Balance += amount
+
+	testCases := []struct {
+		name          string
+		req           *pb.CreateDepositRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.CreateDepositResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.CreateDepositRequest{AccountId: account.ID, Amount: amount, Reference: "slip-7"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+
+				arg := db.DepositTxParams{
+					AccountID:   account.ID,
+					Amount:      amount,
+					DepositedBy: banker.Username,
+					Reference:   "slip-7",
+				}
+				store.EXPECT().
+					DepositTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.DepositTxResult{
+						Deposit: db.Deposit{
+							ID:          1,
+							AccountID:   account.ID,
+							Amount:      amount,
+							DepositedBy: banker.Username,
+							Reference:   "slip-7",
+						},
+						TransferTxResult: db.TransferTxResult{ToAccount: credited},
+					}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateDepositResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, amount, res.GetDeposit().GetAmount())
+				require.Equal(t, banker.Username, res.GetDeposit().GetDepositedBy())
+				require.Equal(t, credited.Balance, res.GetAccount().GetBalance())
+			},
+		},
+		{
+			name: "DepositorNotAllowed",
+			req:  &pb.CreateDepositRequest{AccountId: account.ID, Amount: amount},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().DepositTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateDepositResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "SystemAccount",
+			req:  &pb.CreateDepositRequest{AccountId: account.ID, Amount: amount},
+			buildStubs: func(store *mockdb.MockStore) {
+				cash := account
+				cash.Kind = db.AccountKindCash
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(cash, nil)
+				store.EXPECT().DepositTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateDepositResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "InvalidAmount",
+			req:  &pb.CreateDepositRequest{AccountId: account.ID, Amount: -1},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().DepositTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateDepositResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := callRPC(ctx, server, pb.SimpleBank_CreateDeposit_FullMethodName, tc.req, server.CreateDeposit)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
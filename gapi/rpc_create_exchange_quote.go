@@ -0,0 +1,75 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+var errSameCurrency = errors.New("must differ from from_currency")
+
+func (server *Server) CreateExchangeQuote(ctx context.Context, req *pb.CreateExchangeQuoteRequest) (*pb.CreateExchangeQuoteResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCreateExchangeQuoteRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	rate, err := server.store.GetLatestExchangeRate(ctx, db.GetLatestExchangeRateParams{
+		FromCurrency: req.GetFromCurrency(),
+		ToCurrency:   req.GetToCurrency(),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no exchange rate from %s to %s", req.GetFromCurrency(), req.GetToCurrency())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get exchange rate: %s", err)
+	}
+
+	//The quote locks the rate for the user until it expires or is used by a transfer
+	arg := db.CreateExchangeQuoteParams{
+		ID:           uuid.New(),
+		Username:     authPayload.Username,
+		FromCurrency: rate.FromCurrency,
+		ToCurrency:   rate.ToCurrency,
+		Rate:         rate.Rate,
+		ExpiresAt:    time.Now().Add(server.config.ExchangeQuoteDuration),
+	}
+
+	quote, err := server.store.CreateExchangeQuote(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create exchange quote: %s", err)
+	}
+
+	rsp := &pb.CreateExchangeQuoteResponse{
+		Quote: convertExchangeQuote(quote),
+	}
+	return rsp, nil
+}
+
+func validateCreateExchangeQuoteRequest(req *pb.CreateExchangeQuoteRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateCurrency(req.GetFromCurrency()); err != nil {
+		violations = append(violations, fieldViolation("from_currency", err))
+	}
+
+	if err := val.ValidateCurrency(req.GetToCurrency()); err != nil {
+		violations = append(violations, fieldViolation("to_currency", err))
+	}
+
+	if req.GetFromCurrency() == req.GetToCurrency() {
+		violations = append(violations, fieldViolation("to_currency", errSameCurrency))
+	}
+
+	return violations
+}
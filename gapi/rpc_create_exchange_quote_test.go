@@ -0,0 +1,191 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "goBank/db/mock"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/token"
+	"goBank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+func TestCreateExchangeQuoteAPI(t *testing.T) {
+	user, _ := randomUser(t)
+
+	rate := db.ExchangeRate{
+		ID:           util.RandomInt(1, 1000),
+		FromCurrency: util.USD,
+		ToCurrency:   util.EUR,
+		Rate:         util.RandomInt(db.ExchangeRateScale/2, db.ExchangeRateScale*2),
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.CreateExchangeQuoteRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.CreateExchangeQuoteResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.CreateExchangeQuoteRequest{
+				FromCurrency: util.USD,
+				ToCurrency:   util.EUR,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetLatestExchangeRate(gomock.Any(), gomock.Eq(db.GetLatestExchangeRateParams{
+						FromCurrency: util.USD,
+						ToCurrency:   util.EUR,
+					})).
+					Times(1).
+					Return(rate, nil)
+				store.EXPECT().
+					CreateExchangeQuote(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.CreateExchangeQuoteParams) (db.ExchangeQuote, error) {
+						require.Equal(t, user.Username, arg.Username)
+						require.Equal(t, rate.Rate, arg.Rate)
+						require.WithinDuration(t, time.Now().Add(time.Minute), arg.ExpiresAt, time.Second)
+						return db.ExchangeQuote{
+							ID:           arg.ID,
+							Username:     arg.Username,
+							FromCurrency: arg.FromCurrency,
+							ToCurrency:   arg.ToCurrency,
+							Rate:         arg.Rate,
+							ExpiresAt:    arg.ExpiresAt,
+						}, nil
+					})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateExchangeQuoteResponse, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				quote := res.GetQuote()
+				require.NotEmpty(t, quote.Id)
+				require.Equal(t, rate.Rate, quote.Rate)
+				require.Equal(t, util.USD, quote.FromCurrency)
+				require.Equal(t, util.EUR, quote.ToCurrency)
+			},
+		},
+		{
+			name: "RateNotFound",
+			req: &pb.CreateExchangeQuoteRequest{
+				FromCurrency: util.USD,
+				ToCurrency:   util.EUR,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetLatestExchangeRate(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ExchangeRate{}, db.ErrRecordNotFound)
+				store.EXPECT().
+					CreateExchangeQuote(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateExchangeQuoteResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+		{
+			name: "InternalError",
+			req: &pb.CreateExchangeQuoteRequest{
+				FromCurrency: util.USD,
+				ToCurrency:   util.EUR,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetLatestExchangeRate(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(rate, nil)
+				store.EXPECT().
+					CreateExchangeQuote(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ExchangeQuote{}, sql.ErrConnDone)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateExchangeQuoteResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Internal, st.Code())
+			},
+		},
+		{
+			name: "SameCurrency",
+			req: &pb.CreateExchangeQuoteRequest{
+				FromCurrency: util.USD,
+				ToCurrency:   util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetLatestExchangeRate(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateExchangeQuoteResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req: &pb.CreateExchangeQuoteRequest{
+				FromCurrency: util.USD,
+				ToCurrency:   util.EUR,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetLatestExchangeRate(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateExchangeQuoteResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := callRPC(ctx, server, pb.SimpleBank_CreateExchangeQuote_FullMethodName, tc.req, server.CreateExchangeQuote)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package gapi
+
+import (
+	"context"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateExchangeRate(ctx context.Context, req *pb.CreateExchangeRateRequest) (*pb.CreateExchangeRateResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCreateExchangeRateRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.CreateExchangeRateParams{
+		FromCurrency: req.GetFromCurrency(),
+		ToCurrency:   req.GetToCurrency(),
+		Rate:         req.GetRate(),
+	}
+
+	rate, err := server.store.CreateExchangeRate(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create exchange rate: %s", err)
+	}
+
+	rsp := &pb.CreateExchangeRateResponse{
+		ExchangeRate: convertExchangeRate(rate),
+	}
+	return rsp, nil
+}
+
+func validateCreateExchangeRateRequest(req *pb.CreateExchangeRateRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateCurrency(req.GetFromCurrency()); err != nil {
+		violations = append(violations, fieldViolation("from_currency", err))
+	}
+
+	if err := val.ValidateCurrency(req.GetToCurrency()); err != nil {
+		violations = append(violations, fieldViolation("to_currency", err))
+	}
+
+	if req.GetFromCurrency() == req.GetToCurrency() {
+		violations = append(violations, fieldViolation("to_currency", errSameCurrency))
+	}
+
+	if err := val.ValidateExchangeRate(req.GetRate()); err != nil {
+		violations = append(violations, fieldViolation("rate", err))
+	}
+
+	return violations
+}
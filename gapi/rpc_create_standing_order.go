@@ -0,0 +1,103 @@
+package gapi
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/util"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+func (server *Server) CreateStandingOrder(ctx context.Context, req *pb.CreateStandingOrderRequest) (*pb.CreateStandingOrderResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCreateStandingOrderRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	fromAccount, err := server.validAccount(ctx, req.GetFromAccountId(), req.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
+
+	if fromAccount.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "from account doesn't belong to the authenticated user")
+	}
+
+	_, err = server.validAccount(ctx, req.GetToAccountId(), req.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
+
+	//Runs are paid by the worker with no one to review them, so amounts that need approval aren't scheduled
+	if server.config.RequiresApproval(req.GetCurrency(), req.GetAmount()) {
+		return nil, status.Errorf(codes.FailedPrecondition, "amount needs approval and can't be paid by a standing order")
+	}
+
+	nextRunAt, err := util.NextRun(req.GetSchedule(), time.Now())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to schedule first run: %s", err)
+	}
+
+	arg := db.CreateStandingOrderParams{
+		Owner:         authPayload.Username,
+		FromAccountID: req.GetFromAccountId(),
+		ToAccountID:   req.GetToAccountId(),
+		Amount:        req.GetAmount(),
+		Schedule:      req.GetSchedule(),
+		NextRunAt:     nextRunAt,
+		EndAt: pgtype.Timestamptz{
+			Time:  req.GetEndAt().AsTime(),
+			Valid: req.EndAt != nil,
+		},
+	}
+
+	order, err := server.store.CreateStandingOrder(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create standing order: %s", err)
+	}
+
+	rsp := &pb.CreateStandingOrderResponse{
+		StandingOrder: convertStandingOrder(order),
+	}
+	return rsp, nil
+}
+
+func validateCreateStandingOrderRequest(req *pb.CreateStandingOrderRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetFromAccountId()); err != nil {
+		violations = append(violations, fieldViolation("from_account_id", err))
+	}
+
+	if err := val.ValidateID(req.GetToAccountId()); err != nil {
+		violations = append(violations, fieldViolation("to_account_id", err))
+	}
+
+	if err := val.ValidateAmount(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	}
+
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+
+	if err := val.ValidateSchedule(req.GetSchedule()); err != nil {
+		violations = append(violations, fieldViolation("schedule", err))
+	}
+
+	if req.EndAt != nil {
+		if err := val.ValidateFutureTime(req.GetEndAt().AsTime()); err != nil {
+			violations = append(violations, fieldViolation("end_at", err))
+		}
+	}
+
+	return violations
+}
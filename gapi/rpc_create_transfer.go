@@ -0,0 +1,156 @@
+package gapi
+
+import (
+	"context"
+	"github.com/google/uuid"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/token"
+	"goBank/util"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+func (server *Server) CreateTransfer(ctx context.Context, req *pb.CreateTransferRequest) (*pb.CreateTransferResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mtdt := server.extractMetadata(ctx)
+
+	violations := validateCreateTransferRequest(req, mtdt)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	fromAccount, err := server.validAccount(ctx, req.GetFromAccountId(), req.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
+
+	if fromAccount.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "from account doesn't belong to the authenticated user")
+	}
+
+	//Cross-currency transfers only need the destination to exist, the quote pins its currency
+	if req.QuoteId != nil {
+		_, err = server.getAccount(ctx, req.GetToAccountId())
+	} else {
+		_, err = server.validAccount(ctx, req.GetToAccountId(), req.GetCurrency())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if server.config.RequiresApproval(req.GetCurrency(), req.GetAmount()) {
+		return server.createPendingTransfer(ctx, req, authPayload, mtdt)
+	}
+
+	var result db.TransferTxResult
+	if req.QuoteId != nil {
+		result, err = server.store.ExchangeTransferTx(ctx, db.ExchangeTransferTxParams{
+			FromAccountID:   req.GetFromAccountId(),
+			ToAccountID:     req.GetToAccountId(),
+			Amount:          req.GetAmount(),
+			QuoteID:         uuid.MustParse(req.GetQuoteId()),
+			TransferDetails: transferDetailsArg(req),
+			Username:        authPayload.Username,
+			Role:            authPayload.Role,
+			IdempotencyKey:  mtdt.IdempotencyKey,
+		})
+	} else {
+		result, err = server.store.TransferTx(ctx, db.TransferTxParams{
+			FromAccountID:   req.GetFromAccountId(),
+			ToAccountID:     req.GetToAccountId(),
+			Amount:          req.GetAmount(),
+			TransferDetails: transferDetailsArg(req),
+			Role:            authPayload.Role,
+			Username:        authPayload.Username,
+			IdempotencyKey:  mtdt.IdempotencyKey,
+		})
+	}
+	if err != nil {
+		return nil, transferTxError(err)
+	}
+
+	rsp := &pb.CreateTransferResponse{
+		Transfer:    convertTransfer(result.Transfer),
+		FromAccount: convertAccount(result.FromAccount),
+		ToAccount:   convertAccount(result.ToAccount),
+		FromEntry:   convertEntry(result.FromEntry),
+		ToEntry:     convertEntry(result.ToEntry),
+		GrossAmount: result.Transfer.Amount,
+		Fee:         result.Transfer.Fee,
+		NetAmount:   result.Transfer.Amount - result.Transfer.Fee,
+	}
+	if result.Transfer.Fee > 0 {
+		rsp.FeeEntry = convertEntry(result.FeeEntry)
+	}
+
+	currency, _ := util.LookupCurrency(result.FromAccount.Currency)
+	rsp.GrossAmountDecimal = currency.FormatAmount(rsp.GrossAmount)
+	rsp.FeeDecimal = currency.FormatAmount(rsp.Fee)
+	rsp.NetAmountDecimal = currency.FormatAmount(rsp.NetAmount)
+	return rsp, nil
+}
+
+// createPendingTransfer parks a transfer over the approval threshold until a banker other than the initiator reviews it
+func (server *Server) createPendingTransfer(ctx context.Context, req *pb.CreateTransferRequest, initiator *token.Payload, mtdt *Metadata) (*pb.CreateTransferResponse, error) {
+	arg := db.CreatePendingTransferTxParams{
+		FromAccountID:   req.GetFromAccountId(),
+		ToAccountID:     req.GetToAccountId(),
+		Amount:          req.GetAmount(),
+		TransferDetails: transferDetailsArg(req),
+		Initiator:       initiator.Username,
+		InitiatorRole:   initiator.Role,
+		ExpiresAt:       time.Now().Add(server.config.PendingTransferDuration),
+		IdempotencyKey:  mtdt.IdempotencyKey,
+	}
+	if req.QuoteId != nil {
+		arg.QuoteID = uuid.NullUUID{UUID: uuid.MustParse(req.GetQuoteId()), Valid: true}
+	}
+
+	pending, err := server.store.CreatePendingTransferTx(ctx, arg)
+	if err != nil {
+		return nil, transferTxError(err)
+	}
+
+	rsp := &pb.CreateTransferResponse{
+		PendingTransfer: convertPendingTransfer(pending),
+	}
+	return rsp, nil
+}
+
+func validateCreateTransferRequest(req *pb.CreateTransferRequest, mtdt *Metadata) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetFromAccountId()); err != nil {
+		violations = append(violations, fieldViolation("from_account_id", err))
+	}
+
+	if err := val.ValidateID(req.GetToAccountId()); err != nil {
+		violations = append(violations, fieldViolation("to_account_id", err))
+	}
+
+	if err := val.ValidateAmount(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	}
+
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+
+	if req.QuoteId != nil {
+		if err := val.ValidateQuoteID(req.GetQuoteId()); err != nil {
+			violations = append(violations, fieldViolation("quote_id", err))
+		}
+	}
+
+	violations = append(violations, validateTransferDetails(req)...)
+
+	violations = append(violations, validateIdempotencyKey(mtdt)...)
+
+	return violations
+}
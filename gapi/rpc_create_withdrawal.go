@@ -0,0 +1,59 @@
+package gapi
+
+import (
+	"context"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) CreateWithdrawal(ctx context.Context, req *pb.CreateWithdrawalRequest) (*pb.CreateWithdrawalResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mtdt := server.extractMetadata(ctx)
+
+	violations := validateCreateWithdrawalRequest(req, mtdt)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getOwnedAccount(ctx, req.GetAccountId(), authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := server.store.WithdrawTx(ctx, db.WithdrawTxParams{
+		AccountID:      account.ID,
+		Amount:         req.GetAmount(),
+		RequestedBy:    authPayload.Username,
+		Role:           authPayload.Role,
+		IdempotencyKey: mtdt.IdempotencyKey,
+	})
+	if err != nil {
+		return nil, transferTxError(err)
+	}
+
+	rsp := &pb.CreateWithdrawalResponse{
+		Withdrawal: convertWithdrawal(result.Withdrawal, account.Currency),
+		Account:    convertAccount(result.FromAccount),
+	}
+	return rsp, nil
+}
+
+func validateCreateWithdrawalRequest(req *pb.CreateWithdrawalRequest, mtdt *Metadata) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	if err := val.ValidateAmount(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	}
+
+	violations = append(violations, validateIdempotencyKey(mtdt)...)
+
+	return violations
+}
@@ -0,0 +1,61 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/util"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (server *Server) GetBalanceAt(ctx context.Context, req *pb.GetBalanceAtRequest) (*pb.GetBalanceAtResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateGetBalanceAtRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAuditableAccount(ctx, req.GetAccountId(), authPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	at := req.GetAt().AsTime()
+	balance, err := server.store.GetBalanceAt(ctx, db.GetBalanceAtParams{
+		At:        at,
+		AccountID: account.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get balance: %s", err)
+	}
+
+	rsp := &pb.GetBalanceAtResponse{
+		AccountId:      account.ID,
+		Currency:       account.Currency,
+		At:             timestamppb.New(at),
+		Balance:        balance,
+		BalanceDecimal: util.FormatAmount(account.Currency, balance),
+	}
+	return rsp, nil
+}
+
+func validateGetBalanceAtRequest(req *pb.GetBalanceAtRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	if req.GetAt() == nil {
+		violations = append(violations, fieldViolation("at", fmt.Errorf("must be set")))
+	}
+
+	return violations
+}
@@ -0,0 +1,83 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v5/pgtype"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/util"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+func (server *Server) GetBalanceHistory(ctx context.Context, req *pb.GetBalanceHistoryRequest) (*pb.GetBalanceHistoryResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateGetBalanceHistoryRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAuditableAccount(ctx, req.GetAccountId(), authPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	//Tomorrow's balance isn't known yet
+	toDate := db.StartOfDay(req.GetToDate().AsTime())
+	if today := db.StartOfDay(time.Now()); toDate.After(today) {
+		toDate = today
+	}
+
+	rows, err := server.store.ListDailyBalances(ctx, db.ListDailyBalancesParams{
+		FromDate:  pgtype.Date{Time: db.StartOfDay(req.GetFromDate().AsTime()), Valid: true},
+		ToDate:    pgtype.Date{Time: toDate, Valid: true},
+		AccountID: account.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get balance history: %s", err)
+	}
+
+	rsp := &pb.GetBalanceHistoryResponse{
+		AccountId: account.ID,
+		Currency:  account.Currency,
+	}
+	for _, row := range rows {
+		rsp.Points = append(rsp.Points, &pb.BalancePoint{
+			Date:           timestamppb.New(row.Day.Time),
+			Balance:        row.Balance,
+			BalanceDecimal: util.FormatAmount(account.Currency, row.Balance),
+		})
+	}
+	return rsp, nil
+}
+
+func validateGetBalanceHistoryRequest(req *pb.GetBalanceHistoryRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	if req.GetFromDate() == nil {
+		violations = append(violations, fieldViolation("from_date", fmt.Errorf("must be set")))
+	}
+
+	if req.GetToDate() == nil {
+		violations = append(violations, fieldViolation("to_date", fmt.Errorf("must be set")))
+	} else if req.GetFromDate() != nil {
+		fromDate := db.StartOfDay(req.GetFromDate().AsTime())
+		toDate := db.StartOfDay(req.GetToDate().AsTime())
+		if err := val.ValidateBalanceHistoryRange(fromDate, toDate); err != nil {
+			violations = append(violations, fieldViolation("to_date", err))
+		}
+	}
+
+	return violations
+}
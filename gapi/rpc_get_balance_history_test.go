@@ -0,0 +1,151 @@
+package gapi
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "goBank/db/mock"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/token"
+	"goBank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"testing"
+	"time"
+)
+
+func TestGetBalanceHistoryAPI(t *testing.T) {
+	user, _ := randomUser(t)
+	other, _ := randomUser(t)
+	banker, _ := randomUser(t)
+	banker.Role = util.BankerRole
+	account := randomAccount(user.Username)
+
+	today := db.StartOfDay(time.Now())
+	fromDate := today.AddDate(0, 0, -2)
+	rows := []db.ListDailyBalancesRow{
+		{Day: pgtype.Date{Time: fromDate, Valid: true}, Balance: 100},
+		{Day: pgtype.Date{Time: fromDate.AddDate(0, 0, 1), Valid: true}, Balance: 250},
+		{Day: pgtype.Date{Time: today, Valid: true}, Balance: 200},
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.GetBalanceHistoryRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.GetBalanceHistoryResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.GetBalanceHistoryRequest{
+				AccountId: account.ID,
+				FromDate:  timestamppb.New(fromDate.Add(5 * time.Hour)),
+				// days after today are cut off
+				ToDate: timestamppb.New(today.AddDate(0, 0, 3)),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+
+				arg := db.ListDailyBalancesParams{
+					FromDate:  pgtype.Date{Time: fromDate, Valid: true},
+					ToDate:    pgtype.Date{Time: today, Valid: true},
+					AccountID: account.ID,
+				}
+				store.EXPECT().ListDailyBalances(gomock.Any(), gomock.Eq(arg)).Times(1).Return(rows, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetBalanceHistoryResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, account.ID, res.GetAccountId())
+				require.Len(t, res.GetPoints(), 3)
+				require.True(t, fromDate.Equal(res.GetPoints()[0].GetDate().AsTime()))
+				require.Equal(t, int64(250), res.GetPoints()[1].GetBalance())
+				require.Equal(t, util.FormatAmount(account.Currency, 200), res.GetPoints()[2].GetBalanceDecimal())
+			},
+		},
+		{
+			name: "BankerAnyAccount",
+			req: &pb.GetBalanceHistoryRequest{
+				AccountId: account.ID,
+				FromDate:  timestamppb.New(fromDate),
+				ToDate:    timestamppb.New(today),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().ListDailyBalances(gomock.Any(), gomock.Any()).Times(1).Return(rows, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetBalanceHistoryResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetPoints(), 3)
+			},
+		},
+		{
+			name: "NotOwner",
+			req: &pb.GetBalanceHistoryRequest{
+				AccountId: account.ID,
+				FromDate:  timestamppb.New(fromDate),
+				ToDate:    timestamppb.New(today),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().ListDailyBalances(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, other.Username, other.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetBalanceHistoryResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "RangeTooLong",
+			req: &pb.GetBalanceHistoryRequest{
+				AccountId: account.ID,
+				FromDate:  timestamppb.New(today.AddDate(-1, 0, -1)),
+				ToDate:    timestamppb.New(today),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ListDailyBalances(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetBalanceHistoryResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := callRPC(ctx, server, pb.SimpleBank_GetBalanceHistory_FullMethodName, tc.req, server.GetBalanceHistory)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
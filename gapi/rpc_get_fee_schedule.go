@@ -0,0 +1,41 @@
+package gapi
+
+import (
+	"context"
+	"goBank/pb"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) GetFeeSchedule(ctx context.Context, req *pb.GetFeeScheduleRequest) (*pb.GetFeeScheduleResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateGetFeeScheduleRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	schedules, err := server.store.ListFeeSchedules(ctx, req.GetCurrency())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get fee schedule: %s", err)
+	}
+
+	rsp := &pb.GetFeeScheduleResponse{
+		Currency: req.GetCurrency(),
+		Tiers:    convertFeeSchedules(schedules),
+	}
+	return rsp, nil
+}
+
+func validateGetFeeScheduleRequest(req *pb.GetFeeScheduleRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+
+	return violations
+}
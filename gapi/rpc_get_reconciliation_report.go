@@ -0,0 +1,53 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) GetReconciliationReport(ctx context.Context, req *pb.GetReconciliationReportRequest) (*pb.GetReconciliationReportResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateGetReconciliationReportRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	report, err := server.store.GetReconciliationReport(ctx, req.GetReportId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "reconciliation report [%d] not found", req.GetReportId())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get reconciliation report: %s", err)
+	}
+
+	discrepancies, err := server.store.ListReconciliationDiscrepancies(ctx, report.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list discrepancies: %s", err)
+	}
+
+	rsp := &pb.GetReconciliationReportResponse{
+		Report: convertReconciliationReport(report),
+	}
+	for _, discrepancy := range discrepancies {
+		rsp.Discrepancies = append(rsp.Discrepancies, convertReconciliationDiscrepancy(discrepancy))
+	}
+	return rsp, nil
+}
+
+func validateGetReconciliationReportRequest(req *pb.GetReconciliationReportRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetReportId()); err != nil {
+		violations = append(violations, fieldViolation("report_id", err))
+	}
+
+	return violations
+}
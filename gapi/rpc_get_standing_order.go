@@ -0,0 +1,38 @@
+package gapi
+
+import (
+	"context"
+	"goBank/pb"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) GetStandingOrder(ctx context.Context, req *pb.GetStandingOrderRequest) (*pb.GetStandingOrderResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateGetStandingOrderRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	order, err := server.getOwnedStandingOrder(ctx, req.GetId(), authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.GetStandingOrderResponse{
+		StandingOrder: convertStandingOrder(order),
+	}
+	return rsp, nil
+}
+
+func validateGetStandingOrderRequest(req *pb.GetStandingOrderRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,74 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/statement"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetStatement builds an account's statement for any period on demand, unlike the monthly ones it isn't stored
+func (server *Server) GetStatement(ctx context.Context, req *pb.GetStatementRequest) (*pb.GetStatementResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateGetStatementRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getOwnedAccount(ctx, req.GetAccountId(), authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := server.store.StatementTx(ctx, db.StatementTxParams{
+		AccountID:   account.ID,
+		PeriodStart: req.GetPeriodStart().AsTime(),
+		PeriodEnd:   req.GetPeriodEnd().AsTime(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to build statement: %s", err)
+	}
+
+	csv, err := statement.CSV(result)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s", err)
+	}
+	html, err := statement.HTML(result)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s", err)
+	}
+
+	rsp := &pb.GetStatementResponse{
+		Statement: convertStatement(result, csv, html),
+	}
+	return rsp, nil
+}
+
+func validateGetStatementRequest(req *pb.GetStatementRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	if req.GetPeriodStart() == nil {
+		violations = append(violations, fieldViolation("period_start", fmt.Errorf("must be set")))
+	}
+
+	if req.GetPeriodEnd() == nil {
+		violations = append(violations, fieldViolation("period_end", fmt.Errorf("must be set")))
+	} else if req.GetPeriodStart() != nil {
+		if err := val.ValidateStatementPeriod(req.GetPeriodStart().AsTime(), req.GetPeriodEnd().AsTime()); err != nil {
+			violations = append(violations, fieldViolation("period_end", err))
+		}
+	}
+
+	return violations
+}
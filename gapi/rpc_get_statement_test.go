@@ -0,0 +1,165 @@
+package gapi
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "goBank/db/mock"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/token"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"testing"
+	"time"
+)
+
+func TestGetStatementAPI(t *testing.T) {
+	user, _ := randomUser(t)
+	other, _ := randomUser(t)
+	account := randomAccount(user.Username)
+
+	periodEnd := time.Now().UTC().Truncate(time.Second)
+	periodStart := periodEnd.AddDate(0, -1, 0)
+
+	result := db.StatementTxResult{
+		Account:        account,
+		PeriodStart:    periodStart,
+		PeriodEnd:      periodEnd,
+		OpeningBalance: 1000,
+		ClosingBalance: 750,
+		TotalDebits:    250,
+		Lines: []db.StatementLine{
+			{
+				ListStatementEntriesRow: db.ListStatementEntriesRow{
+					ID:          1,
+					Amount:      -250,
+					TransferID:  pgtype.Int8{Int64: 9, Valid: true},
+					CreatedAt:   periodStart.Add(time.Hour),
+					Description: "rent",
+				},
+				Balance: 750,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.GetStatementRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.GetStatementResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.GetStatementRequest{
+				AccountId:   account.ID,
+				PeriodStart: timestamppb.New(periodStart),
+				PeriodEnd:   timestamppb.New(periodEnd),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+
+				arg := db.StatementTxParams{
+					AccountID:   account.ID,
+					PeriodStart: periodStart,
+					PeriodEnd:   periodEnd,
+				}
+				store.EXPECT().StatementTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(result, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetStatementResponse, err error) {
+				require.NoError(t, err)
+				statement := res.GetStatement()
+				require.Equal(t, account.ID, statement.GetAccountId())
+				require.Equal(t, int64(1000), statement.GetOpeningBalance())
+				require.Equal(t, int64(750), statement.GetClosingBalance())
+				require.Len(t, statement.GetLines(), 1)
+				require.Equal(t, int64(9), statement.GetLines()[0].GetTransferId())
+				require.Equal(t, "rent", statement.GetLines()[0].GetDescription())
+				require.Contains(t, statement.GetCsv(), "rent")
+				require.Contains(t, statement.GetHtml(), "Closing balance")
+			},
+		},
+		{
+			name: "NotOwner",
+			req: &pb.GetStatementRequest{
+				AccountId:   account.ID,
+				PeriodStart: timestamppb.New(periodStart),
+				PeriodEnd:   timestamppb.New(periodEnd),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().StatementTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, other.Username, other.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetStatementResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "PeriodTooLong",
+			req: &pb.GetStatementRequest{
+				AccountId:   account.ID,
+				PeriodStart: timestamppb.New(periodEnd.AddDate(-2, 0, 0)),
+				PeriodEnd:   timestamppb.New(periodEnd),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().StatementTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetStatementResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "MissingPeriod",
+			req:  &pb.GetStatementRequest{AccountId: account.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().StatementTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetStatementResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := callRPC(ctx, server, pb.SimpleBank_GetStatement_FullMethodName, tc.req, server.GetStatement)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) GetTransfer(ctx context.Context, req *pb.GetTransferRequest) (*pb.GetTransferResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateGetTransferRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	transfer, err := server.store.GetTransfer(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "transfer not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get transfer: %s", err)
+	}
+
+	//Either side of the transfer may look at it.
+	_, err = server.getOwnedAccount(ctx, transfer.FromAccountID, authPayload.Username)
+	if status.Code(err) == codes.PermissionDenied {
+		_, err = server.getOwnedAccount(ctx, transfer.ToAccountID, authPayload.Username)
+	}
+	if err != nil {
+		if status.Code(err) == codes.PermissionDenied {
+			return nil, status.Errorf(codes.PermissionDenied, "transfer doesn't belong to the authenticated user")
+		}
+		return nil, err
+	}
+
+	rsp := &pb.GetTransferResponse{
+		Transfer: convertTransfer(transfer),
+	}
+	return rsp, nil
+}
+
+func validateGetTransferRequest(req *pb.GetTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,62 @@
+package gapi
+
+import (
+	"context"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) GetTransferLimits(ctx context.Context, req *pb.GetTransferLimitsRequest) (*pb.GetTransferLimitsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateGetTransferLimitsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getOwnedAccount(ctx, req.GetAccountId(), authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := server.store.TransferLimitsTx(ctx, db.TransferLimitsTxParams{
+		AccountID: account.ID,
+		Role:      authPayload.Role,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get transfer limits: %s", err)
+	}
+
+	rsp := &pb.GetTransferLimitsResponse{
+		AccountId:  account.ID,
+		Currency:   account.Currency,
+		Limits:     convertTransferLimits(result.Limits),
+		UsedAmount: result.Usage.Amount,
+		UsedCount:  result.Usage.Count,
+	}
+	//A limit lowered during the day can leave less than nothing, which is still nothing
+	if result.Limits.DailyAmount.Valid {
+		remaining := max(result.Limits.DailyAmount.Int64-result.Usage.Amount, 0)
+		rsp.RemainingAmount = &remaining
+	}
+	if result.Limits.DailyCount.Valid {
+		remaining := max(result.Limits.DailyCount.Int64-result.Usage.Count, 0)
+		rsp.RemainingCount = &remaining
+	}
+	return rsp, nil
+}
+
+func validateGetTransferLimitsRequest(req *pb.GetTransferLimitsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	return violations
+}
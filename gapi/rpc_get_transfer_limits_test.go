@@ -0,0 +1,118 @@
+package gapi
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "goBank/db/mock"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/token"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+func TestGetTransferLimitsAPI(t *testing.T) {
+	user, _ := randomUser(t)
+	other, _ := randomUser(t)
+	account := randomAccount(user.Username)
+
+	testCases := []struct {
+		name          string
+		req           *pb.GetTransferLimitsRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.GetTransferLimitsResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.GetTransferLimitsRequest{AccountId: account.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+
+				arg := db.TransferLimitsTxParams{
+					AccountID: account.ID,
+					Role:      user.Role,
+				}
+				store.EXPECT().
+					TransferLimitsTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.TransferLimitsTxResult{
+						Limits: db.TransferLimits{
+							MaxAmount:   pgtype.Int8{Int64: 500, Valid: true},
+							DailyAmount: pgtype.Int8{Int64: 1000, Valid: true},
+						},
+						Usage: db.TransferUsage{Amount: 1200, Count: 4},
+					}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetTransferLimitsResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int64(500), res.GetLimits().GetMaxAmount())
+				require.Nil(t, res.GetLimits().DailyCount)
+				require.Equal(t, int64(1200), res.GetUsedAmount())
+				require.Equal(t, int64(4), res.GetUsedCount())
+				// the limit was lowered after the account had already sent more
+				require.NotNil(t, res.RemainingAmount)
+				require.Zero(t, res.GetRemainingAmount())
+				require.Nil(t, res.RemainingCount)
+			},
+		},
+		{
+			name: "NotOwner",
+			req:  &pb.GetTransferLimitsRequest{AccountId: account.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().TransferLimitsTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, other.Username, other.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetTransferLimitsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "InvalidAccountID",
+			req:  &pb.GetTransferLimitsRequest{AccountId: 0},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().TransferLimitsTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetTransferLimitsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := callRPC(ctx, server, pb.SimpleBank_GetTransferLimits_FullMethodName, tc.req, server.GetTransferLimits)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) GetWithdrawal(ctx context.Context, req *pb.GetWithdrawalRequest) (*pb.GetWithdrawalResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateGetWithdrawalRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	withdrawal, err := server.store.GetWithdrawal(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "withdrawal not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get withdrawal: %s", err)
+	}
+
+	account, err := server.getAuditableAccount(ctx, withdrawal.AccountID, authPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.GetWithdrawalResponse{
+		Withdrawal: convertWithdrawal(withdrawal, account.Currency),
+	}
+	return rsp, nil
+}
+
+func validateGetWithdrawalRequest(req *pb.GetWithdrawalRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,68 @@
+package gapi
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/util"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListAccounts(ctx context.Context, req *pb.ListAccountsRequest) (*pb.ListAccountsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateListAccountsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	//The validator already checked the token
+	afterID, _ := util.DecodeCursor(req.GetPageToken())
+
+	arg := db.ListAccountsParams{
+		Owner:       authPayload.Username,
+		AfterID:     afterID,
+		Currency:    pgtype.Text{String: req.GetCurrency(), Valid: req.Currency != nil},
+		CreatedFrom: timestamptzArg(req.GetCreatedFrom()),
+		CreatedTo:   timestamptzArg(req.GetCreatedTo()),
+		MinBalance:  int8Arg(req.MinBalance),
+		MaxBalance:  int8Arg(req.MaxBalance),
+		Limit:       util.PageLimit(req.GetPageSize()),
+	}
+
+	accounts, err := server.store.ListAccounts(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list accounts: %s", err)
+	}
+
+	accounts, nextPageToken := util.NextPage(accounts, req.GetPageSize(), func(account db.Account) int64 { return account.ID })
+
+	rsp := &pb.ListAccountsResponse{NextPageToken: nextPageToken}
+	for _, account := range accounts {
+		rsp.Accounts = append(rsp.Accounts, convertAccount(account))
+	}
+	return rsp, nil
+}
+
+func validateListAccountsRequest(req *pb.ListAccountsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validatePage(req.GetPageSize(), req.GetPageToken(), req.GetCreatedFrom(), req.GetCreatedTo())
+
+	if req.Currency != nil {
+		if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+			violations = append(violations, fieldViolation("currency", err))
+		}
+	}
+
+	if err := val.ValidateAmountRange(req.MinBalance, req.MaxBalance); err != nil {
+		violations = append(violations, fieldViolation("max_balance", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,16 @@
+package gapi
+
+import (
+	"context"
+	"goBank/pb"
+	"goBank/util"
+)
+
+// ListCurrencies is public, clients need the minor units of a currency before they can send amounts in it
+func (server *Server) ListCurrencies(ctx context.Context, req *pb.ListCurrenciesRequest) (*pb.ListCurrenciesResponse, error) {
+	rsp := &pb.ListCurrenciesResponse{}
+	for _, currency := range util.SupportedCurrencies() {
+		rsp.Currencies = append(rsp.Currencies, convertCurrency(currency))
+	}
+	return rsp, nil
+}
@@ -0,0 +1,40 @@
+package gapi
+
+import (
+	"context"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/util"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListCurrenciesAPI(t *testing.T) {
+	defer util.SetCurrencies(util.DefaultCurrencies())
+
+	currencies, err := util.ParseCurrencies("JPY,KWD")
+	require.NoError(t, err)
+	util.SetCurrencies(currencies)
+
+	server := newTestServer(t, nil, nil)
+	res, err := server.ListCurrencies(context.Background(), &pb.ListCurrenciesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.GetCurrencies(), 2)
+
+	jpy := res.GetCurrencies()[0]
+	require.Equal(t, "JPY", jpy.GetCode())
+	require.Equal(t, "392", jpy.GetNumericCode())
+	require.Zero(t, jpy.GetMinorUnits())
+
+	kwd := res.GetCurrencies()[1]
+	require.Equal(t, "KWD", kwd.GetCode())
+	require.Equal(t, int32(3), kwd.GetMinorUnits())
+
+	account := db.Account{Currency: "KWD", Balance: 1234, HeldBalance: 1000}
+	require.Equal(t, "1.234", convertAccount(account).GetBalanceDecimal())
+	require.Equal(t, "0.234", convertAccount(account).GetAvailableBalanceDecimal())
+
+	account = db.Account{Currency: "JPY", Balance: 1234}
+	require.Equal(t, "1234", convertAccount(account).GetBalanceDecimal())
+}
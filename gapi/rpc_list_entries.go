@@ -0,0 +1,69 @@
+package gapi
+
+import (
+	"context"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/util"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListEntries(ctx context.Context, req *pb.ListEntriesRequest) (*pb.ListEntriesResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateListEntriesRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	_, err = server.getOwnedAccount(ctx, req.GetAccountId(), authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	//The validator already checked the token
+	afterID, _ := util.DecodeCursor(req.GetPageToken())
+
+	arg := db.ListEntriesParams{
+		AccountID:   req.GetAccountId(),
+		AfterID:     afterID,
+		CreatedFrom: timestamptzArg(req.GetCreatedFrom()),
+		CreatedTo:   timestamptzArg(req.GetCreatedTo()),
+		MinAmount:   int8Arg(req.MinAmount),
+		MaxAmount:   int8Arg(req.MaxAmount),
+		Limit:       util.PageLimit(req.GetPageSize()),
+	}
+
+	entries, err := server.store.ListEntries(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list entries: %s", err)
+	}
+
+	entries, nextPageToken := util.NextPage(entries, req.GetPageSize(), func(entry db.Entry) int64 { return entry.ID })
+
+	rsp := &pb.ListEntriesResponse{NextPageToken: nextPageToken}
+	for _, entry := range entries {
+		rsp.Entries = append(rsp.Entries, convertEntry(entry))
+	}
+	return rsp, nil
+}
+
+func validateListEntriesRequest(req *pb.ListEntriesRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	violations = append(violations, validatePage(req.GetPageSize(), req.GetPageToken(), req.GetCreatedFrom(), req.GetCreatedTo())...)
+
+	if err := val.ValidateAmountRange(req.MinAmount, req.MaxAmount); err != nil {
+		violations = append(violations, fieldViolation("max_amount", err))
+	}
+
+	return violations
+}
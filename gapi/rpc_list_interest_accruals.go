@@ -0,0 +1,61 @@
+package gapi
+
+import (
+	"context"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListInterestAccruals(ctx context.Context, req *pb.ListInterestAccrualsRequest) (*pb.ListInterestAccrualsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateListInterestAccrualsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	_, err = server.getOwnedAccount(ctx, req.GetAccountId(), authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.ListInterestAccrualsParams{
+		AccountID: req.GetAccountId(),
+		Limit:     req.GetPageSize(),
+		Offset:    (req.GetPageId() - 1) * req.GetPageSize(),
+	}
+
+	accruals, err := server.store.ListInterestAccruals(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list interest accruals: %s", err)
+	}
+
+	rsp := &pb.ListInterestAccrualsResponse{}
+	for _, accrual := range accruals {
+		rsp.Accruals = append(rsp.Accruals, convertInterestAccrual(accrual))
+	}
+	return rsp, nil
+}
+
+func validateListInterestAccrualsRequest(req *pb.ListInterestAccrualsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,26 @@
+package gapi
+
+import (
+	"context"
+	"goBank/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListInterestRates(ctx context.Context, req *pb.ListInterestRatesRequest) (*pb.ListInterestRatesResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rates, err := server.store.ListInterestRates(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list interest rates: %s", err)
+	}
+
+	rsp := &pb.ListInterestRatesResponse{}
+	for _, rate := range rates {
+		rsp.Rates = append(rsp.Rates, convertInterestRate(rate))
+	}
+	return rsp, nil
+}
@@ -0,0 +1,61 @@
+package gapi
+
+import (
+	"context"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListStandingOrderRuns(ctx context.Context, req *pb.ListStandingOrderRunsRequest) (*pb.ListStandingOrderRunsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateListStandingOrderRunsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	_, err = server.getOwnedStandingOrder(ctx, req.GetStandingOrderId(), authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.ListStandingOrderRunsParams{
+		StandingOrderID: req.GetStandingOrderId(),
+		Limit:           req.GetPageSize(),
+		Offset:          (req.GetPageId() - 1) * req.GetPageSize(),
+	}
+
+	runs, err := server.store.ListStandingOrderRuns(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list standing order runs: %s", err)
+	}
+
+	rsp := &pb.ListStandingOrderRunsResponse{}
+	for _, run := range runs {
+		rsp.StandingOrderRuns = append(rsp.StandingOrderRuns, convertStandingOrderRun(run))
+	}
+	return rsp, nil
+}
+
+func validateListStandingOrderRunsRequest(req *pb.ListStandingOrderRunsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetStandingOrderId()); err != nil {
+		violations = append(violations, fieldViolation("standing_order_id", err))
+	}
+
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
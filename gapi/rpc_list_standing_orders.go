@@ -0,0 +1,52 @@
+package gapi
+
+import (
+	"context"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListStandingOrders(ctx context.Context, req *pb.ListStandingOrdersRequest) (*pb.ListStandingOrdersResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateListStandingOrdersRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.ListStandingOrdersParams{
+		Owner:  authPayload.Username,
+		Limit:  req.GetPageSize(),
+		Offset: (req.GetPageId() - 1) * req.GetPageSize(),
+	}
+
+	orders, err := server.store.ListStandingOrders(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list standing orders: %s", err)
+	}
+
+	rsp := &pb.ListStandingOrdersResponse{}
+	for _, order := range orders {
+		rsp.StandingOrders = append(rsp.StandingOrders, convertStandingOrder(order))
+	}
+	return rsp, nil
+}
+
+func validateListStandingOrdersRequest(req *pb.ListStandingOrdersRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,77 @@
+package gapi
+
+import (
+	"context"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/util"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListTransfers(ctx context.Context, req *pb.ListTransfersRequest) (*pb.ListTransfersResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateListTransfersRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	_, err = server.getOwnedAccount(ctx, req.GetAccountId(), authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	//The validator already checked the token
+	afterID, _ := util.DecodeCursor(req.GetPageToken())
+
+	arg := db.ListTransfersParams{
+		AccountID:   req.GetAccountId(),
+		Outgoing:    req.GetDirection() != util.IncomingTransfers,
+		Incoming:    req.GetDirection() != util.OutgoingTransfers,
+		AfterID:     afterID,
+		CreatedFrom: timestamptzArg(req.GetCreatedFrom()),
+		CreatedTo:   timestamptzArg(req.GetCreatedTo()),
+		MinAmount:   int8Arg(req.MinAmount),
+		MaxAmount:   int8Arg(req.MaxAmount),
+		Limit:       util.PageLimit(req.GetPageSize()),
+	}
+
+	transfers, err := server.store.ListTransfers(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list transfers: %s", err)
+	}
+
+	transfers, nextPageToken := util.NextPage(transfers, req.GetPageSize(), func(transfer db.Transfer) int64 { return transfer.ID })
+
+	rsp := &pb.ListTransfersResponse{NextPageToken: nextPageToken}
+	for _, transfer := range transfers {
+		rsp.Transfers = append(rsp.Transfers, convertTransfer(transfer))
+	}
+	return rsp, nil
+}
+
+func validateListTransfersRequest(req *pb.ListTransfersRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	violations = append(violations, validatePage(req.GetPageSize(), req.GetPageToken(), req.GetCreatedFrom(), req.GetCreatedTo())...)
+
+	if req.GetDirection() != "" {
+		if err := val.ValidateTransferDirection(req.GetDirection()); err != nil {
+			violations = append(violations, fieldViolation("direction", err))
+		}
+	}
+
+	if err := val.ValidateAmountRange(req.MinAmount, req.MaxAmount); err != nil {
+		violations = append(violations, fieldViolation("max_amount", err))
+	}
+
+	return violations
+}
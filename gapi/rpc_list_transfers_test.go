@@ -0,0 +1,154 @@
+package gapi
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "goBank/db/mock"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/token"
+	"goBank/util"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"testing"
+	"time"
+)
+
+func TestListTransfersAPI(t *testing.T) {
+	user, _ := randomUser(t)
+	account := randomAccount(user.Username)
+
+	n := 5
+	transfers := make([]db.Transfer, n+1)
+	for i := range transfers {
+		transfers[i] = db.Transfer{
+			ID:            int64(100 + i),
+			FromAccountID: util.RandomInt(1, 1000),
+			ToAccountID:   account.ID,
+			Amount:        util.RandomMoney(),
+		}
+	}
+
+	createdFrom := time.Now().Add(-time.Hour).Truncate(time.Second)
+
+	testCases := []struct {
+		name          string
+		req           *pb.ListTransfersRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.ListTransfersResponse, err error)
+	}{
+		{
+			name: "LastPage",
+			req: &pb.ListTransfersRequest{
+				AccountId: account.ID,
+				PageSize:  int32(n),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+
+				arg := db.ListTransfersParams{
+					AccountID: account.ID,
+					Outgoing:  true,
+					Incoming:  true,
+					Limit:     int32(n + 1),
+				}
+				store.EXPECT().ListTransfers(gomock.Any(), gomock.Eq(arg)).Times(1).Return(transfers[:n], nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListTransfersResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetTransfers(), n)
+				require.Empty(t, res.GetNextPageToken())
+			},
+		},
+		{
+			name: "IncomingWithFilters",
+			req: &pb.ListTransfersRequest{
+				AccountId:   account.ID,
+				PageSize:    int32(n),
+				PageToken:   util.EncodeCursor(99),
+				Direction:   util.IncomingTransfers,
+				CreatedFrom: timestamppb.New(createdFrom),
+				MinAmount:   proto.Int64(1),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+
+				arg := db.ListTransfersParams{
+					AccountID:   account.ID,
+					Incoming:    true,
+					AfterID:     99,
+					CreatedFrom: pgtype.Timestamptz{Time: createdFrom.UTC(), Valid: true},
+					MinAmount:   pgtype.Int8{Int64: 1, Valid: true},
+					Limit:       int32(n + 1),
+				}
+				store.EXPECT().ListTransfers(gomock.Any(), gomock.Eq(arg)).Times(1).Return(transfers, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListTransfersResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetTransfers(), n)
+				require.Equal(t, util.EncodeCursor(transfers[n-1].ID), res.GetNextPageToken())
+			},
+		},
+		{
+			name: "InvalidArguments",
+			req: &pb.ListTransfersRequest{
+				AccountId: account.ID,
+				PageSize:  int32(n),
+				PageToken: "not-a-token",
+				Direction: "sideways",
+				MinAmount: proto.Int64(100),
+				MaxAmount: proto.Int64(10),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ListTransfers(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListTransfersResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+
+				var fields []string
+				for _, detail := range st.Details() {
+					for _, violation := range detail.(*errdetails.BadRequest).GetFieldViolations() {
+						fields = append(fields, violation.GetField())
+					}
+				}
+				require.ElementsMatch(t, []string{"page_token", "direction", "max_amount"}, fields)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := callRPC(ctx, server, pb.SimpleBank_ListTransfers_FullMethodName, tc.req, server.ListTransfers)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -65,6 +65,7 @@ func (server *Server) Login(ctx context.Context, req *pb.LoginUserRequest) (*pb.
 		ClientIp:     mtdt.ClientIP,
 		IsBlocked:    false,
 		ExpiresAt:    refreshPayload.ExpiredAt,
+		FamilyID:     refreshPayload.ID,
 	})
 
 	if err != nil {
@@ -0,0 +1,41 @@
+package gapi
+
+import (
+	"context"
+	"goBank/pb"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Logout blocks the session of a refresh token, and every session it was rotated from or into,
+// so nothing from that login can renew access tokens anymore.
+// The refresh token is the proof of who's logging out, an access token that was already issued
+// stays valid until it expires.
+func (server *Server) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	violations := validateLogoutRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	session, _, err := server.getRefreshSession(ctx, req.GetRefreshToken())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = server.store.BlockSessionFamily(ctx, session.FamilyID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to block session: %s", err)
+	}
+
+	return &pb.LogoutResponse{}, nil
+}
+
+func validateLogoutRequest(req *pb.LogoutRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateToken(req.GetRefreshToken()); err != nil {
+		violations = append(violations, fieldViolation("refresh_token", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,35 @@
+package gapi
+
+import (
+	"context"
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgtype"
+	"goBank/pb"
+	"goBank/worker"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ReconcileLedger(ctx context.Context, req *pb.ReconcileLedgerRequest) (*pb.ReconcileLedgerResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := server.store.CreateReconciliationReport(ctx, pgtype.Text{String: authPayload.Username, Valid: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create reconciliation report: %s", err)
+	}
+
+	//Scanning the whole ledger takes too long for a request, the worker fills the report in
+	taskPayload := &worker.PayloadReconcileLedger{ReportID: report.ID}
+	err = server.taskDistributor.DistributeTaskReconcileLedger(ctx, taskPayload, asynq.Queue(worker.QueueDefault))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to start reconciliation: %s", err)
+	}
+
+	rsp := &pb.ReconcileLedgerResponse{
+		Report: convertReconciliationReport(report),
+	}
+	return rsp, nil
+}
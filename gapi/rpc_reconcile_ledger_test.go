@@ -0,0 +1,127 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "goBank/db/mock"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/token"
+	"goBank/util"
+	"goBank/worker"
+	mockwk "goBank/worker/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+func TestReconcileLedgerAPI(t *testing.T) {
+	banker, _ := randomUser(t)
+	banker.Role = util.BankerRole
+	depositor, _ := randomUser(t)
+
+	report := db.ReconciliationReport{
+		ID:          util.RandomInt(1, 1000),
+		TriggeredBy: pgtype.Text{String: banker.Username, Valid: true},
+		Status:      db.ReconciliationStatusRunning,
+	}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.ReconcileLedgerResponse, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					CreateReconciliationReport(gomock.Any(), gomock.Eq(report.TriggeredBy)).
+					Times(1).
+					Return(report, nil)
+
+				taskPayload := &worker.PayloadReconcileLedger{ReportID: report.ID}
+				taskDistributor.EXPECT().
+					DistributeTaskReconcileLedger(gomock.Any(), taskPayload, gomock.Any()).
+					Times(1).
+					Return(nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ReconcileLedgerResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, report.ID, res.GetReport().GetId())
+				require.Equal(t, banker.Username, res.GetReport().GetTriggeredBy())
+				require.Equal(t, db.ReconciliationStatusRunning, res.GetReport().GetStatus())
+			},
+		},
+		{
+			name: "DepositorNotAllowed",
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					CreateReconciliationReport(gomock.Any(), gomock.Any()).
+					Times(0)
+				taskDistributor.EXPECT().
+					DistributeTaskReconcileLedger(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, depositor.Username, depositor.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ReconcileLedgerResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "DistributeTaskError",
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					CreateReconciliationReport(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(report, nil)
+				taskDistributor.EXPECT().
+					DistributeTaskReconcileLedger(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(fmt.Errorf("redis unavailable"))
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ReconcileLedgerResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Internal, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			taskCtrl := gomock.NewController(t)
+			defer taskCtrl.Finish()
+			taskDistributor := mockwk.NewMockTaskDistributor(taskCtrl)
+
+			tc.buildStubs(store, taskDistributor)
+			server := newTestServer(t, store, taskDistributor)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := callRPC(ctx, server, pb.SimpleBank_ReconcileLedger_FullMethodName, &pb.ReconcileLedgerRequest{}, server.ReconcileLedger)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
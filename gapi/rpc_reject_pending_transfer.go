@@ -0,0 +1,59 @@
+package gapi
+
+import (
+	"context"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/val"
+	"goBank/worker"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) RejectPendingTransfer(ctx context.Context, req *pb.RejectPendingTransferRequest) (*pb.RejectPendingTransferResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateRejectPendingTransferRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.ReviewPendingTransferTxParams{
+		PendingTransferID: req.GetPendingTransferId(),
+		Reviewer:          authPayload.Username,
+		Reason:            req.GetReason(),
+	}
+
+	pending, err := server.store.RejectPendingTransferTx(ctx, arg)
+	if err != nil {
+		return nil, transferTxError(err)
+	}
+
+	//The rejection stands either way, a lost notification shouldn't fail the request
+	taskPayload := &worker.PayloadSendPendingTransferReviewed{PendingTransferID: pending.ID}
+	err = server.taskDistributor.DistributeTaskSendPendingTransferReviewed(ctx, taskPayload, asynq.MaxRetry(10))
+	if err != nil {
+		log.Error().Err(err).Int64("pending_transfer_id", pending.ID).Msg("failed to enqueue pending transfer rejection email")
+	}
+
+	rsp := &pb.RejectPendingTransferResponse{
+		PendingTransfer: convertPendingTransfer(pending),
+	}
+	return rsp, nil
+}
+
+func validateRejectPendingTransferRequest(req *pb.RejectPendingTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetPendingTransferId()); err != nil {
+		violations = append(violations, fieldViolation("pending_transfer_id", err))
+	}
+
+	if err := val.ValidateReason(req.GetReason()); err != nil {
+		violations = append(violations, fieldViolation("reason", err))
+	}
+
+	return violations
+}
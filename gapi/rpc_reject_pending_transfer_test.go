@@ -0,0 +1,184 @@
+package gapi
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "goBank/db/mock"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/token"
+	"goBank/util"
+	"goBank/worker"
+	mockwk "goBank/worker/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+func TestRejectPendingTransferAPI(t *testing.T) {
+	banker, _ := randomUser(t)
+	banker.Role = util.BankerRole
+	depositor, _ := randomUser(t)
+
+	pendingTransferID := util.RandomInt(1, 1000)
+	reason := "unusual activity"
+
+	testCases := []struct {
+		name          string
+		req           *pb.RejectPendingTransferRequest
+		buildStubs    func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.RejectPendingTransferResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.RejectPendingTransferRequest{
+				PendingTransferId: pendingTransferID,
+				Reason:            reason,
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				arg := db.ReviewPendingTransferTxParams{
+					PendingTransferID: pendingTransferID,
+					Reviewer:          banker.Username,
+					Reason:            reason,
+				}
+				store.EXPECT().
+					RejectPendingTransferTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.PendingTransfer{
+						ID:     pendingTransferID,
+						Status: db.PendingTransferStatusRejected,
+						Reason: reason,
+					}, nil)
+
+				taskPayload := &worker.PayloadSendPendingTransferReviewed{PendingTransferID: pendingTransferID}
+				taskDistributor.EXPECT().
+					DistributeTaskSendPendingTransferReviewed(gomock.Any(), taskPayload, gomock.Any()).
+					Times(1).
+					Return(nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.RejectPendingTransferResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, pendingTransferID, res.GetPendingTransfer().GetId())
+				require.Equal(t, db.PendingTransferStatusRejected, res.GetPendingTransfer().GetStatus())
+			},
+		},
+		{
+			name: "SelfReview",
+			req: &pb.RejectPendingTransferRequest{
+				PendingTransferId: pendingTransferID,
+				Reason:            reason,
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					RejectPendingTransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.PendingTransfer{}, db.ErrSelfReview)
+				taskDistributor.EXPECT().
+					DistributeTaskSendPendingTransferReviewed(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.RejectPendingTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "AlreadyReviewed",
+			req: &pb.RejectPendingTransferRequest{
+				PendingTransferId: pendingTransferID,
+				Reason:            reason,
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					RejectPendingTransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.PendingTransfer{}, db.ErrPendingTransferReviewed)
+				taskDistributor.EXPECT().
+					DistributeTaskSendPendingTransferReviewed(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.RejectPendingTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "DepositorNotAllowed",
+			req: &pb.RejectPendingTransferRequest{
+				PendingTransferId: pendingTransferID,
+				Reason:            reason,
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					RejectPendingTransferTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, depositor.Username, depositor.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.RejectPendingTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "MissingReason",
+			req: &pb.RejectPendingTransferRequest{
+				PendingTransferId: pendingTransferID,
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					RejectPendingTransferTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.RejectPendingTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			taskCtrl := gomock.NewController(t)
+			defer taskCtrl.Finish()
+			taskDistributor := mockwk.NewMockTaskDistributor(taskCtrl)
+
+			tc.buildStubs(store, taskDistributor)
+			server := newTestServer(t, store, taskDistributor)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := callRPC(ctx, server, pb.SimpleBank_RejectPendingTransfer_FullMethodName, tc.req, server.RejectPendingTransfer)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
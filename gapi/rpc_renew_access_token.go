@@ -0,0 +1,131 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/val"
+	"goBank/worker"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+// RenewAccessToken exchanges the refresh token of a session for a new access token and a new refresh token.
+// The old refresh token is spent, presenting it again means someone else has a copy of it,
+// so the whole family of sessions from that login is blocked and the user is told by email.
+// The new refresh token expires when the login's first one would have, renewing doesn't extend a login.
+func (server *Server) RenewAccessToken(ctx context.Context, req *pb.RenewAccessTokenRequest) (*pb.RenewAccessTokenResponse, error) {
+	violations := validateRenewAccessTokenRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	session, refreshPayload, err := server.getRefreshSession(ctx, req.GetRefreshToken())
+	if err != nil {
+		return nil, err
+	}
+
+	if session.IsBlocked {
+		return nil, unauthenticatedError(fmt.Errorf("blocked session"))
+	}
+
+	if session.RotatedAt.Valid {
+		return nil, server.refreshTokenReused(ctx, session)
+	}
+
+	if time.Now().After(session.ExpiresAt) {
+		return nil, unauthenticatedError(fmt.Errorf("expired session"))
+	}
+
+	accessToken, accessPayload, err := server.tokenMaker.CreateToken(
+		refreshPayload.Username,
+		refreshPayload.Role,
+		server.config.AccessTokenDuration,
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create access token.")
+	}
+
+	refreshToken, newRefreshPayload, err := server.tokenMaker.CreateToken(
+		refreshPayload.Username,
+		refreshPayload.Role,
+		time.Until(session.ExpiresAt),
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create refresh token.")
+	}
+
+	mtdt := server.extractMetadata(ctx)
+	newSession, err := server.store.RotateSessionTx(ctx, db.RotateSessionTxParams{
+		Session: session,
+		NewSession: db.CreateSessionParams{
+			ID:           newRefreshPayload.ID,
+			Username:     session.Username,
+			RefreshToken: refreshToken,
+			UserAgent:    mtdt.UserAgent,
+			ClientIp:     mtdt.ClientIP,
+			IsBlocked:    false,
+			ExpiresAt:    newRefreshPayload.ExpiredAt,
+		},
+	})
+	if err != nil {
+		// another renewal with the same refresh token got there first
+		if errors.Is(err, db.ErrSessionRotated) {
+			return nil, server.refreshTokenReused(ctx, session)
+		}
+		// a logout blocked it since it was read
+		if errors.Is(err, db.ErrSessionBlocked) {
+			return nil, unauthenticatedError(err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to rotate session: %s", err)
+	}
+
+	rsp := &pb.RenewAccessTokenResponse{
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  timestamppb.New(accessPayload.ExpiredAt),
+		SessionId:             newSession.ID.String(),
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: timestamppb.New(newRefreshPayload.ExpiredAt),
+	}
+	return rsp, nil
+}
+
+// refreshTokenReused blocks every session of the login a rotated refresh token belongs to,
+// then has the worker email the user about it. It returns the error to answer the request with.
+func (server *Server) refreshTokenReused(ctx context.Context, session db.Session) error {
+	_, err := server.store.BlockSessionFamily(ctx, session.FamilyID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to block sessions: %s", err)
+	}
+
+	//The sessions are blocked either way, a lost notification shouldn't change the answer
+	mtdt := server.extractMetadata(ctx)
+	taskPayload := &worker.PayloadSendSessionReuseAlert{
+		SessionID:  session.ID,
+		UserAgent:  mtdt.UserAgent,
+		ClientIP:   mtdt.ClientIP,
+		DetectedAt: time.Now(),
+	}
+	err = server.taskDistributor.DistributeTaskSendSessionReuseAlert(ctx, taskPayload,
+		asynq.MaxRetry(10), asynq.Queue(worker.QueueCritical))
+	if err != nil {
+		log.Error().Err(err).Str("session_id", session.ID.String()).Msg("failed to enqueue session reuse alert")
+	}
+
+	return unauthenticatedError(fmt.Errorf("refresh token has already been used"))
+}
+
+func validateRenewAccessTokenRequest(req *pb.RenewAccessTokenRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateToken(req.GetRefreshToken()); err != nil {
+		violations = append(violations, fieldViolation("refresh_token", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,203 @@
+package gapi
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "goBank/db/mock"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/worker"
+	mockwk "goBank/worker/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+func TestRenewAccessTokenAPI(t *testing.T) {
+	user, _ := randomUser(t)
+
+	testCases := []struct {
+		name string
+		// sent instead of the refresh token the session was created with
+		refreshToken  string
+		buildSession  func(session *db.Session)
+		buildStubs    func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor, session db.Session)
+		checkResponse func(t *testing.T, session db.Session, res *pb.RenewAccessTokenResponse, err error)
+	}{
+		{
+			name:         "OK",
+			buildSession: func(session *db.Session) {},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor, session db.Session) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(session, nil)
+				store.EXPECT().
+					RotateSessionTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.RotateSessionTxParams) (db.Session, error) {
+						require.Equal(t, session.ID, arg.Session.ID)
+						require.Equal(t, user.Username, arg.NewSession.Username)
+						require.NotEqual(t, session.RefreshToken, arg.NewSession.RefreshToken)
+						return db.Session{
+							ID:                arg.NewSession.ID,
+							Username:          arg.NewSession.Username,
+							RefreshToken:      arg.NewSession.RefreshToken,
+							ExpiresAt:         arg.NewSession.ExpiresAt,
+							FamilyID:          session.FamilyID,
+							PreviousSessionID: pgtype.UUID{Bytes: session.ID, Valid: true},
+						}, nil
+					})
+				store.EXPECT().BlockSessionFamily(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, session db.Session, res *pb.RenewAccessTokenResponse, err error) {
+				require.NoError(t, err)
+				require.NotEmpty(t, res.GetAccessToken())
+				require.WithinDuration(t, time.Now().Add(time.Minute), res.GetAccessTokenExpiresAt().AsTime(), time.Second)
+				require.NotEqual(t, session.ID.String(), res.GetSessionId())
+				require.NotEqual(t, session.RefreshToken, res.GetRefreshToken())
+				// rotating doesn't extend the login
+				require.WithinDuration(t, session.ExpiresAt, res.GetRefreshTokenExpiresAt().AsTime(), time.Second)
+			},
+		},
+		{
+			name: "ReusedToken",
+			buildSession: func(session *db.Session) {
+				session.RotatedAt = pgtype.Timestamptz{Time: time.Now().Add(-time.Minute), Valid: true}
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor, session db.Session) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(session, nil)
+				store.EXPECT().RotateSessionTx(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().BlockSessionFamily(gomock.Any(), gomock.Eq(session.FamilyID)).Times(1).Return(int64(2), nil)
+				taskDistributor.EXPECT().
+					DistributeTaskSendSessionReuseAlert(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, payload *worker.PayloadSendSessionReuseAlert, opts ...asynq.Option) error {
+						require.Equal(t, session.ID, payload.SessionID)
+						return nil
+					})
+			},
+			checkResponse: func(t *testing.T, session db.Session, res *pb.RenewAccessTokenResponse, err error) {
+				require.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+		{
+			name:         "ConcurrentRenewal",
+			buildSession: func(session *db.Session) {},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor, session db.Session) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(session, nil)
+				store.EXPECT().RotateSessionTx(gomock.Any(), gomock.Any()).Times(1).Return(db.Session{}, db.ErrSessionRotated)
+				store.EXPECT().BlockSessionFamily(gomock.Any(), gomock.Eq(session.FamilyID)).Times(1).Return(int64(2), nil)
+				taskDistributor.EXPECT().
+					DistributeTaskSendSessionReuseAlert(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(t *testing.T, session db.Session, res *pb.RenewAccessTokenResponse, err error) {
+				require.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+		{
+			name:         "BlockedDuringRenewal",
+			buildSession: func(session *db.Session) {},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor, session db.Session) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(session, nil)
+				store.EXPECT().RotateSessionTx(gomock.Any(), gomock.Any()).Times(1).Return(db.Session{}, db.ErrSessionBlocked)
+				store.EXPECT().BlockSessionFamily(gomock.Any(), gomock.Any()).Times(0)
+				taskDistributor.EXPECT().DistributeTaskSendSessionReuseAlert(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, session db.Session, res *pb.RenewAccessTokenResponse, err error) {
+				require.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+		{
+			name: "BlockedSession",
+			buildSession: func(session *db.Session) {
+				// blocked sessions aren't reported again, even when the token was rotated
+				session.IsBlocked = true
+				session.RotatedAt = pgtype.Timestamptz{Time: time.Now().Add(-time.Minute), Valid: true}
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor, session db.Session) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(session, nil)
+				store.EXPECT().BlockSessionFamily(gomock.Any(), gomock.Any()).Times(0)
+				taskDistributor.EXPECT().DistributeTaskSendSessionReuseAlert(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, session db.Session, res *pb.RenewAccessTokenResponse, err error) {
+				require.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+		{
+			name: "MismatchedToken",
+			buildSession: func(session *db.Session) {
+				session.RefreshToken = "another refresh token"
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor, session db.Session) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(session, nil)
+				store.EXPECT().RotateSessionTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, session db.Session, res *pb.RenewAccessTokenResponse, err error) {
+				require.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+		{
+			name:         "SessionNotFound",
+			buildSession: func(session *db.Session) {},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor, session db.Session) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(db.Session{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(t *testing.T, session db.Session, res *pb.RenewAccessTokenResponse, err error) {
+				require.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+		{
+			name:         "InvalidToken",
+			refreshToken: "not a token",
+			buildSession: func(session *db.Session) {},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor, session db.Session) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, session db.Session, res *pb.RenewAccessTokenResponse, err error) {
+				require.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			taskCtrl := gomock.NewController(t)
+			defer taskCtrl.Finish()
+			taskDistributor := mockwk.NewMockTaskDistributor(taskCtrl)
+
+			server := newTestServer(t, store, taskDistributor)
+
+			refreshToken, refreshPayload, err := server.tokenMaker.CreateToken(user.Username, user.Role, time.Hour)
+			require.NoError(t, err)
+
+			session := db.Session{
+				ID:           refreshPayload.ID,
+				Username:     user.Username,
+				RefreshToken: refreshToken,
+				ExpiresAt:    refreshPayload.ExpiredAt,
+				FamilyID:     refreshPayload.ID,
+			}
+			tc.buildSession(&session)
+			tc.buildStubs(store, taskDistributor, session)
+
+			req := &pb.RenewAccessTokenRequest{RefreshToken: refreshToken}
+			if tc.refreshToken != "" {
+				req.RefreshToken = tc.refreshToken
+			}
+
+			ctx := context.Background()
+			res, err := callRPC(ctx, server, pb.SimpleBank_RenewAccessToken_FullMethodName, req, server.RenewAccessToken)
+			tc.checkResponse(t, session, res, err)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package gapi
+
+import (
+	"context"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) ReverseTransfer(ctx context.Context, req *pb.ReverseTransferRequest) (*pb.ReverseTransferResponse, error) {
+	//Only bankers can refund, depositors would be able to claw back money they sent
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateReverseTransferRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.ReverseTransferTxParams{
+		TransferID: req.GetTransferId(),
+		Amount:     req.GetAmount(),
+	}
+
+	result, err := server.store.ReverseTransferTx(ctx, arg)
+	if err != nil {
+		return nil, transferTxError(err)
+	}
+
+	rsp := &pb.ReverseTransferResponse{
+		OriginalTransfer: convertTransfer(result.OriginalTransfer),
+		Transfer:         convertTransfer(result.Transfer),
+		FromAccount:      convertAccount(result.FromAccount),
+		ToAccount:        convertAccount(result.ToAccount),
+		FromEntry:        convertEntry(result.FromEntry),
+		ToEntry:          convertEntry(result.ToEntry),
+	}
+	return rsp, nil
+}
+
+func validateReverseTransferRequest(req *pb.ReverseTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetTransferId()); err != nil {
+		violations = append(violations, fieldViolation("transfer_id", err))
+	}
+
+	if err := val.ValidateAmount(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	}
+
+	return violations
+}
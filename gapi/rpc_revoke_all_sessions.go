@@ -0,0 +1,27 @@
+package gapi
+
+import (
+	"context"
+	"goBank/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RevokeAllSessions blocks every session of the caller, the one they're calling from included,
+// so every device has to log in again once its access token expires
+func (server *Server) RevokeAllSessions(ctx context.Context, req *pb.RevokeAllSessionsRequest) (*pb.RevokeAllSessionsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := server.store.BlockUserSessions(ctx, authPayload.Username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to block sessions: %s", err)
+	}
+
+	rsp := &pb.RevokeAllSessionsResponse{
+		Revoked: revoked,
+	}
+	return rsp, nil
+}
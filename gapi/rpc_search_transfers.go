@@ -0,0 +1,73 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v5/pgtype"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/util"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SearchTransfers looks through the transfers of every account the user owns, sent or received
+func (server *Server) SearchTransfers(ctx context.Context, req *pb.SearchTransfersRequest) (*pb.SearchTransfersResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateSearchTransfersRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	//The validator already checked the token
+	afterID, _ := util.DecodeCursor(req.GetPageToken())
+
+	arg := db.SearchTransfersParams{
+		Owner:             authPayload.Username,
+		Query:             pgtype.Text{String: req.GetQuery(), Valid: req.GetQuery() != ""},
+		ExternalReference: pgtype.Text{String: req.GetExternalReference(), Valid: req.GetExternalReference() != ""},
+		AfterID:           afterID,
+		Limit:             util.PageLimit(req.GetPageSize()),
+	}
+
+	transfers, err := server.store.SearchTransfers(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search transfers: %s", err)
+	}
+
+	transfers, nextPageToken := util.NextPage(transfers, req.GetPageSize(), func(transfer db.Transfer) int64 { return transfer.ID })
+
+	rsp := &pb.SearchTransfersResponse{NextPageToken: nextPageToken}
+	for _, transfer := range transfers {
+		rsp.Transfers = append(rsp.Transfers, convertTransfer(transfer))
+	}
+	return rsp, nil
+}
+
+func validateSearchTransfersRequest(req *pb.SearchTransfersRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.GetQuery() == "" && req.GetExternalReference() == "" {
+		violations = append(violations, fieldViolation("query", fmt.Errorf("either query or external_reference must be set")))
+	}
+
+	if req.GetQuery() != "" {
+		if err := val.ValidateSearchQuery(req.GetQuery()); err != nil {
+			violations = append(violations, fieldViolation("query", err))
+		}
+	}
+
+	if req.GetExternalReference() != "" {
+		if err := val.ValidateExternalReference(req.GetExternalReference()); err != nil {
+			violations = append(violations, fieldViolation("external_reference", err))
+		}
+	}
+
+	violations = append(violations, validatePage(req.GetPageSize(), req.GetPageToken(), nil, nil)...)
+
+	return violations
+}
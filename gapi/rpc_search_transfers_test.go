@@ -0,0 +1,136 @@
+package gapi
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "goBank/db/mock"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/token"
+	"goBank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+func TestSearchTransfersAPI(t *testing.T) {
+	user, _ := randomUser(t)
+	transfer := db.Transfer{
+		ID:          util.RandomInt(1, 1000),
+		Amount:      util.RandomMoney(),
+		Description: "March rent",
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.SearchTransfersRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.SearchTransfersResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.SearchTransfersRequest{
+				Query:    "rent",
+				PageSize: 5,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.SearchTransfersParams{
+					Owner: user.Username,
+					Query: pgtype.Text{String: "rent", Valid: true},
+					Limit: 6,
+				}
+				store.EXPECT().SearchTransfers(gomock.Any(), gomock.Eq(arg)).Times(1).Return([]db.Transfer{transfer}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.SearchTransfersResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetTransfers(), 1)
+				require.Equal(t, transfer.ID, res.GetTransfers()[0].GetId())
+				require.Equal(t, "March rent", res.GetTransfers()[0].GetDescription())
+				require.Empty(t, res.GetNextPageToken())
+			},
+		},
+		{
+			name: "ExternalReference",
+			req: &pb.SearchTransfersRequest{
+				ExternalReference: "INV-42",
+				PageSize:          5,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.SearchTransfersParams{
+					Owner:             user.Username,
+					ExternalReference: pgtype.Text{String: "INV-42", Valid: true},
+					Limit:             6,
+				}
+				store.EXPECT().SearchTransfers(gomock.Any(), gomock.Eq(arg)).Times(1).Return([]db.Transfer{}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.SearchTransfersResponse, err error) {
+				require.NoError(t, err)
+				require.Empty(t, res.GetTransfers())
+			},
+		},
+		{
+			name: "NothingToSearchFor",
+			req: &pb.SearchTransfersRequest{
+				PageSize: 5,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SearchTransfers(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.SearchTransfersResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req: &pb.SearchTransfersRequest{
+				Query:    "rent",
+				PageSize: 5,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SearchTransfers(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.SearchTransfersResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := callRPC(ctx, server, pb.SimpleBank_SearchTransfers_FullMethodName, tc.req, server.SearchTransfers)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
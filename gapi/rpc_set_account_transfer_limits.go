@@ -0,0 +1,71 @@
+package gapi
+
+import (
+	"context"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) SetAccountTransferLimits(ctx context.Context, req *pb.SetAccountTransferLimitsRequest) (*pb.SetAccountTransferLimitsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateSetAccountTransferLimitsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccount(ctx, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.SetAccountTransferLimitsResponse{
+		AccountId: account.ID,
+		Limits:    &pb.TransferLimits{},
+	}
+
+	//Nothing left to override, so the account goes back to its owner's role limits
+	limits := transferLimitsArg(req.GetLimits())
+	if !limits.MaxAmount.Valid && !limits.DailyAmount.Valid && !limits.DailyCount.Valid {
+		err = server.store.DeleteAccountTransferLimits(ctx, account.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to remove account transfer limits: %s", err)
+		}
+		return rsp, nil
+	}
+
+	accountLimits, err := server.store.UpsertAccountTransferLimits(ctx, db.UpsertAccountTransferLimitsParams{
+		AccountID:   account.ID,
+		MaxAmount:   limits.MaxAmount,
+		DailyAmount: limits.DailyAmount,
+		DailyCount:  limits.DailyCount,
+		UpdatedBy:   authPayload.Username,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set account transfer limits: %s", err)
+	}
+
+	rsp.Limits = convertTransferLimits(db.TransferLimits{
+		MaxAmount:   accountLimits.MaxAmount,
+		DailyAmount: accountLimits.DailyAmount,
+		DailyCount:  accountLimits.DailyCount,
+	})
+	return rsp, nil
+}
+
+func validateSetAccountTransferLimitsRequest(req *pb.SetAccountTransferLimitsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	violations = append(violations, validateTransferLimits("limits", req.GetLimits())...)
+
+	return violations
+}
@@ -0,0 +1,54 @@
+package gapi
+
+import (
+	"context"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) SetInterestRate(ctx context.Context, req *pb.SetInterestRateRequest) (*pb.SetInterestRateResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateSetInterestRateRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	//The new rate applies from the next daily accrual, days already accrued keep their rate
+	rate, err := server.store.UpsertInterestRate(ctx, db.UpsertInterestRateParams{
+		Product:     req.GetProduct(),
+		Currency:    req.GetCurrency(),
+		BasisPoints: req.GetBasisPoints(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set interest rate: %s", err)
+	}
+
+	rsp := &pb.SetInterestRateResponse{
+		Rate: convertInterestRate(rate),
+	}
+	return rsp, nil
+}
+
+func validateSetInterestRateRequest(req *pb.SetInterestRateRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateProduct(req.GetProduct()); err != nil {
+		violations = append(violations, fieldViolation("product", err))
+	}
+
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+
+	if err := val.ValidateBasisPoints(req.GetBasisPoints()); err != nil {
+		violations = append(violations, fieldViolation("basis_points", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,147 @@
+package gapi
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "goBank/db/mock"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/token"
+	"goBank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+func TestSetInterestRateAPI(t *testing.T) {
+	banker, _ := randomUser(t)
+	banker.Role = util.BankerRole
+	depositor, _ := randomUser(t)
+
+	testCases := []struct {
+		name          string
+		req           *pb.SetInterestRateRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.SetInterestRateResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.SetInterestRateRequest{
+				Product:     util.SavingsProduct,
+				Currency:    util.USD,
+				BasisPoints: 250,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.UpsertInterestRateParams{
+					Product:     util.SavingsProduct,
+					Currency:    util.USD,
+					BasisPoints: 250,
+				}
+				store.EXPECT().
+					UpsertInterestRate(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.InterestRate{
+						ID:          1,
+						Product:     util.SavingsProduct,
+						Currency:    util.USD,
+						BasisPoints: 250,
+						UpdatedAt:   time.Now(),
+					}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.SetInterestRateResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.SavingsProduct, res.GetRate().GetProduct())
+				require.Equal(t, int64(250), res.GetRate().GetBasisPoints())
+			},
+		},
+		{
+			name: "InvalidProduct",
+			req: &pb.SetInterestRateRequest{
+				Product:     "brokerage",
+				Currency:    util.USD,
+				BasisPoints: 250,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpsertInterestRate(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.SetInterestRateResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "InvalidBasisPoints",
+			req: &pb.SetInterestRateRequest{
+				Product:     util.SavingsProduct,
+				Currency:    util.USD,
+				BasisPoints: -1,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpsertInterestRate(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.SetInterestRateResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "DepositorNotAllowed",
+			req: &pb.SetInterestRateRequest{
+				Product:     util.SavingsProduct,
+				Currency:    util.USD,
+				BasisPoints: 250,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpsertInterestRate(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, depositor.Username, depositor.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.SetInterestRateResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := callRPC(ctx, server, pb.SimpleBank_SetInterestRate_FullMethodName, tc.req, server.SetInterestRate)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
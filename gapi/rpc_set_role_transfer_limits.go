@@ -0,0 +1,60 @@
+package gapi
+
+import (
+	"context"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) SetRoleTransferLimits(ctx context.Context, req *pb.SetRoleTransferLimitsRequest) (*pb.SetRoleTransferLimitsResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateSetRoleTransferLimitsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	limits := transferLimitsArg(req.GetLimits())
+	roleLimits, err := server.store.UpsertRoleTransferLimits(ctx, db.UpsertRoleTransferLimitsParams{
+		Role:        req.GetRole(),
+		Currency:    req.GetCurrency(),
+		MaxAmount:   limits.MaxAmount,
+		DailyAmount: limits.DailyAmount,
+		DailyCount:  limits.DailyCount,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set role transfer limits: %s", err)
+	}
+
+	rsp := &pb.SetRoleTransferLimitsResponse{
+		Role:     roleLimits.Role,
+		Currency: roleLimits.Currency,
+		Limits: convertTransferLimits(db.TransferLimits{
+			MaxAmount:   roleLimits.MaxAmount,
+			DailyAmount: roleLimits.DailyAmount,
+			DailyCount:  roleLimits.DailyCount,
+		}),
+	}
+	return rsp, nil
+}
+
+func validateSetRoleTransferLimitsRequest(req *pb.SetRoleTransferLimitsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateRole(req.GetRole()); err != nil {
+		violations = append(violations, fieldViolation("role", err))
+	}
+
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+
+	violations = append(violations, validateTransferLimits("limits", req.GetLimits())...)
+
+	return violations
+}
@@ -0,0 +1,84 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/util"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SettleWithdrawal is the payment processor's callback, it has no user behind it so it's authenticated
+// by the signature made with the secret the bank shares with the processor instead of a token
+func (server *Server) SettleWithdrawal(ctx context.Context, req *pb.SettleWithdrawalRequest) (*pb.SettleWithdrawalResponse, error) {
+	violations := validateSettleWithdrawalRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	if !util.VerifySettlement(
+		server.config.SettlementCallbackSecret,
+		req.GetWithdrawalId(),
+		req.GetStatus(),
+		req.GetProcessorReference(),
+		req.GetFailureReason(),
+		req.GetSignature(),
+	) {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid settlement signature")
+	}
+
+	result, err := server.store.SettleWithdrawalTx(ctx, db.SettleWithdrawalTxParams{
+		WithdrawalID:       req.GetWithdrawalId(),
+		Status:             req.GetStatus(),
+		ProcessorReference: req.GetProcessorReference(),
+		FailureReason:      req.GetFailureReason(),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "withdrawal not found")
+		}
+		return nil, transferTxError(err)
+	}
+
+	account, err := server.getAccount(ctx, result.Withdrawal.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.SettleWithdrawalResponse{
+		Withdrawal: convertWithdrawal(result.Withdrawal, account.Currency),
+	}
+	return rsp, nil
+}
+
+func validateSettleWithdrawalRequest(req *pb.SettleWithdrawalRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetWithdrawalId()); err != nil {
+		violations = append(violations, fieldViolation("withdrawal_id", err))
+	}
+
+	if err := val.ValidateSettlementStatus(req.GetStatus()); err != nil {
+		violations = append(violations, fieldViolation("status", err))
+	}
+
+	if req.GetProcessorReference() != "" {
+		if err := val.ValidateExternalReference(req.GetProcessorReference()); err != nil {
+			violations = append(violations, fieldViolation("processor_reference", err))
+		}
+	}
+
+	if req.GetFailureReason() != "" {
+		if err := val.ValidateReason(req.GetFailureReason()); err != nil {
+			violations = append(violations, fieldViolation("failure_reason", err))
+		}
+	}
+
+	if err := val.ValidateString(req.GetSignature(), 64, 64); err != nil {
+		violations = append(violations, fieldViolation("signature", err))
+	}
+
+	return violations
+}
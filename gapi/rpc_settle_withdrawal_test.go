@@ -0,0 +1,188 @@
+package gapi
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "goBank/db/mock"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestSettleWithdrawalAPI(t *testing.T) {
+	user, _ := randomUser(t)
+	account := randomAccount(user.Username)
+
+	withdrawal := db.Withdrawal{
+		ID:          util.RandomInt(1, 1000),
+		AccountID:   account.ID,
+		Amount:      100,
+		Status:      db.WithdrawalStatusPending,
+		RequestedBy: user.Username,
+		TransferID:  util.RandomInt(1, 1000),
+	}
+	settled := withdrawal
+	settled.Status = db.WithdrawalStatusSettled
+	settled.SettlementTransferID = pgtype.Int8{Int64: util.RandomInt(1, 1000), Valid: true}
+	settled.ProcessorReference = "payout-1"
+
+	testCases := []struct {
+		name          string
+		buildRequest  func(secret string) *pb.SettleWithdrawalRequest
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.SettleWithdrawalResponse, err error)
+	}{
+		{
+			name: "OK",
+			buildRequest: func(secret string) *pb.SettleWithdrawalRequest {
+				return &pb.SettleWithdrawalRequest{
+					WithdrawalId:       withdrawal.ID,
+					Status:             db.WithdrawalStatusSettled,
+					ProcessorReference: "payout-1",
+					Signature:          util.SignSettlement(secret, withdrawal.ID, db.WithdrawalStatusSettled, "payout-1", ""),
+				}
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.SettleWithdrawalTxParams{
+					WithdrawalID:       withdrawal.ID,
+					Status:             db.WithdrawalStatusSettled,
+					ProcessorReference: "payout-1",
+				}
+				store.EXPECT().
+					SettleWithdrawalTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.WithdrawTxResult{Withdrawal: settled}, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.SettleWithdrawalResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, db.WithdrawalStatusSettled, res.GetWithdrawal().GetStatus())
+				require.Equal(t, settled.SettlementTransferID.Int64, res.GetWithdrawal().GetSettlementTransferId())
+			},
+		},
+		{
+			name: "WrongSecret",
+			buildRequest: func(secret string) *pb.SettleWithdrawalRequest {
+				return &pb.SettleWithdrawalRequest{
+					WithdrawalId:       withdrawal.ID,
+					Status:             db.WithdrawalStatusSettled,
+					ProcessorReference: "payout-1",
+					Signature:          util.SignSettlement(util.RandomString(32), withdrawal.ID, db.WithdrawalStatusSettled, "payout-1", ""),
+				}
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SettleWithdrawalTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.SettleWithdrawalResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			name: "TamperedStatus",
+			buildRequest: func(secret string) *pb.SettleWithdrawalRequest {
+				return &pb.SettleWithdrawalRequest{
+					WithdrawalId:       withdrawal.ID,
+					Status:             db.WithdrawalStatusFailed,
+					ProcessorReference: "payout-1",
+					Signature:          util.SignSettlement(secret, withdrawal.ID, db.WithdrawalStatusSettled, "payout-1", ""),
+				}
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SettleWithdrawalTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.SettleWithdrawalResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			name: "TamperedFailureReason",
+			buildRequest: func(secret string) *pb.SettleWithdrawalRequest {
+				return &pb.SettleWithdrawalRequest{
+					WithdrawalId:  withdrawal.ID,
+					Status:        db.WithdrawalStatusFailed,
+					FailureReason: "customer asked to cancel",
+					Signature:     util.SignSettlement(secret, withdrawal.ID, db.WithdrawalStatusFailed, "", "account closed at the receiving bank"),
+				}
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SettleWithdrawalTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.SettleWithdrawalResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			name: "AlreadySettledTheOtherWay",
+			buildRequest: func(secret string) *pb.SettleWithdrawalRequest {
+				return &pb.SettleWithdrawalRequest{
+					WithdrawalId:  withdrawal.ID,
+					Status:        db.WithdrawalStatusFailed,
+					FailureReason: "account closed at the receiving bank",
+					Signature:     util.SignSettlement(secret, withdrawal.ID, db.WithdrawalStatusFailed, "", "account closed at the receiving bank"),
+				}
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SettleWithdrawalTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.WithdrawTxResult{Withdrawal: settled}, db.ErrWithdrawalSettled)
+			},
+			checkResponse: func(t *testing.T, res *pb.SettleWithdrawalResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "InvalidStatus",
+			buildRequest: func(secret string) *pb.SettleWithdrawalRequest {
+				return &pb.SettleWithdrawalRequest{
+					WithdrawalId: withdrawal.ID,
+					Status:       db.WithdrawalStatusPending,
+					Signature:    util.SignSettlement(secret, withdrawal.ID, db.WithdrawalStatusPending, "", ""),
+				}
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SettleWithdrawalTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.SettleWithdrawalResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			req := tc.buildRequest(server.config.SettlementCallbackSecret)
+			res, err := server.SettleWithdrawal(context.Background(), req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
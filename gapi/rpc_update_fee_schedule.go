@@ -0,0 +1,74 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) UpdateFeeSchedule(ctx context.Context, req *pb.UpdateFeeScheduleRequest) (*pb.UpdateFeeScheduleResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateUpdateFeeScheduleRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.ReplaceFeeScheduleTxParams{
+		Currency: req.GetCurrency(),
+	}
+	for _, tier := range req.GetTiers() {
+		arg.Tiers = append(arg.Tiers, db.FeeTier{
+			MinAmount:   tier.GetMinAmount(),
+			FlatFee:     tier.GetFlatFee(),
+			BasisPoints: tier.GetBasisPoints(),
+		})
+	}
+
+	schedules, err := server.store.ReplaceFeeScheduleTx(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update fee schedule: %s", err)
+	}
+
+	rsp := &pb.UpdateFeeScheduleResponse{
+		Currency: req.GetCurrency(),
+		Tiers:    convertFeeSchedules(schedules),
+	}
+	return rsp, nil
+}
+
+func validateUpdateFeeScheduleRequest(req *pb.UpdateFeeScheduleRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+
+	minAmounts := make(map[int64]bool)
+	for i, tier := range req.GetTiers() {
+		field := fmt.Sprintf("tiers[%d]", i)
+
+		if err := val.ValidateFee(tier.GetMinAmount()); err != nil {
+			violations = append(violations, fieldViolation(field+".min_amount", err))
+		} else if minAmounts[tier.GetMinAmount()] {
+			violations = append(violations, fieldViolation(field+".min_amount", fmt.Errorf("must be unique")))
+		}
+		minAmounts[tier.GetMinAmount()] = true
+
+		if err := val.ValidateFee(tier.GetFlatFee()); err != nil {
+			violations = append(violations, fieldViolation(field+".flat_fee", err))
+		}
+
+		if err := val.ValidateBasisPoints(tier.GetBasisPoints()); err != nil {
+			violations = append(violations, fieldViolation(field+".basis_points", err))
+		}
+	}
+
+	return violations
+}
@@ -0,0 +1,151 @@
+package gapi
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "goBank/db/mock"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/token"
+	"goBank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+func TestUpdateFeeScheduleAPI(t *testing.T) {
+	banker, _ := randomUser(t)
+	banker.Role = util.BankerRole
+	depositor, _ := randomUser(t)
+
+	tiers := []*pb.FeeTier{
+		{MinAmount: 0, FlatFee: 25},
+		{MinAmount: 10000, BasisPoints: 50},
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.UpdateFeeScheduleRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.UpdateFeeScheduleResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.UpdateFeeScheduleRequest{
+				Currency: util.USD,
+				Tiers:    tiers,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ReplaceFeeScheduleTxParams{
+					Currency: util.USD,
+					Tiers: []db.FeeTier{
+						{MinAmount: 0, FlatFee: 25},
+						{MinAmount: 10000, BasisPoints: 50},
+					},
+				}
+				store.EXPECT().
+					ReplaceFeeScheduleTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return([]db.FeeSchedule{
+						{Currency: util.USD, MinAmount: 0, FlatFee: 25},
+						{Currency: util.USD, MinAmount: 10000, BasisPoints: 50},
+					}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateFeeScheduleResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.USD, res.GetCurrency())
+				require.Len(t, res.GetTiers(), 2)
+				require.Equal(t, int64(50), res.GetTiers()[1].GetBasisPoints())
+			},
+		},
+		{
+			name: "DuplicateMinAmount",
+			req: &pb.UpdateFeeScheduleRequest{
+				Currency: util.USD,
+				Tiers: []*pb.FeeTier{
+					{MinAmount: 0, FlatFee: 25},
+					{MinAmount: 0, BasisPoints: 50},
+				},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ReplaceFeeScheduleTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateFeeScheduleResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "InvalidBasisPoints",
+			req: &pb.UpdateFeeScheduleRequest{
+				Currency: util.USD,
+				Tiers:    []*pb.FeeTier{{MinAmount: 0, BasisPoints: 10001}},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ReplaceFeeScheduleTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateFeeScheduleResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "DepositorNotAllowed",
+			req: &pb.UpdateFeeScheduleRequest{
+				Currency: util.USD,
+				Tiers:    tiers,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ReplaceFeeScheduleTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, depositor.Username, depositor.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateFeeScheduleResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := callRPC(ctx, server, pb.SimpleBank_UpdateFeeSchedule_FullMethodName, tc.req, server.UpdateFeeSchedule)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
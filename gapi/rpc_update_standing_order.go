@@ -0,0 +1,105 @@
+package gapi
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/util"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+func (server *Server) UpdateStandingOrder(ctx context.Context, req *pb.UpdateStandingOrderRequest) (*pb.UpdateStandingOrderResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateUpdateStandingOrderRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	order, err := server.getOwnedStandingOrder(ctx, req.GetId(), authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	if !order.IsActive {
+		return nil, status.Errorf(codes.FailedPrecondition, "standing order has been cancelled")
+	}
+
+	if req.Amount != nil {
+		fromAccount, err := server.getAccount(ctx, order.FromAccountID)
+		if err != nil {
+			return nil, err
+		}
+
+		if server.config.RequiresApproval(fromAccount.Currency, req.GetAmount()) {
+			return nil, status.Errorf(codes.FailedPrecondition, "amount needs approval and can't be paid by a standing order")
+		}
+	}
+
+	arg := db.UpdateStandingOrderParams{
+		ID: order.ID,
+		Amount: pgtype.Int8{
+			Int64: req.GetAmount(),
+			Valid: req.Amount != nil,
+		},
+		EndAt: pgtype.Timestamptz{
+			Time:  req.GetEndAt().AsTime(),
+			Valid: req.EndAt != nil,
+		},
+	}
+
+	//A new schedule starts over from now
+	if req.Schedule != nil {
+		nextRunAt, err := util.NextRun(req.GetSchedule(), time.Now())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to schedule next run: %s", err)
+		}
+
+		arg.Schedule = pgtype.Text{String: req.GetSchedule(), Valid: true}
+		arg.NextRunAt = pgtype.Timestamptz{Time: nextRunAt, Valid: true}
+	}
+
+	order, err = server.store.UpdateStandingOrder(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update standing order: %s", err)
+	}
+
+	rsp := &pb.UpdateStandingOrderResponse{
+		StandingOrder: convertStandingOrder(order),
+	}
+	return rsp, nil
+}
+
+func validateUpdateStandingOrderRequest(req *pb.UpdateStandingOrderRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	if req.Amount != nil {
+		if err := val.ValidateAmount(req.GetAmount()); err != nil {
+			violations = append(violations, fieldViolation("amount", err))
+		}
+	}
+
+	if req.Schedule != nil {
+		if err := val.ValidateSchedule(req.GetSchedule()); err != nil {
+			violations = append(violations, fieldViolation("schedule", err))
+		}
+	}
+
+	if req.EndAt != nil {
+		if err := val.ValidateFutureTime(req.GetEndAt().AsTime()); err != nil {
+			violations = append(violations, fieldViolation("end_at", err))
+		}
+	}
+
+	return violations
+}
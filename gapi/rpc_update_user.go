@@ -15,9 +15,9 @@ import (
 )
 
 func (server *Server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UpdateUserResponse, error) {
-	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	authPayload, err := server.authorizeUser(ctx)
 	if err != nil {
-		return nil, unauthenticatedError(err)
+		return nil, err
 	}
 
 	violations := validateUpdateUserRequest(req)
@@ -181,7 +181,7 @@ func TestUpdateUserAPI(t *testing.T) {
 			server := newTestServer(t, store, nil)
 
 			ctx := tc.buildContext(t, server.tokenMaker)
-			res, err := server.UpdateUser(ctx, tc.req)
+			res, err := callRPC(ctx, server, pb.SimpleBank_UpdateUser_FullMethodName, tc.req, server.UpdateUser)
 			tc.checkResponse(t, res, err)
 		})
 	}
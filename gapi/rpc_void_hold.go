@@ -0,0 +1,44 @@
+package gapi
+
+import (
+	"context"
+	"goBank/pb"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) VoidHold(ctx context.Context, req *pb.VoidHoldRequest) (*pb.VoidHoldResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateVoidHoldRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	_, _, err = server.getOwnedHold(ctx, req.GetHoldId(), authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := server.store.VoidHoldTx(ctx, req.GetHoldId())
+	if err != nil {
+		return nil, transferTxError(err)
+	}
+
+	rsp := &pb.VoidHoldResponse{
+		Hold:    convertHold(result.Hold),
+		Account: convertAccount(result.Account),
+	}
+	return rsp, nil
+}
+
+func validateVoidHoldRequest(req *pb.VoidHoldRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetHoldId()); err != nil {
+		violations = append(violations, fieldViolation("hold_id", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,38 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	db "goBank/db/sqlc"
+	"goBank/token"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// getRefreshSession verifies a refresh token and returns the session it was issued for at login.
+// Whether the session is blocked or expired is left to the caller.
+func (server *Server) getRefreshSession(ctx context.Context, refreshToken string) (db.Session, *token.Payload, error) {
+	refreshPayload, err := server.tokenMaker.VerifyToken(refreshToken)
+	if err != nil {
+		return db.Session{}, nil, unauthenticatedError(fmt.Errorf("invalid refresh token: %s", err))
+	}
+
+	session, err := server.store.GetSession(ctx, refreshPayload.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return db.Session{}, nil, unauthenticatedError(fmt.Errorf("session not found"))
+		}
+		return db.Session{}, nil, status.Errorf(codes.Internal, "failed to get session: %s", err)
+	}
+
+	if session.Username != refreshPayload.Username {
+		return db.Session{}, nil, unauthenticatedError(fmt.Errorf("incorrect session user"))
+	}
+
+	if session.RefreshToken != refreshToken {
+		return db.Session{}, nil, unauthenticatedError(fmt.Errorf("mismatched session token"))
+	}
+
+	return session, refreshPayload, nil
+}
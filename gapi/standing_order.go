@@ -0,0 +1,25 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	db "goBank/db/sqlc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// getOwnedStandingOrder loads a standing order and makes sure it belongs to the authenticated user.
+func (server *Server) getOwnedStandingOrder(ctx context.Context, id int64, username string) (db.StandingOrder, error) {
+	order, err := server.store.GetStandingOrder(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return order, status.Errorf(codes.NotFound, "standing order [%d] not found", id)
+		}
+		return order, status.Errorf(codes.Internal, "failed to get standing order: %s", err)
+	}
+
+	if order.Owner != username {
+		return order, status.Errorf(codes.PermissionDenied, "standing order doesn't belong to the authenticated user")
+	}
+	return order, nil
+}
@@ -0,0 +1,56 @@
+package gapi
+
+import (
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// transferDetailsArg turns the memo, reference and metadata of a request into the store's, metadata left out stays empty
+func transferDetailsArg(req *pb.CreateTransferRequest) db.TransferDetails {
+	details := db.TransferDetails{
+		Description:       req.GetDescription(),
+		ExternalReference: req.GetExternalReference(),
+	}
+	if req.GetMetadata() != nil {
+		//The validator already made sure it marshals
+		details.Metadata, _ = protojson.Marshal(req.GetMetadata())
+	}
+	return details
+}
+
+func validateTransferDetails(req *pb.CreateTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateDescription(req.GetDescription()); err != nil {
+		violations = append(violations, fieldViolation("description", err))
+	}
+
+	if req.GetExternalReference() != "" {
+		if err := val.ValidateExternalReference(req.GetExternalReference()); err != nil {
+			violations = append(violations, fieldViolation("external_reference", err))
+		}
+	}
+
+	if req.GetMetadata() != nil {
+		data, err := protojson.Marshal(req.GetMetadata())
+		if err == nil {
+			err = val.ValidateMetadata(data)
+		}
+		if err != nil {
+			violations = append(violations, fieldViolation("metadata", err))
+		}
+	}
+
+	return violations
+}
+
+// convertMetadata turns a transfer's stored metadata back into a Struct, an empty object becomes nil
+func convertMetadata(metadata []byte) *structpb.Struct {
+	var object structpb.Struct
+	if err := protojson.Unmarshal(metadata, &object); err != nil || len(object.GetFields()) == 0 {
+		return nil
+	}
+	return &object
+}
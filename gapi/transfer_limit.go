@@ -0,0 +1,41 @@
+package gapi
+
+import (
+	"fmt"
+	"github.com/jackc/pgx/v5/pgtype"
+	db "goBank/db/sqlc"
+	"goBank/pb"
+	"goBank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// transferLimitsArg turns the limits in a request into the store's, a limit that isn't set stays invalid
+func transferLimitsArg(limits *pb.TransferLimits) db.TransferLimits {
+	return db.TransferLimits{
+		MaxAmount:   pgtype.Int8{Int64: limits.GetMaxAmount(), Valid: limits.MaxAmount != nil},
+		DailyAmount: pgtype.Int8{Int64: limits.GetDailyAmount(), Valid: limits.DailyAmount != nil},
+		DailyCount:  pgtype.Int8{Int64: limits.GetDailyCount(), Valid: limits.DailyCount != nil},
+	}
+}
+
+func validateTransferLimits(field string, limits *pb.TransferLimits) (violations []*errdetails.BadRequest_FieldViolation) {
+	if limits.MaxAmount != nil {
+		if err := val.ValidateLimit(limits.GetMaxAmount()); err != nil {
+			violations = append(violations, fieldViolation(fmt.Sprintf("%s.max_amount", field), err))
+		}
+	}
+
+	if limits.DailyAmount != nil {
+		if err := val.ValidateLimit(limits.GetDailyAmount()); err != nil {
+			violations = append(violations, fieldViolation(fmt.Sprintf("%s.daily_amount", field), err))
+		}
+	}
+
+	if limits.DailyCount != nil {
+		if err := val.ValidateLimit(limits.GetDailyCount()); err != nil {
+			violations = append(violations, fieldViolation(fmt.Sprintf("%s.daily_count", field), err))
+		}
+	}
+
+	return violations
+}
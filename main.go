@@ -51,6 +51,14 @@ func main() {
 
 	store := db.NewStore(connPool)
 
+	var currencies []string
+	for _, currency := range util.SupportedCurrencies() {
+		currencies = append(currencies, currency.Code)
+	}
+	if err = store.OpenSystemAccounts(context.Background(), currencies); err != nil {
+		log.Fatal().Err(err).Msg("cannot open system accounts:")
+	}
+
 	redisOpt := asynq.RedisClientOpt{
 		Addr: config.RedisAddress,
 	}
@@ -58,7 +66,8 @@ func main() {
 	taskDistributor := worker.NewRedisTaskDistributor(redisOpt)
 
 	//SWAP between gRPC and GinServer here:
-	go runTaskProcessor(config, redisOpt, store)
+	go runTaskProcessor(config, redisOpt, store, taskDistributor)
+	go runTaskScheduler(redisOpt)
 	go runGatewayServer(config, store, taskDistributor)
 	runGrpcServer(config, store, taskDistributor)
 }
@@ -78,9 +87,9 @@ func runDBMigration(migrationURL string, dbSource string) {
 
 }
 
-func runTaskProcessor(config util.Config, redisOpt asynq.RedisClientOpt, store db.Store) {
+func runTaskProcessor(config util.Config, redisOpt asynq.RedisClientOpt, store db.Store, taskDistributor worker.TaskDistributor) {
 	mailer := mail.NewGmailSender(config.EmailSenderName, config.EmailSenderAddress, config.EmailSenderPassword)
-	taskProcessor := worker.NewRedisTaskProcessor(redisOpt, store, mailer)
+	taskProcessor := worker.NewRedisTaskProcessor(redisOpt, store, mailer, taskDistributor)
 	log.Info().Msg("start task processor")
 	err := taskProcessor.Start()
 	if err != nil {
@@ -88,16 +97,27 @@ func runTaskProcessor(config util.Config, redisOpt asynq.RedisClientOpt, store d
 	}
 }
 
+func runTaskScheduler(redisOpt asynq.RedisClientOpt) {
+	taskScheduler := worker.NewRedisTaskScheduler(redisOpt)
+	log.Info().Msg("start task scheduler")
+	err := taskScheduler.Start()
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to start task scheduler")
+	}
+}
+
 func runGrpcServer(config util.Config, store db.Store, taskDistributor worker.TaskDistributor) {
 	server, err := gapi.NewServer(config, store, taskDistributor)
 	if err != nil {
 		log.Fatal().Err(err).Msg("cannot connect to db:")
 	}
 
-	//Intercepting to write the terminal logs.
-	grpcLogger := grpc.UnaryInterceptor(gapi.GRPCLogger)
+	//Intercepting to write the terminal logs, then to check the caller against the method's policy.
+	//The logger goes first so rejected calls are logged too.
+	unaryInterceptors := grpc.ChainUnaryInterceptor(gapi.GRPCLogger, server.UnaryAuthInterceptor)
+	streamInterceptors := grpc.ChainStreamInterceptor(server.StreamAuthInterceptor)
 	//Can pass all sorts of interceptors to this server method call.
-	grpcServer := grpc.NewServer(grpcLogger)
+	grpcServer := grpc.NewServer(unaryInterceptors, streamInterceptors)
 	pb.RegisterSimpleBankServer(grpcServer, server)
 
 	//This allows the client to export what gRPC's are available on the server.
@@ -132,7 +152,7 @@ func runGatewayServer(config util.Config, store db.Store, taskDistributor worker
 		},
 	})
 
-	grpcMux := runtime.NewServeMux(jsonOption)
+	grpcMux := runtime.NewServeMux(jsonOption, runtime.WithIncomingHeaderMatcher(gapi.GatewayHeaderMatcher))
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -144,6 +164,7 @@ func runGatewayServer(config util.Config, store db.Store, taskDistributor worker
 
 	mux := http.NewServeMux()
 	mux.Handle("/", grpcMux)
+	mux.Handle(gapi.JWKSPath, server.JWKSHandler())
 
 	statikFS, err := fs.New()
 
@@ -172,8 +193,8 @@ func runGatewayServer(config util.Config, store db.Store, taskDistributor worker
 	}
 }
 
-func runGinServer(config util.Config, store db.Store) {
-	server, err := api.NewServer(config, store)
+func runGinServer(config util.Config, store db.Store, taskDistributor worker.TaskDistributor) {
+	server, err := api.NewServer(config, store, taskDistributor)
 	if err != nil {
 		log.Fatal().Err(err).Msg("cannot connect to db:")
 	}
@@ -0,0 +1,202 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.24.3
+// source: rpc_reconcile_ledger.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReconcileLedgerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReconcileLedgerRequest) Reset() {
+	*x = ReconcileLedgerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_reconcile_ledger_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconcileLedgerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileLedgerRequest) ProtoMessage() {}
+
+func (x *ReconcileLedgerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_reconcile_ledger_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileLedgerRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileLedgerRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_reconcile_ledger_proto_rawDescGZIP(), []int{0}
+}
+
+type ReconcileLedgerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Report *ReconciliationReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+}
+
+func (x *ReconcileLedgerResponse) Reset() {
+	*x = ReconcileLedgerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_reconcile_ledger_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconcileLedgerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileLedgerResponse) ProtoMessage() {}
+
+func (x *ReconcileLedgerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_reconcile_ledger_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileLedgerResponse.ProtoReflect.Descriptor instead.
+func (*ReconcileLedgerResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_reconcile_ledger_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReconcileLedgerResponse) GetReport() *ReconciliationReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+var File_rpc_reconcile_ledger_proto protoreflect.FileDescriptor
+
+var file_rpc_reconcile_ledger_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x72, 0x70, 0x63, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x5f,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62,
+	0x1a, 0x14, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x18, 0x0a, 0x16, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x65, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x4b, 0x0a, 0x17, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62,
+	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x0b, 0x5a,
+	0x09, 0x67, 0x6f, 0x42, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_rpc_reconcile_ledger_proto_rawDescOnce sync.Once
+	file_rpc_reconcile_ledger_proto_rawDescData = file_rpc_reconcile_ledger_proto_rawDesc
+)
+
+func file_rpc_reconcile_ledger_proto_rawDescGZIP() []byte {
+	file_rpc_reconcile_ledger_proto_rawDescOnce.Do(func() {
+		file_rpc_reconcile_ledger_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_reconcile_ledger_proto_rawDescData)
+	})
+	return file_rpc_reconcile_ledger_proto_rawDescData
+}
+
+var file_rpc_reconcile_ledger_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_reconcile_ledger_proto_goTypes = []interface{}{
+	(*ReconcileLedgerRequest)(nil),  // 0: pb.ReconcileLedgerRequest
+	(*ReconcileLedgerResponse)(nil), // 1: pb.ReconcileLedgerResponse
+	(*ReconciliationReport)(nil),    // 2: pb.ReconciliationReport
+}
+var file_rpc_reconcile_ledger_proto_depIdxs = []int32{
+	2, // 0: pb.ReconcileLedgerResponse.report:type_name -> pb.ReconciliationReport
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_reconcile_ledger_proto_init() }
+func file_rpc_reconcile_ledger_proto_init() {
+	if File_rpc_reconcile_ledger_proto != nil {
+		return
+	}
+	file_reconciliation_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_reconcile_ledger_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconcileLedgerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_reconcile_ledger_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconcileLedgerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_reconcile_ledger_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_reconcile_ledger_proto_goTypes,
+		DependencyIndexes: file_rpc_reconcile_ledger_proto_depIdxs,
+		MessageInfos:      file_rpc_reconcile_ledger_proto_msgTypes,
+	}.Build()
+	File_rpc_reconcile_ledger_proto = out.File
+	file_rpc_reconcile_ledger_proto_rawDesc = nil
+	file_rpc_reconcile_ledger_proto_goTypes = nil
+	file_rpc_reconcile_ledger_proto_depIdxs = nil
+}
@@ -0,0 +1,231 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.24.3
+// source: rpc_set_interest_rate.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SetInterestRateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Product     string `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Currency    string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	BasisPoints int64  `protobuf:"varint,3,opt,name=basis_points,json=basisPoints,proto3" json:"basis_points,omitempty"`
+}
+
+func (x *SetInterestRateRequest) Reset() {
+	*x = SetInterestRateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_set_interest_rate_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetInterestRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInterestRateRequest) ProtoMessage() {}
+
+func (x *SetInterestRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_set_interest_rate_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInterestRateRequest.ProtoReflect.Descriptor instead.
+func (*SetInterestRateRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_set_interest_rate_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SetInterestRateRequest) GetProduct() string {
+	if x != nil {
+		return x.Product
+	}
+	return ""
+}
+
+func (x *SetInterestRateRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *SetInterestRateRequest) GetBasisPoints() int64 {
+	if x != nil {
+		return x.BasisPoints
+	}
+	return 0
+}
+
+type SetInterestRateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rate *InterestRate `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate,omitempty"`
+}
+
+func (x *SetInterestRateResponse) Reset() {
+	*x = SetInterestRateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_set_interest_rate_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetInterestRateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInterestRateResponse) ProtoMessage() {}
+
+func (x *SetInterestRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_set_interest_rate_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInterestRateResponse.ProtoReflect.Descriptor instead.
+func (*SetInterestRateResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_set_interest_rate_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetInterestRateResponse) GetRate() *InterestRate {
+	if x != nil {
+		return x.Rate
+	}
+	return nil
+}
+
+var File_rpc_set_interest_rate_proto protoreflect.FileDescriptor
+
+var file_rpc_set_interest_rate_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x72, 0x70, 0x63, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65,
+	0x73, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70,
+	0x62, 0x1a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x71, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74,
+	0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x22, 0x3f, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x24, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x70, 0x62, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x52,
+	0x04, 0x72, 0x61, 0x74, 0x65, 0x42, 0x0b, 0x5a, 0x09, 0x67, 0x6f, 0x42, 0x61, 0x6e, 0x6b, 0x2f,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_set_interest_rate_proto_rawDescOnce sync.Once
+	file_rpc_set_interest_rate_proto_rawDescData = file_rpc_set_interest_rate_proto_rawDesc
+)
+
+func file_rpc_set_interest_rate_proto_rawDescGZIP() []byte {
+	file_rpc_set_interest_rate_proto_rawDescOnce.Do(func() {
+		file_rpc_set_interest_rate_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_set_interest_rate_proto_rawDescData)
+	})
+	return file_rpc_set_interest_rate_proto_rawDescData
+}
+
+var file_rpc_set_interest_rate_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_set_interest_rate_proto_goTypes = []interface{}{
+	(*SetInterestRateRequest)(nil),  // 0: pb.SetInterestRateRequest
+	(*SetInterestRateResponse)(nil), // 1: pb.SetInterestRateResponse
+	(*InterestRate)(nil),            // 2: pb.InterestRate
+}
+var file_rpc_set_interest_rate_proto_depIdxs = []int32{
+	2, // 0: pb.SetInterestRateResponse.rate:type_name -> pb.InterestRate
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_set_interest_rate_proto_init() }
+func file_rpc_set_interest_rate_proto_init() {
+	if File_rpc_set_interest_rate_proto != nil {
+		return
+	}
+	file_interest_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_set_interest_rate_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetInterestRateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_set_interest_rate_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetInterestRateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_set_interest_rate_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_set_interest_rate_proto_goTypes,
+		DependencyIndexes: file_rpc_set_interest_rate_proto_depIdxs,
+		MessageInfos:      file_rpc_set_interest_rate_proto_msgTypes,
+	}.Build()
+	File_rpc_set_interest_rate_proto = out.File
+	file_rpc_set_interest_rate_proto_rawDesc = nil
+	file_rpc_set_interest_rate_proto_goTypes = nil
+	file_rpc_set_interest_rate_proto_depIdxs = nil
+}
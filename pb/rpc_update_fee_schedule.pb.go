@@ -0,0 +1,233 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.24.3
+// source: rpc_update_fee_schedule.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type UpdateFeeScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Currency string     `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Tiers    []*FeeTier `protobuf:"bytes,2,rep,name=tiers,proto3" json:"tiers,omitempty"`
+}
+
+func (x *UpdateFeeScheduleRequest) Reset() {
+	*x = UpdateFeeScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_update_fee_schedule_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateFeeScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFeeScheduleRequest) ProtoMessage() {}
+
+func (x *UpdateFeeScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_update_fee_schedule_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFeeScheduleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFeeScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_update_fee_schedule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UpdateFeeScheduleRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *UpdateFeeScheduleRequest) GetTiers() []*FeeTier {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+type UpdateFeeScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Currency string     `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Tiers    []*FeeTier `protobuf:"bytes,2,rep,name=tiers,proto3" json:"tiers,omitempty"`
+}
+
+func (x *UpdateFeeScheduleResponse) Reset() {
+	*x = UpdateFeeScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_update_fee_schedule_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateFeeScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFeeScheduleResponse) ProtoMessage() {}
+
+func (x *UpdateFeeScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_update_fee_schedule_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFeeScheduleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateFeeScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_update_fee_schedule_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateFeeScheduleResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *UpdateFeeScheduleResponse) GetTiers() []*FeeTier {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+var File_rpc_update_fee_schedule_proto protoreflect.FileDescriptor
+
+var file_rpc_update_fee_schedule_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x72, 0x70, 0x63, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x65, 0x65,
+	0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x02, 0x70, 0x62, 0x1a, 0x12, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x59, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x46, 0x65, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x21, 0x0a, 0x05, 0x74, 0x69, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x70, 0x62, 0x2e, 0x46, 0x65, 0x65, 0x54, 0x69, 0x65, 0x72, 0x52, 0x05, 0x74, 0x69, 0x65,
+	0x72, 0x73, 0x22, 0x5a, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x21, 0x0a, 0x05, 0x74,
+	0x69, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e,
+	0x46, 0x65, 0x65, 0x54, 0x69, 0x65, 0x72, 0x52, 0x05, 0x74, 0x69, 0x65, 0x72, 0x73, 0x42, 0x0b,
+	0x5a, 0x09, 0x67, 0x6f, 0x42, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_update_fee_schedule_proto_rawDescOnce sync.Once
+	file_rpc_update_fee_schedule_proto_rawDescData = file_rpc_update_fee_schedule_proto_rawDesc
+)
+
+func file_rpc_update_fee_schedule_proto_rawDescGZIP() []byte {
+	file_rpc_update_fee_schedule_proto_rawDescOnce.Do(func() {
+		file_rpc_update_fee_schedule_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_update_fee_schedule_proto_rawDescData)
+	})
+	return file_rpc_update_fee_schedule_proto_rawDescData
+}
+
+var file_rpc_update_fee_schedule_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_update_fee_schedule_proto_goTypes = []interface{}{
+	(*UpdateFeeScheduleRequest)(nil),  // 0: pb.UpdateFeeScheduleRequest
+	(*UpdateFeeScheduleResponse)(nil), // 1: pb.UpdateFeeScheduleResponse
+	(*FeeTier)(nil),                   // 2: pb.FeeTier
+}
+var file_rpc_update_fee_schedule_proto_depIdxs = []int32{
+	2, // 0: pb.UpdateFeeScheduleRequest.tiers:type_name -> pb.FeeTier
+	2, // 1: pb.UpdateFeeScheduleResponse.tiers:type_name -> pb.FeeTier
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpc_update_fee_schedule_proto_init() }
+func file_rpc_update_fee_schedule_proto_init() {
+	if File_rpc_update_fee_schedule_proto != nil {
+		return
+	}
+	file_fee_schedule_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_update_fee_schedule_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFeeScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_update_fee_schedule_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFeeScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_update_fee_schedule_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_update_fee_schedule_proto_goTypes,
+		DependencyIndexes: file_rpc_update_fee_schedule_proto_depIdxs,
+		MessageInfos:      file_rpc_update_fee_schedule_proto_msgTypes,
+	}.Build()
+	File_rpc_update_fee_schedule_proto = out.File
+	file_rpc_update_fee_schedule_proto_rawDesc = nil
+	file_rpc_update_fee_schedule_proto_goTypes = nil
+	file_rpc_update_fee_schedule_proto_depIdxs = nil
+}
@@ -0,0 +1,135 @@
+package statement
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"html/template"
+	"strconv"
+	"strings"
+	"time"
+
+	db "goBank/db/sqlc"
+	"goBank/util"
+)
+
+// dateLayout is how statement periods and entry dates are written
+const dateLayout = "2006-01-02 15:04:05 MST"
+
+var csvHeader = []string{"date", "entry_id", "transfer_id", "description", "external_reference", "amount", "balance"}
+
+// CSV renders the statement as a spreadsheet, one row per entry between an opening and a closing balance row.
+// Amounts are decimals in the account's currency.
+func CSV(statement db.StatementTxResult) (string, error) {
+	currency := statement.Account.Currency
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	rows := [][]string{
+		csvHeader,
+		{formatDate(statement.PeriodStart), "", "", "Opening balance", "", "", util.FormatAmount(currency, statement.OpeningBalance)},
+	}
+	for _, line := range statement.Lines {
+		transferID := ""
+		if line.TransferID.Valid {
+			transferID = strconv.FormatInt(line.TransferID.Int64, 10)
+		}
+		rows = append(rows, []string{
+			formatDate(line.CreatedAt),
+			strconv.FormatInt(line.ID, 10),
+			transferID,
+			escapeFormula(line.Description),
+			escapeFormula(line.ExternalReference),
+			util.FormatAmount(currency, line.Amount),
+			util.FormatAmount(currency, line.Balance),
+		})
+	}
+	rows = append(rows, []string{formatDate(statement.PeriodEnd), "", "", "Closing balance", "", "", util.FormatAmount(currency, statement.ClosingBalance)})
+
+	if err := writer.WriteAll(rows); err != nil {
+		return "", fmt.Errorf("failed to write csv: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// HTML renders the statement as a standalone page laid out to be printed or saved as a PDF
+func HTML(statement db.StatementTxResult) (string, error) {
+	var buf bytes.Buffer
+	if err := htmlTemplate.Execute(&buf, statement); err != nil {
+		return "", fmt.Errorf("failed to render html: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// FileName is what an attachment of the statement in format, csv or html, is called
+func FileName(statement db.StatementTxResult, format string) string {
+	return fmt.Sprintf("statement-%d-%s-%s.%s", statement.Account.ID,
+		statement.PeriodStart.UTC().Format("20060102"), statement.PeriodEnd.UTC().Format("20060102"), format)
+}
+
+func formatDate(t time.Time) string {
+	return t.UTC().Format(dateLayout)
+}
+
+// escapeFormula keeps a spreadsheet from running user written text that starts like a formula
+func escapeFormula(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+var htmlTemplate = template.Must(template.New("statement").Funcs(template.FuncMap{
+	"amount": func(statement db.StatementTxResult, amount int64) string {
+		return util.FormatAmount(statement.Account.Currency, amount)
+	},
+	"date": formatDate,
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Statement of account #{{.Account.ID}}</title>
+<style>
+  body { font-family: Helvetica, Arial, sans-serif; font-size: 12px; margin: 24px; color: #222; }
+  h1 { font-size: 20px; margin-bottom: 4px; }
+  table { width: 100%; border-collapse: collapse; margin-top: 16px; }
+  th, td { padding: 4px 8px; border-bottom: 1px solid #ddd; text-align: left; }
+  td.amount, th.amount { text-align: right; font-variant-numeric: tabular-nums; }
+  tr.balance td { font-weight: bold; background: #f4f4f4; }
+  @page { size: A4; margin: 16mm; }
+  @media print {
+    body { margin: 0; }
+    thead { display: table-header-group; }
+    tr { page-break-inside: avoid; }
+  }
+</style>
+</head>
+<body>
+<h1>Account statement</h1>
+<p>
+  Account #{{.Account.ID}} &middot; {{.Account.Owner}} &middot; {{.Account.Currency}}<br>
+  {{date .PeriodStart}} to {{date .PeriodEnd}}
+</p>
+<table>
+  <tr><td>Opening balance</td><td class="amount">{{amount . .OpeningBalance}}</td></tr>
+  <tr><td>Money in</td><td class="amount">{{amount . .TotalCredits}}</td></tr>
+  <tr><td>Money out</td><td class="amount">{{amount . .TotalDebits}}</td></tr>
+  <tr><td>Closing balance</td><td class="amount">{{amount . .ClosingBalance}}</td></tr>
+</table>
+<table>
+  <thead>
+    <tr><th>Date</th><th>Transfer</th><th>Description</th><th>Reference</th><th class="amount">Amount</th><th class="amount">Balance</th></tr>
+  </thead>
+  <tbody>
+    <tr class="balance"><td>{{date .PeriodStart}}</td><td></td><td>Opening balance</td><td></td><td></td><td class="amount">{{amount . .OpeningBalance}}</td></tr>
+    {{- $statement := .}}
+    {{- range .Lines}}
+    <tr><td>{{date .CreatedAt}}</td><td>{{if .TransferID.Valid}}#{{.TransferID.Int64}}{{end}}</td><td>{{.Description}}</td><td>{{.ExternalReference}}</td><td class="amount">{{amount $statement .Amount}}</td><td class="amount">{{amount $statement .Balance}}</td></tr>
+    {{- end}}
+    <tr class="balance"><td>{{date .PeriodEnd}}</td><td></td><td>Closing balance</td><td></td><td></td><td class="amount">{{amount . .ClosingBalance}}</td></tr>
+  </tbody>
+</table>
+</body>
+</html>
+`))
@@ -0,0 +1,82 @@
+package statement
+
+import (
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	db "goBank/db/sqlc"
+	"goBank/util"
+)
+
+func testStatement() db.StatementTxResult {
+	start := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+
+	return db.StatementTxResult{
+		Account:        db.Account{ID: 7, Owner: "alice", Currency: util.USD},
+		PeriodStart:    start,
+		PeriodEnd:      end,
+		OpeningBalance: 10000,
+		ClosingBalance: 9050,
+		TotalCredits:   50,
+		TotalDebits:    1000,
+		Lines: []db.StatementLine{
+			{
+				ListStatementEntriesRow: db.ListStatementEntriesRow{
+					ID:          1,
+					Amount:      -1000,
+					TransferID:  pgtype.Int8{Int64: 3, Valid: true},
+					CreatedAt:   start.Add(time.Hour),
+					Description: "=HYPERLINK(\"http://evil\")",
+				},
+				Balance: 9000,
+			},
+			{
+				ListStatementEntriesRow: db.ListStatementEntriesRow{
+					ID:                2,
+					Amount:            50,
+					CreatedAt:         start.Add(2 * time.Hour),
+					Description:       "<b>refund</b>",
+					ExternalReference: "INV-1",
+				},
+				Balance: 9050,
+			},
+		},
+	}
+}
+
+func TestCSV(t *testing.T) {
+	content, err := CSV(testStatement())
+	require.NoError(t, err)
+
+	rows, err := csv.NewReader(strings.NewReader(content)).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 5)
+
+	require.Equal(t, csvHeader, rows[0])
+	require.Equal(t, []string{"2024-03-01 00:00:00 UTC", "", "", "Opening balance", "", "", "100.00"}, rows[1])
+	require.Equal(t, []string{"2024-03-01 01:00:00 UTC", "1", "3", "'=HYPERLINK(\"http://evil\")", "", "-10.00", "90.00"}, rows[2])
+	require.Equal(t, []string{"2024-03-01 02:00:00 UTC", "2", "", "<b>refund</b>", "INV-1", "0.50", "90.50"}, rows[3])
+	require.Equal(t, []string{"2024-04-01 00:00:00 UTC", "", "", "Closing balance", "", "", "90.50"}, rows[4])
+}
+
+func TestHTML(t *testing.T) {
+	content, err := HTML(testStatement())
+	require.NoError(t, err)
+
+	require.Contains(t, content, "Account #7")
+	require.Contains(t, content, "@media print")
+	require.Contains(t, content, "&lt;b&gt;refund&lt;/b&gt;")
+	require.NotContains(t, content, "<b>refund</b>")
+	require.Contains(t, content, "#3")
+	require.Contains(t, content, "90.50")
+	require.Contains(t, content, "-10.00")
+}
+
+func TestFileName(t *testing.T) {
+	require.Equal(t, "statement-7-20240301-20240401.csv", FileName(testStatement(), "csv"))
+}
@@ -0,0 +1,85 @@
+package token
+
+import (
+	"errors"
+	"github.com/dgrijalva/jwt-go"
+	"time"
+)
+
+// AsymmetricJWTMaker is a JSON Web Token Maker that signs with a private key,
+// EdDSA for an Ed25519 key and RS256 for an RSA key, and names the key in the "kid" header
+type AsymmetricJWTMaker struct {
+	keys *KeySet
+}
+
+// NewAsymmetricJWTMaker creates a new AsymmetricJWTMaker
+func NewAsymmetricJWTMaker(keys *KeySet) (Maker, error) {
+	return &AsymmetricJWTMaker{keys}, nil
+}
+
+func (maker *AsymmetricJWTMaker) CreateToken(username string, role string, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewPayload(username, role, duration)
+	if err != nil {
+		return "", payload, err
+	}
+
+	jwtToken := jwt.NewWithClaims(signingMethod(maker.keys.signingKey.Public()), payload)
+	jwtToken.Header["kid"] = maker.keys.signingKeyID
+
+	token, err := jwtToken.SignedString(maker.keys.signingKey)
+
+	return token, payload, err
+}
+
+// VerifyToken checks if the token is valid or not.
+// It has to be signed by one of the accepted keys, with the algorithm that key signs with.
+func (maker *AsymmetricJWTMaker) VerifyToken(token string) (*Payload, error) {
+	keyFunc := func(token *jwt.Token) (interface{}, error) {
+		keyID, ok := token.Header["kid"].(string)
+		if !ok {
+			return nil, ErrInvalidToken
+		}
+
+		publicKey, ok := maker.keys.publicKey(keyID)
+		if !ok {
+			return nil, ErrInvalidToken
+		}
+
+		if token.Method.Alg() != algorithm(publicKey) {
+			return nil, ErrInvalidToken
+		}
+
+		return publicKey, nil
+	}
+
+	jwtToken, err := jwt.ParseWithClaims(token, &Payload{}, keyFunc)
+	if err != nil {
+		verr, ok := err.(*jwt.ValidationError)
+		if ok && errors.Is(verr.Inner, ErrExpiredToken) {
+			return nil, ErrExpiredToken
+		}
+		return nil, ErrInvalidToken
+	}
+
+	payload, ok := jwtToken.Claims.(*Payload)
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+
+	return payload, nil
+}
+
+// JWKS returns the public keys tokens are accepted from
+func (maker *AsymmetricJWTMaker) JWKS() JSONWebKeySet {
+	return maker.keys.JWKS()
+}
+
+func signingMethod(publicKey interface{}) jwt.SigningMethod {
+	if algorithm(publicKey) == AlgorithmRS256 {
+		return jwt.SigningMethodRS256
+	}
+	return SigningMethodEdDSA
+}
+
+var _ Maker = (*AsymmetricJWTMaker)(nil)
+var _ KeyPublisher = (*AsymmetricJWTMaker)(nil)
@@ -0,0 +1,180 @@
+package token
+
+import (
+	"crypto"
+	"goBank/util"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAsymmetricJWTMaker(t *testing.T, signingKey crypto.PrivateKey, publicKeys ...crypto.PublicKey) Maker {
+	maker, err := NewAsymmetricJWTMaker(newTestKeySet(t, signingKey, publicKeys...))
+	require.NoError(t, err)
+	return maker
+}
+
+func TestAsymmetricJWTMaker(t *testing.T) {
+	testCases := []struct {
+		name       string
+		signingKey crypto.Signer
+		alg        string
+	}{
+		{
+			name:       "Ed25519",
+			signingKey: randomEd25519Key(t),
+			alg:        AlgorithmEdDSA,
+		},
+		{
+			name:       "RSA",
+			signingKey: randomRSAKey(t),
+			alg:        AlgorithmRS256,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			maker := newTestAsymmetricJWTMaker(t, tc.signingKey)
+
+			username := util.RandomOwner()
+			role := util.DepositorRole
+			duration := time.Minute
+
+			issuedAt := time.Now()
+			expiredAt := issuedAt.Add(duration)
+
+			token, payload, err := maker.CreateToken(username, role, duration)
+			require.NoError(t, err)
+			require.NotEmpty(t, token)
+			require.NotEmpty(t, payload)
+
+			parsed, _, err := new(jwt.Parser).ParseUnverified(token, &Payload{})
+			require.NoError(t, err)
+			require.Equal(t, tc.alg, parsed.Header["alg"])
+			keyID, err := KeyID(tc.signingKey.Public())
+			require.NoError(t, err)
+			require.Equal(t, keyID, parsed.Header["kid"])
+
+			payload, err = maker.VerifyToken(token)
+			require.NoError(t, err)
+			require.NotEmpty(t, payload)
+
+			require.NotZero(t, payload.ID)
+			require.Equal(t, username, payload.Username)
+			require.Equal(t, role, payload.Role)
+			require.WithinDuration(t, issuedAt, payload.IssuedAt, time.Second)
+			require.WithinDuration(t, expiredAt, payload.ExpiredAt, time.Second)
+		})
+	}
+}
+
+func TestExpiredAsymmetricJWTToken(t *testing.T) {
+	maker := newTestAsymmetricJWTMaker(t, randomEd25519Key(t))
+
+	token, _, err := maker.CreateToken(util.RandomOwner(), util.DepositorRole, -time.Minute)
+	require.NoError(t, err)
+
+	payload, err := maker.VerifyToken(token)
+	require.EqualError(t, err, ErrExpiredToken.Error())
+	require.Nil(t, payload)
+}
+
+func TestAsymmetricJWTKeyRotation(t *testing.T) {
+	previousKey := randomEd25519Key(t)
+	nextKey := randomRSAKey(t)
+
+	previousMaker := newTestAsymmetricJWTMaker(t, previousKey)
+	token, _, err := previousMaker.CreateToken(util.RandomOwner(), util.DepositorRole, time.Minute)
+	require.NoError(t, err)
+
+	// signing with the next key while the previous one is still accepted
+	rotatedMaker := newTestAsymmetricJWTMaker(t, nextKey, previousKey.Public())
+	payload, err := rotatedMaker.VerifyToken(token)
+	require.NoError(t, err)
+	require.NotNil(t, payload)
+
+	newToken, _, err := rotatedMaker.CreateToken(util.RandomOwner(), util.DepositorRole, time.Minute)
+	require.NoError(t, err)
+	_, err = previousMaker.VerifyToken(newToken)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+
+	// the previous key is retired
+	retiredMaker := newTestAsymmetricJWTMaker(t, nextKey)
+	payload, err = retiredMaker.VerifyToken(token)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+	require.Nil(t, payload)
+
+	_, err = retiredMaker.VerifyToken(newToken)
+	require.NoError(t, err)
+}
+
+func TestInvalidAsymmetricJWTToken(t *testing.T) {
+	signingKey := randomRSAKey(t)
+	maker := newTestAsymmetricJWTMaker(t, signingKey)
+
+	keyID, err := KeyID(signingKey.Public())
+	require.NoError(t, err)
+
+	payload, err := NewPayload(util.RandomOwner(), util.DepositorRole, time.Minute)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		signToken func(t *testing.T) string
+	}{
+		{
+			name: "AlgNone",
+			signToken: func(t *testing.T) string {
+				jwtToken := jwt.NewWithClaims(jwt.SigningMethodNone, payload)
+				jwtToken.Header["kid"] = keyID
+				token, err := jwtToken.SignedString(jwt.UnsafeAllowNoneSignatureType)
+				require.NoError(t, err)
+				return token
+			},
+		},
+		{
+			// an HMAC keyed with the public key must not pass for a signature
+			name: "AlgConfusion",
+			signToken: func(t *testing.T) string {
+				jwtToken := jwt.NewWithClaims(jwt.SigningMethodHS256, payload)
+				jwtToken.Header["kid"] = keyID
+				token, err := jwtToken.SignedString(encodePublicKeyPEM(t, signingKey.Public()))
+				require.NoError(t, err)
+				return token
+			},
+		},
+		{
+			name: "MissingKeyID",
+			signToken: func(t *testing.T) string {
+				token, err := jwt.NewWithClaims(jwt.SigningMethodRS256, payload).SignedString(signingKey)
+				require.NoError(t, err)
+				return token
+			},
+		},
+		{
+			name: "UnknownKey",
+			signToken: func(t *testing.T) string {
+				otherKey := randomEd25519Key(t)
+				jwtToken := jwt.NewWithClaims(SigningMethodEdDSA, payload)
+				jwtToken.Header["kid"] = keyID
+				token, err := jwtToken.SignedString(otherKey)
+				require.NoError(t, err)
+				return token
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			payload, err := maker.VerifyToken(tc.signToken(t))
+			require.EqualError(t, err, ErrInvalidToken.Error())
+			require.Nil(t, payload)
+		})
+	}
+}
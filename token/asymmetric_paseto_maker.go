@@ -0,0 +1,125 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"aidanwoods.dev/go-paseto"
+)
+
+// AsymmetricPasetoMaker is a Maker of PASETO v4.public tokens, signed with an Ed25519 key
+// named by the "kid" in the token's footer
+type AsymmetricPasetoMaker struct {
+	keys       *KeySet
+	signingKey paseto.V4AsymmetricSecretKey
+	publicKeys map[string]paseto.V4AsymmetricPublicKey
+}
+
+// pasetoFooter is the unencrypted footer of a token, it's authenticated along with the claims
+type pasetoFooter struct {
+	KeyID string `json:"kid"`
+}
+
+// NewAsymmetricPasetoMaker creates a new AsymmetricPasetoMaker, v4.public only signs with Ed25519 keys
+func NewAsymmetricPasetoMaker(keys *KeySet) (Maker, error) {
+	privateKey, ok := keys.signingKey.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("invalid signing key: PASETO v4.public needs an Ed25519 key")
+	}
+
+	signingKey, err := paseto.NewV4AsymmetricSecretKeyFromEd25519(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	maker := &AsymmetricPasetoMaker{
+		keys:       keys,
+		signingKey: signingKey,
+		publicKeys: make(map[string]paseto.V4AsymmetricPublicKey, len(keys.publicKeys)),
+	}
+
+	for keyID, publicKey := range keys.publicKeys {
+		key, ok := publicKey.(ed25519.PublicKey)
+		if !ok {
+			return nil, fmt.Errorf("invalid public key %s: PASETO v4.public needs an Ed25519 key", keyID)
+		}
+
+		maker.publicKeys[keyID], err = paseto.NewV4AsymmetricPublicKeyFromEd25519(key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return maker, nil
+}
+
+// CreateToken creates a new token for a specific username and duration
+func (maker *AsymmetricPasetoMaker) CreateToken(username string, role string, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewPayload(username, role, duration)
+	if err != nil {
+		return "", payload, err
+	}
+
+	footer, err := json.Marshal(pasetoFooter{KeyID: maker.keys.signingKeyID})
+	if err != nil {
+		return "", payload, err
+	}
+
+	token := newPasetoToken(payload)
+	token.SetFooter(footer)
+
+	return token.V4Sign(maker.signingKey, nil), payload, nil
+}
+
+// VerifyToken checks if the token is valid or not.
+// It has to be signed by one of the accepted keys.
+func (maker *AsymmetricPasetoMaker) VerifyToken(token string) (*Payload, error) {
+	// expiry is checked on the payload so it's reported as ErrExpiredToken
+	parser := paseto.NewParserWithoutExpiryCheck()
+
+	// the footer is only trusted to pick the key, the signature covers it
+	data, err := parser.UnsafeParseFooter(paseto.V4Public, token)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	var footer pasetoFooter
+	if err := json.Unmarshal(data, &footer); err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	publicKey, ok := maker.publicKeys[footer.KeyID]
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+
+	parsedToken, err := parser.ParseV4Public(publicKey, token, nil)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	payload, err := payloadFromClaims(parsedToken)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	if err := payload.Valid(); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// JWKS returns the public keys tokens are accepted from
+func (maker *AsymmetricPasetoMaker) JWKS() JSONWebKeySet {
+	jwks := maker.keys.JWKS()
+	// PASETO isn't a JOSE algorithm, v4.public signs with the Ed25519 keys as they are
+	for i := range jwks.Keys {
+		jwks.Keys[i].Alg = ""
+	}
+	return jwks
+}
+
+var _ Maker = (*AsymmetricPasetoMaker)(nil)
+var _ KeyPublisher = (*AsymmetricPasetoMaker)(nil)
@@ -0,0 +1,98 @@
+package token
+
+import (
+	"crypto"
+	"goBank/util"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAsymmetricPasetoMaker(t *testing.T, signingKey crypto.PrivateKey, publicKeys ...crypto.PublicKey) Maker {
+	maker, err := NewAsymmetricPasetoMaker(newTestKeySet(t, signingKey, publicKeys...))
+	require.NoError(t, err)
+	return maker
+}
+
+func TestAsymmetricPasetoMaker(t *testing.T) {
+	maker := newTestAsymmetricPasetoMaker(t, randomEd25519Key(t))
+
+	username := util.RandomOwner()
+	role := util.BankerRole
+	duration := time.Minute
+
+	issuedAt := time.Now()
+	expiredAt := issuedAt.Add(duration)
+
+	token, payload, err := maker.CreateToken(username, role, duration)
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+	require.NotEmpty(t, payload)
+
+	verified, err := maker.VerifyToken(token)
+	require.NoError(t, err)
+	require.NotEmpty(t, verified)
+
+	require.Equal(t, payload.ID, verified.ID)
+	require.Equal(t, username, verified.Username)
+	require.Equal(t, role, verified.Role)
+	require.WithinDuration(t, issuedAt, verified.IssuedAt, time.Second)
+	require.WithinDuration(t, expiredAt, verified.ExpiredAt, time.Second)
+}
+
+func TestExpiredAsymmetricPasetoToken(t *testing.T) {
+	maker := newTestAsymmetricPasetoMaker(t, randomEd25519Key(t))
+
+	token, _, err := maker.CreateToken(util.RandomOwner(), util.DepositorRole, -time.Minute)
+	require.NoError(t, err)
+
+	payload, err := maker.VerifyToken(token)
+	require.EqualError(t, err, ErrExpiredToken.Error())
+	require.Nil(t, payload)
+}
+
+func TestAsymmetricPasetoKeyRotation(t *testing.T) {
+	previousKey := randomEd25519Key(t)
+	nextKey := randomEd25519Key(t)
+
+	previousMaker := newTestAsymmetricPasetoMaker(t, previousKey)
+	token, _, err := previousMaker.CreateToken(util.RandomOwner(), util.DepositorRole, time.Minute)
+	require.NoError(t, err)
+
+	rotatedMaker := newTestAsymmetricPasetoMaker(t, nextKey, previousKey.Public())
+	_, err = rotatedMaker.VerifyToken(token)
+	require.NoError(t, err)
+
+	retiredMaker := newTestAsymmetricPasetoMaker(t, nextKey)
+	payload, err := retiredMaker.VerifyToken(token)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+	require.Nil(t, payload)
+}
+
+func TestInvalidAsymmetricPasetoToken(t *testing.T) {
+	maker := newTestAsymmetricPasetoMaker(t, randomEd25519Key(t))
+
+	token, _, err := maker.CreateToken(util.RandomOwner(), util.DepositorRole, time.Minute)
+	require.NoError(t, err)
+
+	// signed with a key that isn't accepted
+	otherMaker := newTestAsymmetricPasetoMaker(t, randomEd25519Key(t))
+	otherToken, _, err := otherMaker.CreateToken(util.RandomOwner(), util.BankerRole, time.Minute)
+	require.NoError(t, err)
+
+	// the kid of an accepted key in the footer of a token signed with another key
+	parts := strings.Split(token, ".")
+	otherParts := strings.Split(otherToken, ".")
+	forgedToken := strings.Join([]string{otherParts[0], otherParts[1], otherParts[2], parts[3]}, ".")
+
+	for _, token := range []string{otherToken, forgedToken, "v4.public.garbage"} {
+		payload, err := maker.VerifyToken(token)
+		require.EqualError(t, err, ErrInvalidToken.Error())
+		require.Nil(t, payload)
+	}
+
+	_, err = NewAsymmetricPasetoMaker(newTestKeySet(t, randomRSAKey(t)))
+	require.Error(t, err)
+}
@@ -0,0 +1,243 @@
+package token
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+)
+
+// Algorithms a key signs JWTs with, picked from the type of the key
+const (
+	AlgorithmEdDSA = "EdDSA"
+	AlgorithmRS256 = "RS256"
+)
+
+const minRSAKeyBits = 2048
+
+// KeySet holds the private key new tokens are signed with and the public keys tokens are still accepted from.
+// Rotating keys is a matter of configuration: publish the next key as a public key first so other services
+// pick it up, switch signing to it, and keep the previous one as a public key until its tokens have expired.
+// Dropping a public key retires it, tokens signed with it aren't accepted anymore.
+type KeySet struct {
+	signingKey   crypto.Signer
+	signingKeyID string
+	publicKeys   map[string]crypto.PublicKey
+}
+
+// NewKeySet creates a KeySet that signs with signingKey, an ed25519.PrivateKey or an *rsa.PrivateKey,
+// and also accepts tokens signed with the private keys of publicKeys
+func NewKeySet(signingKey crypto.PrivateKey, publicKeys ...crypto.PublicKey) (*KeySet, error) {
+	signer, ok := signingKey.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported signing key type %T", signingKey)
+	}
+
+	keys := &KeySet{
+		signingKey: signer,
+		publicKeys: make(map[string]crypto.PublicKey),
+	}
+
+	for _, publicKey := range append([]crypto.PublicKey{signer.Public()}, publicKeys...) {
+		keyID, err := KeyID(publicKey)
+		if err != nil {
+			return nil, err
+		}
+		keys.publicKeys[keyID] = publicKey
+	}
+
+	keys.signingKeyID, _ = KeyID(signer.Public())
+	return keys, nil
+}
+
+// LoadKeySet reads a KeySet from PEM. The signing key is taken from privateKeyPEM, or read from privateKeyFile
+// when that's empty. publicKeyFiles hold the PEM of the other keys that are still accepted.
+func LoadKeySet(privateKeyPEM string, privateKeyFile string, publicKeyFiles []string) (*KeySet, error) {
+	data := []byte(privateKeyPEM)
+	if privateKeyPEM == "" {
+		var err error
+		data, err = os.ReadFile(privateKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read private key: %w", err)
+		}
+	}
+
+	signingKey, err := ParsePrivateKeyPEM(data)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKeys := make([]crypto.PublicKey, 0, len(publicKeyFiles))
+	for _, file := range publicKeyFiles {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read public key: %w", err)
+		}
+
+		publicKey, err := ParsePublicKeyPEM(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		publicKeys = append(publicKeys, publicKey)
+	}
+
+	return NewKeySet(signingKey, publicKeys...)
+}
+
+// ParsePrivateKeyPEM parses a PKCS #8 Ed25519 or RSA private key, or a PKCS #1 RSA private key
+func ParsePrivateKeyPEM(data []byte) (crypto.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM encoded private key found")
+	}
+
+	var key crypto.PrivateKey
+	var err error
+	if block.Type == "RSA PRIVATE KEY" {
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	} else {
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+
+	if err := checkKey(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// ParsePublicKeyPEM parses a PKIX Ed25519 or RSA public key
+func ParsePublicKeyPEM(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM encoded public key found")
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key: %w", err)
+	}
+
+	if err := checkKey(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func checkKey(key any) error {
+	switch key := key.(type) {
+	case ed25519.PrivateKey, ed25519.PublicKey:
+		return nil
+	case *rsa.PrivateKey:
+		return checkRSAKeySize(&key.PublicKey)
+	case *rsa.PublicKey:
+		return checkRSAKeySize(key)
+	}
+	return fmt.Errorf("unsupported key type %T: must be Ed25519 or RSA", key)
+}
+
+func checkRSAKeySize(key *rsa.PublicKey) error {
+	if key.N.BitLen() < minRSAKeyBits {
+		return fmt.Errorf("invalid RSA key size: must be at least %d bits", minRSAKeyBits)
+	}
+	return nil
+}
+
+// KeyID identifies a public key by its RFC 7638 JWK thumbprint, so every replica derives the same kid from the same key
+func KeyID(publicKey crypto.PublicKey) (string, error) {
+	var thumbprint string
+	// the required members of the JWK in lexicographic order
+	switch key := publicKey.(type) {
+	case ed25519.PublicKey:
+		thumbprint = fmt.Sprintf(`{"crv":"Ed25519","kty":"OKP","x":"%s"}`, encodeKeyBytes(key))
+	case *rsa.PublicKey:
+		thumbprint = fmt.Sprintf(`{"e":"%s","kty":"RSA","n":"%s"}`,
+			encodeKeyBytes(big.NewInt(int64(key.E)).Bytes()), encodeKeyBytes(key.N.Bytes()))
+	default:
+		return "", fmt.Errorf("unsupported key type %T: must be Ed25519 or RSA", publicKey)
+	}
+
+	sum := sha256.Sum256([]byte(thumbprint))
+	return encodeKeyBytes(sum[:]), nil
+}
+
+// algorithm returns the JWT algorithm a key signs with
+func algorithm(publicKey crypto.PublicKey) string {
+	if _, ok := publicKey.(*rsa.PublicKey); ok {
+		return AlgorithmRS256
+	}
+	return AlgorithmEdDSA
+}
+
+// publicKey returns the accepted key with the ID, if it hasn't been retired
+func (keys *KeySet) publicKey(keyID string) (crypto.PublicKey, bool) {
+	publicKey, ok := keys.publicKeys[keyID]
+	return publicKey, ok
+}
+
+// JSONWebKey is the public half of a signing key, in the format of RFC 7517
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg,omitempty"`
+	// Ed25519
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	// RSA
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+}
+
+// JSONWebKeySet is served at /.well-known/jwks.json for other services to verify our tokens with
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// KeyPublisher is implemented by the makers that sign tokens with a private key
+type KeyPublisher interface {
+	JWKS() JSONWebKeySet
+}
+
+// JWKS returns every accepted public key, the signing key's included, sorted by kid
+func (keys *KeySet) JWKS() JSONWebKeySet {
+	jwks := JSONWebKeySet{Keys: make([]JSONWebKey, 0, len(keys.publicKeys))}
+
+	for keyID, publicKey := range keys.publicKeys {
+		jwk := JSONWebKey{
+			Use: "sig",
+			Kid: keyID,
+			Alg: algorithm(publicKey),
+		}
+
+		switch key := publicKey.(type) {
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = encodeKeyBytes(key)
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = encodeKeyBytes(key.N.Bytes())
+			jwk.E = encodeKeyBytes(big.NewInt(int64(key.E)).Bytes())
+		}
+		jwks.Keys = append(jwks.Keys, jwk)
+	}
+
+	sort.Slice(jwks.Keys, func(i, j int) bool {
+		return jwks.Keys[i].Kid < jwks.Keys[j].Kid
+	})
+	return jwks
+}
+
+func encodeKeyBytes(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
@@ -0,0 +1,143 @@
+package token
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func randomEd25519Key(t *testing.T) ed25519.PrivateKey {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return privateKey
+}
+
+func randomRSAKey(t *testing.T) *rsa.PrivateKey {
+	privateKey, err := rsa.GenerateKey(rand.Reader, minRSAKeyBits)
+	require.NoError(t, err)
+	return privateKey
+}
+
+func newTestKeySet(t *testing.T, signingKey crypto.PrivateKey, publicKeys ...crypto.PublicKey) *KeySet {
+	keys, err := NewKeySet(signingKey, publicKeys...)
+	require.NoError(t, err)
+	return keys
+}
+
+func encodePrivateKeyPEM(t *testing.T, privateKey crypto.PrivateKey) []byte {
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func encodePublicKeyPEM(t *testing.T, publicKey crypto.PublicKey) []byte {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+func TestKeyID(t *testing.T) {
+	// the Ed25519 example of RFC 8037, appendix A.3
+	x := "11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"
+	publicKey, err := base64.RawURLEncoding.DecodeString(x)
+	require.NoError(t, err)
+
+	keyID, err := KeyID(ed25519.PublicKey(publicKey))
+	require.NoError(t, err)
+	require.Equal(t, "kPrK_qmxVWaYVA9wwBF6Iuo3vVzz7TxHCTwXBygrS4k", keyID)
+
+	_, err = KeyID("not a key")
+	require.Error(t, err)
+}
+
+func TestLoadKeySet(t *testing.T) {
+	signingKey := randomEd25519Key(t)
+	previousKey := randomRSAKey(t)
+
+	dir := t.TempDir()
+	privateKeyFile := filepath.Join(dir, "token_key.pem")
+	publicKeyFile := filepath.Join(dir, "previous_token_key.pub.pem")
+	require.NoError(t, os.WriteFile(privateKeyFile, encodePrivateKeyPEM(t, signingKey), 0600))
+	require.NoError(t, os.WriteFile(publicKeyFile, encodePublicKeyPEM(t, previousKey.Public()), 0600))
+
+	fromFile, err := LoadKeySet("", privateKeyFile, []string{publicKeyFile})
+	require.NoError(t, err)
+
+	fromPEM, err := LoadKeySet(string(encodePrivateKeyPEM(t, signingKey)), "", []string{publicKeyFile})
+	require.NoError(t, err)
+
+	signingKeyID, err := KeyID(signingKey.Public())
+	require.NoError(t, err)
+	previousKeyID, err := KeyID(previousKey.Public())
+	require.NoError(t, err)
+
+	for _, keys := range []*KeySet{fromFile, fromPEM} {
+		require.Equal(t, signingKeyID, keys.signingKeyID)
+		require.Len(t, keys.publicKeys, 2)
+
+		_, ok := keys.publicKey(signingKeyID)
+		require.True(t, ok)
+		_, ok = keys.publicKey(previousKeyID)
+		require.True(t, ok)
+	}
+
+	_, err = LoadKeySet("", filepath.Join(dir, "missing.pem"), nil)
+	require.Error(t, err)
+
+	_, err = LoadKeySet("", privateKeyFile, []string{privateKeyFile})
+	require.Error(t, err)
+}
+
+func TestParsePrivateKeyPEM(t *testing.T) {
+	rsaKey := randomRSAKey(t)
+	pkcs1 := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)})
+
+	key, err := ParsePrivateKeyPEM(pkcs1)
+	require.NoError(t, err)
+	require.True(t, rsaKey.Equal(key))
+
+	weakKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	_, err = ParsePrivateKeyPEM(encodePrivateKeyPEM(t, weakKey))
+	require.Error(t, err)
+
+	_, err = ParsePrivateKeyPEM([]byte("not a key"))
+	require.Error(t, err)
+}
+
+func TestJWKS(t *testing.T) {
+	ed25519Key := randomEd25519Key(t)
+	rsaKey := randomRSAKey(t)
+
+	keys := newTestKeySet(t, ed25519Key, rsaKey.Public())
+	jwks := keys.JWKS()
+	require.Len(t, jwks.Keys, 2)
+	require.Less(t, jwks.Keys[0].Kid, jwks.Keys[1].Kid)
+
+	for _, jwk := range jwks.Keys {
+		require.Equal(t, "sig", jwk.Use)
+
+		switch jwk.Kty {
+		case "OKP":
+			require.Equal(t, AlgorithmEdDSA, jwk.Alg)
+			require.Equal(t, "Ed25519", jwk.Crv)
+			require.Equal(t, encodeKeyBytes(ed25519Key.Public().(ed25519.PublicKey)), jwk.X)
+			require.Equal(t, keys.signingKeyID, jwk.Kid)
+		case "RSA":
+			require.Equal(t, AlgorithmRS256, jwk.Alg)
+			require.Equal(t, encodeKeyBytes(rsaKey.N.Bytes()), jwk.N)
+			require.Equal(t, "AQAB", jwk.E)
+		default:
+			t.Fatalf("unexpected key type %s", jwk.Kty)
+		}
+	}
+}
@@ -1,6 +1,10 @@
 package token
 
-import "time"
+import (
+	"fmt"
+	"goBank/util"
+	"time"
+)
 
 // Maker is an interface for managing tokens
 type Maker interface {
@@ -9,3 +13,37 @@ type Maker interface {
 	//VerifyToken checks if the token is valid or not.
 	VerifyToken(token string) (*Payload, error)
 }
+
+// Token types TOKEN_TYPE selects between
+const (
+	TypeJWT    = "jwt"
+	TypePASETO = "paseto"
+)
+
+// NewMaker creates the Maker of the token type the config asks for, signing with the private key
+// when one is set and with the symmetric key otherwise
+func NewMaker(config util.Config) (Maker, error) {
+	switch config.TokenType {
+	case "", TypeJWT:
+		if !config.UsesTokenKeyPair() {
+			return NewJWTMaker(config.TokenSymmetricKey)
+		}
+
+		keys, err := LoadKeySet(config.TokenPrivateKey, config.TokenPrivateKeyFile, config.TokenPublicKeyFiles)
+		if err != nil {
+			return nil, err
+		}
+		return NewAsymmetricJWTMaker(keys)
+	case TypePASETO:
+		if !config.UsesTokenKeyPair() {
+			return NewPasetoMaker(config.TokenSymmetricKey)
+		}
+
+		keys, err := LoadKeySet(config.TokenPrivateKey, config.TokenPrivateKeyFile, config.TokenPublicKeyFiles)
+		if err != nil {
+			return nil, err
+		}
+		return NewAsymmetricPasetoMaker(keys)
+	}
+	return nil, fmt.Errorf("unsupported token type %q: must be %s or %s", config.TokenType, TypeJWT, TypePASETO)
+}
@@ -0,0 +1,218 @@
+package token
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"goBank/util"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// makers lists every Maker implementation, each call to newMaker keys a maker with a new key
+var makers = []struct {
+	name     string
+	newMaker func(t *testing.T) Maker
+}{
+	{
+		name: "JWT",
+		newMaker: func(t *testing.T) Maker {
+			maker, err := NewJWTMaker(util.RandomString(32))
+			require.NoError(t, err)
+			return maker
+		},
+	},
+	{
+		name: "PASETO",
+		newMaker: func(t *testing.T) Maker {
+			maker, err := NewPasetoMaker(util.RandomString(32))
+			require.NoError(t, err)
+			return maker
+		},
+	},
+	{
+		name: "AsymmetricJWTEd25519",
+		newMaker: func(t *testing.T) Maker {
+			return newTestAsymmetricJWTMaker(t, randomEd25519Key(t))
+		},
+	},
+	{
+		name: "AsymmetricJWTRSA",
+		newMaker: func(t *testing.T) Maker {
+			return newTestAsymmetricJWTMaker(t, randomRSAKey(t))
+		},
+	},
+	{
+		name: "AsymmetricPASETO",
+		newMaker: func(t *testing.T) Maker {
+			return newTestAsymmetricPasetoMaker(t, randomEd25519Key(t))
+		},
+	},
+}
+
+// TestMakerConformance checks the behaviour every Maker has to share, whatever its tokens are made of
+func TestMakerConformance(t *testing.T) {
+	for i := range makers {
+		tc := makers[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			maker := tc.newMaker(t)
+
+			t.Run("RoundTrip", func(t *testing.T) {
+				for _, role := range []string{util.DepositorRole, util.BankerRole} {
+					username := util.RandomOwner()
+					duration := time.Minute
+
+					issuedAt := time.Now()
+					expiredAt := issuedAt.Add(duration)
+
+					token, payload, err := maker.CreateToken(username, role, duration)
+					require.NoError(t, err)
+					require.NotEmpty(t, token)
+					require.NotNil(t, payload)
+
+					verified, err := maker.VerifyToken(token)
+					require.NoError(t, err)
+					require.NotNil(t, verified)
+
+					require.NotZero(t, verified.ID)
+					require.Equal(t, payload.ID, verified.ID)
+					require.Equal(t, username, verified.Username)
+					require.Equal(t, role, verified.Role)
+					require.WithinDuration(t, issuedAt, verified.IssuedAt, time.Second)
+					require.WithinDuration(t, expiredAt, verified.ExpiredAt, time.Second)
+				}
+			})
+
+			t.Run("UniqueID", func(t *testing.T) {
+				_, payload1, err := maker.CreateToken(util.RandomOwner(), util.DepositorRole, time.Minute)
+				require.NoError(t, err)
+				_, payload2, err := maker.CreateToken(util.RandomOwner(), util.DepositorRole, time.Minute)
+				require.NoError(t, err)
+				require.NotEqual(t, payload1.ID, payload2.ID)
+			})
+
+			t.Run("Expired", func(t *testing.T) {
+				token, _, err := maker.CreateToken(util.RandomOwner(), util.DepositorRole, -time.Minute)
+				require.NoError(t, err)
+
+				payload, err := maker.VerifyToken(token)
+				require.EqualError(t, err, ErrExpiredToken.Error())
+				require.Nil(t, payload)
+			})
+
+			t.Run("OtherKey", func(t *testing.T) {
+				token, _, err := tc.newMaker(t).CreateToken(util.RandomOwner(), util.BankerRole, time.Minute)
+				require.NoError(t, err)
+
+				payload, err := maker.VerifyToken(token)
+				require.EqualError(t, err, ErrInvalidToken.Error())
+				require.Nil(t, payload)
+			})
+
+			t.Run("Tampered", func(t *testing.T) {
+				token, _, err := maker.CreateToken(util.RandomOwner(), util.DepositorRole, time.Minute)
+				require.NoError(t, err)
+
+				tampered := []byte(token)
+				i := len(tampered) / 2
+				if tampered[i] == 'A' {
+					tampered[i] = 'B'
+				} else {
+					tampered[i] = 'A'
+				}
+
+				payload, err := maker.VerifyToken(string(tampered))
+				require.EqualError(t, err, ErrInvalidToken.Error())
+				require.Nil(t, payload)
+			})
+
+			t.Run("Malformed", func(t *testing.T) {
+				for _, token := range []string{"", "invalid", "a.b.c", "v4.local.invalid", "v4.public.invalid"} {
+					payload, err := maker.VerifyToken(token)
+					require.EqualError(t, err, ErrInvalidToken.Error())
+					require.Nil(t, payload)
+				}
+			})
+		})
+	}
+}
+
+func TestNewMaker(t *testing.T) {
+	der, err := x509.MarshalPKCS8PrivateKey(randomEd25519Key(t))
+	require.NoError(t, err)
+	privateKey := string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+
+	testCases := []struct {
+		name       string
+		config     util.Config
+		checkMaker func(t *testing.T, maker Maker, err error)
+	}{
+		{
+			name:   "DefaultType",
+			config: util.Config{TokenSymmetricKey: util.RandomString(32)},
+			checkMaker: func(t *testing.T, maker Maker, err error) {
+				require.NoError(t, err)
+				require.IsType(t, &JWTMaker{}, maker)
+			},
+		},
+		{
+			name:   "JWT",
+			config: util.Config{TokenType: TypeJWT, TokenSymmetricKey: util.RandomString(32)},
+			checkMaker: func(t *testing.T, maker Maker, err error) {
+				require.NoError(t, err)
+				require.IsType(t, &JWTMaker{}, maker)
+			},
+		},
+		{
+			name:   "PASETO",
+			config: util.Config{TokenType: TypePASETO, TokenSymmetricKey: util.RandomString(32)},
+			checkMaker: func(t *testing.T, maker Maker, err error) {
+				require.NoError(t, err)
+				require.IsType(t, &PasetoMaker{}, maker)
+			},
+		},
+		{
+			name:   "AsymmetricJWT",
+			config: util.Config{TokenType: TypeJWT, TokenPrivateKey: privateKey},
+			checkMaker: func(t *testing.T, maker Maker, err error) {
+				require.NoError(t, err)
+				require.IsType(t, &AsymmetricJWTMaker{}, maker)
+			},
+		},
+		{
+			name:   "AsymmetricPASETO",
+			config: util.Config{TokenType: TypePASETO, TokenPrivateKey: privateKey},
+			checkMaker: func(t *testing.T, maker Maker, err error) {
+				require.NoError(t, err)
+				require.IsType(t, &AsymmetricPasetoMaker{}, maker)
+			},
+		},
+		{
+			name:   "PASETOKeySize",
+			config: util.Config{TokenType: TypePASETO, TokenSymmetricKey: util.RandomString(64)},
+			checkMaker: func(t *testing.T, maker Maker, err error) {
+				require.Error(t, err)
+				require.Nil(t, maker)
+			},
+		},
+		{
+			name:   "UnsupportedType",
+			config: util.Config{TokenType: "macaroon", TokenSymmetricKey: util.RandomString(32)},
+			checkMaker: func(t *testing.T, maker Maker, err error) {
+				require.Error(t, err)
+				require.Nil(t, maker)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			maker, err := NewMaker(tc.config)
+			tc.checkMaker(t, maker, err)
+		})
+	}
+}
@@ -1,108 +1,107 @@
 package token
 
 import (
-	"strings"
+	"fmt"
 	"time"
 
 	"aidanwoods.dev/go-paseto"
 	"github.com/google/uuid"
 )
 
+// v4.local encrypts with XChaCha20, its key is exactly 32 bytes
+const pasetoSymmetricKeySize = 32
+
 // PasetoMaker is a struct that implements Maker interface
 type PasetoMaker struct {
 	symmetricKey paseto.V4SymmetricKey
-	implicit     []byte
-}
-
-func NewPasetoMaker() Maker {
-	return &PasetoMaker{paseto.NewV4SymmetricKey(), []byte("my implicit nonce")}
 }
 
-// CreateToken creates a new token for a specific username and duration
-func (maker *PasetoMaker) CreateToken(username string, role string, duration time.Duration) (string, *Payload, error) {
-
-	payload, err := NewPayload(username, role, duration)
-	if err != nil {
-		return "", payload, err
-	}
-
-	// create paseto token
-	token := paseto.NewToken()
-	// Create uuid for token id
-	tokenID, err := uuid.NewRandom()
-
-	if err != nil {
-		return "", payload, err
-	}
-	// add data to the token.
-	err = token.Set("id", tokenID.String())
-	if err != nil {
-		return "", payload, err
+// NewPasetoMaker creates a new PasetoMaker of v4.local tokens, keyed with the same symmetricKey on every replica
+func NewPasetoMaker(symmetricKey string) (Maker, error) {
+	if len(symmetricKey) != pasetoSymmetricKeySize {
+		return nil, fmt.Errorf("invalid key size: must be exactly %d characters", pasetoSymmetricKeySize)
 	}
 
-	err = token.Set("username", username)
+	key, err := paseto.V4SymmetricKeyFromBytes([]byte(symmetricKey))
 	if err != nil {
-		return "", payload, err
+		return nil, err
 	}
+	return &PasetoMaker{key}, nil
+}
 
-	err = token.Set("payload", payload)
+// CreateToken creates a new token for a specific username and duration
+func (maker *PasetoMaker) CreateToken(username string, role string, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewPayload(username, role, duration)
 	if err != nil {
 		return "", payload, err
 	}
 
-	token.SetIssuedAt(time.Now())
-	token.SetExpiration(time.Now().Add(duration))
+	token := newPasetoToken(payload)
 
-	turken, err := token.V4Encrypt(maker.symmetricKey, maker.implicit), nil
-
-	return turken, payload, err
+	return token.V4Encrypt(maker.symmetricKey, nil), payload, nil
 }
 
 // VerifyToken checks if the token is valid or not
 func (maker *PasetoMaker) VerifyToken(token string) (*Payload, error) {
+	// expiry is checked on the payload so it's reported as ErrExpiredToken
+	parser := paseto.NewParserWithoutExpiryCheck()
 
-	parser := paseto.NewParser()
-	parser.AddRule(paseto.NotExpired())
-	parsedToken, err := parser.ParseV4Local(maker.symmetricKey, token, maker.implicit)
+	parsedToken, err := parser.ParseV4Local(maker.symmetricKey, token, nil)
 	if err != nil {
-		if strings.Contains(err.Error(), "expired") {
-			return nil, ErrExpiredToken
-		}
 		return nil, ErrInvalidToken
 	}
-	// construct payload from token
-	payload, err := getPayloadFromToken(parsedToken)
+
+	payload, err := payloadFromClaims(parsedToken)
 	if err != nil {
 		return nil, ErrInvalidToken
 	}
+
+	if err := payload.Valid(); err != nil {
+		return nil, err
+	}
 	return payload, nil
+}
 
+// newPasetoToken writes the payload to the registered claims, with the role as a custom claim
+func newPasetoToken(payload *Payload) paseto.Token {
+	token := paseto.NewToken()
+	token.SetJti(payload.ID.String())
+	token.SetSubject(payload.Username)
+	token.SetString("role", payload.Role)
+	token.SetIssuedAt(payload.IssuedAt)
+	token.SetExpiration(payload.ExpiredAt)
+	return token
 }
 
-func getPayloadFromToken(t *paseto.Token) (*Payload, error) {
-	id, err := t.GetString("id")
+// payloadFromClaims reads the payload back from the registered claims it was written to
+func payloadFromClaims(t *paseto.Token) (*Payload, error) {
+	jti, err := t.GetJti()
 	if err != nil {
-		return nil, ErrInvalidToken
+		return nil, err
 	}
-	username, err := t.GetString("username")
+	id, err := uuid.Parse(jti)
 	if err != nil {
-		return nil, ErrInvalidToken
+		return nil, err
+	}
+	username, err := t.GetSubject()
+	if err != nil {
+		return nil, err
 	}
 	role, err := t.GetString("role")
 	if err != nil {
-		return nil, ErrInvalidToken
+		return nil, err
 	}
 	issuedAt, err := t.GetIssuedAt()
 	if err != nil {
-		return nil, ErrInvalidToken
+		return nil, err
 	}
 	expiredAt, err := t.GetExpiration()
 	if err != nil {
-		return nil, ErrInvalidToken
+		return nil, err
 	}
 
 	return &Payload{
-		ID:        uuid.MustParse(id),
+		ID:        id,
 		Username:  username,
 		Role:      role,
 		IssuedAt:  issuedAt,
@@ -9,7 +9,10 @@ import (
 )
 
 func TestPasetoMaker(t *testing.T) {
-	maker := NewPasetoMaker()
+	symmetricKey := util.RandomString(32)
+
+	maker, err := NewPasetoMaker(symmetricKey)
+	require.NoError(t, err)
 
 	username := util.RandomOwner()
 	role := util.DepositorRole
@@ -24,20 +27,24 @@ func TestPasetoMaker(t *testing.T) {
 	require.NotEmpty(t, token)
 	require.NotEmpty(t, payload)
 
-	payload, err = maker.VerifyToken(token)
+	// another replica keyed from the same config
+	replica, err := NewPasetoMaker(symmetricKey)
 	require.NoError(t, err)
-	require.NotEmpty(t, payload)
 
-	require.NotZero(t, payload.ID)
-	require.Equal(t, username, payload.Username)
-	require.Equal(t, role, payload.Role)
-	require.WithinDuration(t, issuedAt, payload.IssuedAt, time.Second)
-	require.WithinDuration(t, expiredAt, payload.ExpiredAt, time.Second)
+	verified, err := replica.VerifyToken(token)
+	require.NoError(t, err)
+	require.NotEmpty(t, verified)
 
+	require.Equal(t, payload.ID, verified.ID)
+	require.Equal(t, username, verified.Username)
+	require.Equal(t, role, verified.Role)
+	require.WithinDuration(t, issuedAt, verified.IssuedAt, time.Second)
+	require.WithinDuration(t, expiredAt, verified.ExpiredAt, time.Second)
 }
 
 func TestExpiredPasetoToken(t *testing.T) {
-	maker := NewPasetoMaker()
+	maker, err := NewPasetoMaker(util.RandomString(32))
+	require.NoError(t, err)
 
 	token, payload, err := maker.CreateToken(util.RandomOwner(), util.DepositorRole, -time.Minute)
 	require.NoError(t, err)
@@ -49,3 +56,11 @@ func TestExpiredPasetoToken(t *testing.T) {
 	require.EqualError(t, err, ErrExpiredToken.Error())
 	require.Nil(t, payload)
 }
+
+func TestPasetoMakerKeySize(t *testing.T) {
+	for _, size := range []int{0, 31, 33} {
+		maker, err := NewPasetoMaker(util.RandomString(size))
+		require.Error(t, err)
+		require.Nil(t, maker)
+	}
+}
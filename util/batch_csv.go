@@ -0,0 +1,50 @@
+package util
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// BatchPayment is one row of a batch transfer CSV
+type BatchPayment struct {
+	ToAccountID int64
+	Amount      int64
+}
+
+// ParseBatchCSV reads to_account_id,amount rows, a header row naming those two columns may come first
+func ParseBatchCSV(r io.Reader) ([]BatchPayment, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+
+	var payments []BatchPayment
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return payments, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if line == 1 && strings.EqualFold(record[0], "to_account_id") && strings.EqualFold(record[1], "amount") {
+			continue
+		}
+
+		toAccountID, err := strconv.ParseInt(strings.TrimSpace(record[0]), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid to_account_id %q", line, record[0])
+		}
+
+		amount, err := strconv.ParseInt(strings.TrimSpace(record[1]), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid amount %q", line, record[1])
+		}
+
+		payments = append(payments, BatchPayment{ToAccountID: toAccountID, Amount: amount})
+	}
+}
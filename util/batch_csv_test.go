@@ -0,0 +1,31 @@
+package util
+
+import (
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+func TestParseBatchCSV(t *testing.T) {
+	payments, err := ParseBatchCSV(strings.NewReader("to_account_id,amount\n12, 500\n13,750\n"))
+	require.NoError(t, err)
+	require.Equal(t, []BatchPayment{{ToAccountID: 12, Amount: 500}, {ToAccountID: 13, Amount: 750}}, payments)
+
+	payments, err = ParseBatchCSV(strings.NewReader("12,500"))
+	require.NoError(t, err)
+	require.Equal(t, []BatchPayment{{ToAccountID: 12, Amount: 500}}, payments)
+
+	payments, err = ParseBatchCSV(strings.NewReader(""))
+	require.NoError(t, err)
+	require.Empty(t, payments)
+
+	_, err = ParseBatchCSV(strings.NewReader("12,500,USD"))
+	require.Error(t, err)
+
+	_, err = ParseBatchCSV(strings.NewReader("12,lots"))
+	require.Error(t, err)
+
+	// a header is only allowed on the first line
+	_, err = ParseBatchCSV(strings.NewReader("12,500\nto_account_id,amount"))
+	require.Error(t, err)
+}
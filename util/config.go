@@ -1,6 +1,7 @@
 package util
 
 import (
+	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
 	"time"
 )
@@ -19,6 +20,36 @@ type Config struct {
 	EmailSenderName      string        `mapstructure:"EMAIL_SENDER_NAME"`
 	EmailSenderAddress   string        `mapstructure:"EMAIL_SENDER_ADDRESS"`
 	EmailSenderPassword  string        `mapstructure:"EMAIL_SENDER_PASSWORD"`
+	//How long an exchange quote keeps its rate locked
+	ExchangeQuoteDuration time.Duration `mapstructure:"EXCHANGE_QUOTE_DURATION"`
+	//How long a hold keeps funds reserved before the worker expires it
+	HoldDuration time.Duration `mapstructure:"HOLD_DURATION"`
+	//Transfers above these amounts wait for a banker's approval, currencies without one never do
+	ApprovalThresholds CurrencyAmounts `mapstructure:"APPROVAL_THRESHOLDS"`
+	//How long a pending transfer waits for approval before the worker expires it
+	PendingTransferDuration time.Duration `mapstructure:"PENDING_TRANSFER_DURATION"`
+	//The currencies accounts can be opened in, USD, EUR and CAD when unset
+	Currencies Currencies `mapstructure:"CURRENCIES"`
+	//Shared with the payment processor to sign withdrawal settlement callbacks, callbacks are refused while it's empty
+	SettlementCallbackSecret string `mapstructure:"SETTLEMENT_CALLBACK_SECRET"`
+	//PEM of the Ed25519 or RSA private key tokens are signed with, TOKEN_SYMMETRIC_KEY is used while neither it nor TOKEN_PRIVATE_KEY_FILE is set
+	TokenPrivateKey     string `mapstructure:"TOKEN_PRIVATE_KEY"`
+	TokenPrivateKeyFile string `mapstructure:"TOKEN_PRIVATE_KEY_FILE"`
+	//Comma separated PEM files of the other public keys tokens are accepted from while keys are rotated
+	TokenPublicKeyFiles []string `mapstructure:"TOKEN_PUBLIC_KEY_FILES"`
+	//The kind of tokens issued, jwt or paseto
+	TokenType string `mapstructure:"TOKEN_TYPE"`
+}
+
+// RequiresApproval reports whether a transfer of amount in currency has to wait for a banker's approval
+func (config Config) RequiresApproval(currency string, amount int64) bool {
+	threshold, ok := config.ApprovalThresholds.Get(currency)
+	return ok && amount > threshold
+}
+
+// UsesTokenKeyPair reports whether tokens are signed with a private key rather than the symmetric key
+func (config Config) UsesTokenKeyPair() bool {
+	return config.TokenPrivateKey != "" || config.TokenPrivateKeyFile != ""
 }
 
 // LoadConfig reads configurtation from file or environment variable
@@ -29,11 +60,33 @@ func LoadConfig(path string) (config Config, err error) {
 
 	viper.AutomaticEnv()
 
+	// app.env files written before these settings existed don't set them
+	viper.SetDefault("EXCHANGE_QUOTE_DURATION", "30s")
+	viper.SetDefault("HOLD_DURATION", "168h")
+	viper.SetDefault("PENDING_TRANSFER_DURATION", "72h")
+	viper.SetDefault("SETTLEMENT_CALLBACK_SECRET", "")
+	viper.SetDefault("TOKEN_PRIVATE_KEY", "")
+	viper.SetDefault("TOKEN_PRIVATE_KEY_FILE", "")
+	viper.SetDefault("TOKEN_PUBLIC_KEY_FILES", "")
+	viper.SetDefault("TOKEN_TYPE", "jwt")
+
 	err = viper.ReadInConfig()
 	if err != nil {
 		return
 	}
 
-	err = viper.Unmarshal(&config)
+	//Registered first since the other settings' currencies are checked against them
+	config.Currencies, err = ParseCurrencies(viper.GetString("CURRENCIES"))
+	if err != nil {
+		return
+	}
+	SetCurrencies(config.Currencies)
+
+	err = viper.Unmarshal(&config, viper.DecodeHook(mapstructure.ComposeDecodeHookFunc(
+		mapstructure.StringToTimeDurationHookFunc(),
+		mapstructure.StringToSliceHookFunc(","),
+		stringToCurrencyAmountsHookFunc(),
+		stringToCurrenciesHookFunc(),
+	)))
 	return
 }
@@ -1,15 +1,202 @@
 package util
 
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+// Codes of the currencies enabled when the config doesn't list any
 const (
 	USD = "USD"
 	EUR = "EUR"
 	CAD = "CAD"
 )
 
+// Currency is an ISO 4217 currency, amounts in it are stored as integers of its minor unit
+type Currency struct {
+	Code        string `json:"code"`
+	NumericCode string `json:"numeric_code"`
+	// How many decimal places the minor unit is, 2 for cents, 0 for JPY, 3 for KWD
+	MinorUnits int    `json:"minor_units"`
+	Symbol     string `json:"symbol"`
+}
+
+// iso4217 are the currencies the config can enable by their code alone
+var iso4217 = map[string]Currency{
+	"AUD": {Code: "AUD", NumericCode: "036", MinorUnits: 2, Symbol: "A$"},
+	"BHD": {Code: "BHD", NumericCode: "048", MinorUnits: 3, Symbol: "BD"},
+	"BRL": {Code: "BRL", NumericCode: "986", MinorUnits: 2, Symbol: "R$"},
+	"CAD": {Code: "CAD", NumericCode: "124", MinorUnits: 2, Symbol: "CA$"},
+	"CHF": {Code: "CHF", NumericCode: "756", MinorUnits: 2, Symbol: "CHF"},
+	"CLP": {Code: "CLP", NumericCode: "152", MinorUnits: 0, Symbol: "CLP$"},
+	"CNY": {Code: "CNY", NumericCode: "156", MinorUnits: 2, Symbol: "¥"},
+	"EUR": {Code: "EUR", NumericCode: "978", MinorUnits: 2, Symbol: "€"},
+	"GBP": {Code: "GBP", NumericCode: "826", MinorUnits: 2, Symbol: "£"},
+	"HKD": {Code: "HKD", NumericCode: "344", MinorUnits: 2, Symbol: "HK$"},
+	"INR": {Code: "INR", NumericCode: "356", MinorUnits: 2, Symbol: "₹"},
+	"JOD": {Code: "JOD", NumericCode: "400", MinorUnits: 3, Symbol: "JD"},
+	"JPY": {Code: "JPY", NumericCode: "392", MinorUnits: 0, Symbol: "¥"},
+	"KRW": {Code: "KRW", NumericCode: "410", MinorUnits: 0, Symbol: "₩"},
+	"KWD": {Code: "KWD", NumericCode: "414", MinorUnits: 3, Symbol: "KD"},
+	"MXN": {Code: "MXN", NumericCode: "484", MinorUnits: 2, Symbol: "MX$"},
+	"NOK": {Code: "NOK", NumericCode: "578", MinorUnits: 2, Symbol: "kr"},
+	"NZD": {Code: "NZD", NumericCode: "554", MinorUnits: 2, Symbol: "NZ$"},
+	"OMR": {Code: "OMR", NumericCode: "512", MinorUnits: 3, Symbol: "OMR"},
+	"SEK": {Code: "SEK", NumericCode: "752", MinorUnits: 2, Symbol: "kr"},
+	"SGD": {Code: "SGD", NumericCode: "702", MinorUnits: 2, Symbol: "S$"},
+	"TND": {Code: "TND", NumericCode: "788", MinorUnits: 3, Symbol: "DT"},
+	"USD": {Code: "USD", NumericCode: "840", MinorUnits: 2, Symbol: "$"},
+	"ZAR": {Code: "ZAR", NumericCode: "710", MinorUnits: 2, Symbol: "R"},
+}
+
+// Currencies is a registry of currencies keyed by code, it's written as USD,EUR,JPY in app.env.
+// A currency missing from iso4217 is defined in full as CODE:numeric:minor units:symbol, like XAU:959:0:oz.
+type Currencies map[string]Currency
+
+var (
+	codePattern    = regexp.MustCompile(`^[A-Z]{3}$`)
+	numericPattern = regexp.MustCompile(`^[0-9]{3}$`)
+)
+
+// maxMinorUnits keeps ten to the power of the minor units inside an int64
+const maxMinorUnits = 18
+
+// DefaultCurrencies is the registry used until the config sets one
+func DefaultCurrencies() Currencies {
+	return Currencies{USD: iso4217[USD], EUR: iso4217[EUR], CAD: iso4217[CAD]}
+}
+
+// ParseCurrencies parses a comma separated list of currency codes or full definitions, an empty list is the default
+func ParseCurrencies(value string) (Currencies, error) {
+	currencies := make(Currencies)
+
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		fields := strings.Split(entry, ":")
+		code := strings.ToUpper(strings.TrimSpace(fields[0]))
+		if !codePattern.MatchString(code) {
+			return nil, fmt.Errorf("invalid currency code %q", fields[0])
+		}
+
+		switch len(fields) {
+		case 1:
+			currency, ok := iso4217[code]
+			if !ok {
+				return nil, fmt.Errorf("unknown currency %q, define it as %s:numeric:minor units:symbol", code, code)
+			}
+			currencies[code] = currency
+		case 4:
+			numericCode := strings.TrimSpace(fields[1])
+			if !numericPattern.MatchString(numericCode) {
+				return nil, fmt.Errorf("invalid numeric code for %s: %q", code, fields[1])
+			}
+
+			minorUnits, err := strconv.Atoi(strings.TrimSpace(fields[2]))
+			if err != nil || minorUnits < 0 || minorUnits > maxMinorUnits {
+				return nil, fmt.Errorf("invalid minor units for %s: %q", code, fields[2])
+			}
+
+			currencies[code] = Currency{
+				Code:        code,
+				NumericCode: numericCode,
+				MinorUnits:  minorUnits,
+				Symbol:      strings.TrimSpace(fields[3]),
+			}
+		default:
+			return nil, fmt.Errorf("invalid currency %q", entry)
+		}
+	}
+
+	if len(currencies) == 0 {
+		return DefaultCurrencies(), nil
+	}
+	return currencies, nil
+}
+
+// The registry every validator and formatter reads, LoadConfig replaces it at startup
+var (
+	registryMutex sync.RWMutex
+	registry      = DefaultCurrencies()
+)
+
+// SetCurrencies replaces the registry of supported currencies
+func SetCurrencies(currencies Currencies) {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+	registry = currencies
+}
+
+// LookupCurrency returns the supported currency with code, ok is false when it isn't one
+func LookupCurrency(code string) (currency Currency, ok bool) {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+	currency, ok = registry[code]
+	return
+}
+
 func IsSupportedCurrency(currency string) bool {
-	switch currency {
-	case USD, EUR, CAD:
-		return true
+	_, ok := LookupCurrency(currency)
+	return ok
+}
+
+// SupportedCurrencies lists the registry ordered by code
+func SupportedCurrencies() []Currency {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+
+	currencies := make([]Currency, 0, len(registry))
+	for _, currency := range registry {
+		currencies = append(currencies, currency)
+	}
+	sort.Slice(currencies, func(i, j int) bool { return currencies[i].Code < currencies[j].Code })
+	return currencies
+}
+
+// FormatAmount writes an amount of minor units as a decimal string with the currency's number of decimals,
+// 1234 is "12.34" in USD, "1234" in JPY and "1.234" in KWD
+func (currency Currency) FormatAmount(amount int64) string {
+	sign := ""
+	magnitude := uint64(amount)
+	if amount < 0 {
+		sign = "-"
+		magnitude = -magnitude
+	}
+
+	digits := strconv.FormatUint(magnitude, 10)
+	if currency.MinorUnits == 0 {
+		return sign + digits
+	}
+
+	if len(digits) <= currency.MinorUnits {
+		digits = strings.Repeat("0", currency.MinorUnits-len(digits)+1) + digits
+	}
+	point := len(digits) - currency.MinorUnits
+	return sign + digits[:point] + "." + digits[point:]
+}
+
+// FormatAmount formats an amount in the currency with code, one that isn't supported is written as whole minor units
+func FormatAmount(code string, amount int64) string {
+	currency, _ := LookupCurrency(code)
+	return currency.FormatAmount(amount)
+}
+
+// stringToCurrenciesHookFunc lets viper decode the Currencies setting from an env string
+func stringToCurrenciesHookFunc() mapstructure.DecodeHookFunc {
+	return func(from reflect.Type, to reflect.Type, data interface{}) (interface{}, error) {
+		if from.Kind() != reflect.String || to != reflect.TypeOf(Currencies{}) {
+			return data, nil
+		}
+		return ParseCurrencies(data.(string))
 	}
-	return false
 }
@@ -0,0 +1,58 @@
+package util
+
+import (
+	"fmt"
+	"github.com/mitchellh/mapstructure"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// CurrencyAmounts holds one amount per currency, it's written as USD=100000,EUR=90000 in app.env
+type CurrencyAmounts map[string]int64
+
+// Get returns the amount set for the currency, ok is false when there isn't one
+func (amounts CurrencyAmounts) Get(currency string) (amount int64, ok bool) {
+	amount, ok = amounts[currency]
+	return
+}
+
+// ParseCurrencyAmounts parses a comma separated list of CURRENCY=amount pairs
+func ParseCurrencyAmounts(value string) (CurrencyAmounts, error) {
+	amounts := make(CurrencyAmounts)
+
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		currency, amount, found := strings.Cut(pair, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid currency amount %q", pair)
+		}
+
+		currency = strings.TrimSpace(currency)
+		if !IsSupportedCurrency(currency) {
+			return nil, fmt.Errorf("unsupported currency %q", currency)
+		}
+
+		parsed, err := strconv.ParseInt(strings.TrimSpace(amount), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount for %s: %w", currency, err)
+		}
+		amounts[currency] = parsed
+	}
+
+	return amounts, nil
+}
+
+// stringToCurrencyAmountsHookFunc lets viper decode CurrencyAmounts settings from env strings
+func stringToCurrencyAmountsHookFunc() mapstructure.DecodeHookFunc {
+	return func(from reflect.Type, to reflect.Type, data interface{}) (interface{}, error) {
+		if from.Kind() != reflect.String || to != reflect.TypeOf(CurrencyAmounts{}) {
+			return data, nil
+		}
+		return ParseCurrencyAmounts(data.(string))
+	}
+}
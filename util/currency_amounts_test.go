@@ -0,0 +1,46 @@
+package util
+
+import (
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParseCurrencyAmounts(t *testing.T) {
+	amounts, err := ParseCurrencyAmounts("USD=100000, EUR=90000")
+	require.NoError(t, err)
+	require.Equal(t, CurrencyAmounts{USD: 100000, EUR: 90000}, amounts)
+
+	amount, ok := amounts.Get(CAD)
+	require.False(t, ok)
+	require.Zero(t, amount)
+
+	amounts, err = ParseCurrencyAmounts("")
+	require.NoError(t, err)
+	require.Empty(t, amounts)
+
+	_, err = ParseCurrencyAmounts("XYZ=1")
+	require.Error(t, err)
+
+	_, err = ParseCurrencyAmounts("USD")
+	require.Error(t, err)
+
+	_, err = ParseCurrencyAmounts("USD=lots")
+	require.Error(t, err)
+}
+
+func TestDecodeCurrencyAmounts(t *testing.T) {
+	var config Config
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook: stringToCurrencyAmountsHookFunc(),
+		Result:     &config,
+	})
+	require.NoError(t, err)
+
+	err = decoder.Decode(map[string]interface{}{"APPROVAL_THRESHOLDS": "USD=1000"})
+	require.NoError(t, err)
+
+	require.True(t, config.RequiresApproval(USD, 1001))
+	require.False(t, config.RequiresApproval(USD, 1000))
+	require.False(t, config.RequiresApproval(EUR, 1000000))
+}
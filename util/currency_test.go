@@ -0,0 +1,73 @@
+package util
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCurrencies(t *testing.T) {
+	currencies, err := ParseCurrencies("usd, JPY,KWD")
+	require.NoError(t, err)
+	require.Len(t, currencies, 3)
+	require.Equal(t, Currency{Code: "JPY", NumericCode: "392", MinorUnits: 0, Symbol: "¥"}, currencies["JPY"])
+	require.Equal(t, 3, currencies["KWD"].MinorUnits)
+	require.Equal(t, 2, currencies[USD].MinorUnits)
+
+	currencies, err = ParseCurrencies("XAU:959:0:oz")
+	require.NoError(t, err)
+	require.Equal(t, Currency{Code: "XAU", NumericCode: "959", MinorUnits: 0, Symbol: "oz"}, currencies["XAU"])
+
+	currencies, err = ParseCurrencies("")
+	require.NoError(t, err)
+	require.Equal(t, DefaultCurrencies(), currencies)
+
+	for _, value := range []string{"XYZ", "US", "XAU:95:0:oz", "XAU:959:-1:oz", "XAU:959:19:oz", "XAU:959:0"} {
+		_, err = ParseCurrencies(value)
+		require.Error(t, err, value)
+	}
+}
+
+func TestFormatAmount(t *testing.T) {
+	testCases := []struct {
+		currency string
+		amount   int64
+		expected string
+	}{
+		{"USD", 1234, "12.34"},
+		{"USD", 5, "0.05"},
+		{"USD", 0, "0.00"},
+		{"USD", -1234, "-12.34"},
+		{"USD", -5, "-0.05"},
+		{"JPY", 1234, "1234"},
+		{"JPY", -1234, "-1234"},
+		{"KWD", 1234, "1.234"},
+		{"KWD", 7, "0.007"},
+		{"KWD", math.MinInt64, "-9223372036854775.808"},
+	}
+
+	for _, tc := range testCases {
+		currency := iso4217[tc.currency]
+		require.Equal(t, tc.expected, currency.FormatAmount(tc.amount), "%s %d", tc.currency, tc.amount)
+	}
+}
+
+func TestCurrencyRegistry(t *testing.T) {
+	defer SetCurrencies(DefaultCurrencies())
+
+	require.True(t, IsSupportedCurrency(USD))
+	require.False(t, IsSupportedCurrency("JPY"))
+
+	currencies, err := ParseCurrencies("USD,JPY")
+	require.NoError(t, err)
+	SetCurrencies(currencies)
+
+	require.True(t, IsSupportedCurrency("JPY"))
+	require.False(t, IsSupportedCurrency(EUR))
+	require.Equal(t, "1234", FormatAmount("JPY", 1234))
+	require.Equal(t, []Currency{iso4217["JPY"], iso4217[USD]}, SupportedCurrencies())
+
+	_, err = ParseCurrencyAmounts("JPY=100000")
+	require.NoError(t, err)
+}
@@ -0,0 +1,53 @@
+package util
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// cursor is what a page token carries, lists page through rows in id order
+type cursor struct {
+	AfterID int64 `json:"a"`
+}
+
+// EncodeCursor turns the id of the last row of a page into the opaque token that fetches the page after it
+func EncodeCursor(lastID int64) string {
+	data, _ := json.Marshal(cursor{AfterID: lastID})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor returns the id a page token continues after, an empty token starts from the first page
+func DecodeCursor(token string) (afterID int64, err error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, fmt.Errorf("invalid page token")
+	}
+
+	var c cursor
+	if err = json.Unmarshal(data, &c); err != nil || c.AfterID < 0 {
+		return 0, fmt.Errorf("invalid page token")
+	}
+	return c.AfterID, nil
+}
+
+// PageLimit is the number of rows to fetch for a page of pageSize,
+// one row more than the page shows whether there's a page after it
+func PageLimit(pageSize int32) int32 {
+	return pageSize + 1
+}
+
+// NextPage trims rows fetched with a limit of PageLimit(pageSize) down to one page and returns the token of the page after it,
+// which is empty when there is none
+func NextPage[T any](rows []T, pageSize int32, id func(T) int64) ([]T, string) {
+	if len(rows) <= int(pageSize) {
+		return rows, ""
+	}
+
+	rows = rows[:pageSize]
+	return rows, EncodeCursor(id(rows[len(rows)-1]))
+}
@@ -0,0 +1,39 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor(t *testing.T) {
+	token := EncodeCursor(1234)
+	require.NotEmpty(t, token)
+
+	afterID, err := DecodeCursor(token)
+	require.NoError(t, err)
+	require.Equal(t, int64(1234), afterID)
+
+	afterID, err = DecodeCursor("")
+	require.NoError(t, err)
+	require.Zero(t, afterID)
+
+	_, err = DecodeCursor("not a token")
+	require.Error(t, err)
+
+	_, err = DecodeCursor(EncodeCursor(-1))
+	require.Error(t, err)
+}
+
+func TestNextPage(t *testing.T) {
+	id := func(value int64) int64 { return value }
+	require.Equal(t, int32(4), PageLimit(3))
+
+	page, token := NextPage([]int64{1, 2, 3}, 3, id)
+	require.Equal(t, []int64{1, 2, 3}, page)
+	require.Empty(t, token)
+
+	page, token = NextPage([]int64{1, 2, 3, 4}, 3, id)
+	require.Equal(t, []int64{1, 2, 3}, page)
+	require.Equal(t, EncodeCursor(3), token)
+}
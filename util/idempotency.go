@@ -0,0 +1,12 @@
+package util
+
+import "strings"
+
+// SystemIdempotencyKeyPrefix starts the idempotency keys the bank makes up for the transfers it sends itself,
+// e.g. standing order runs. Clients can't send keys with it, so they can't claim one of these first.
+const SystemIdempotencyKeyPrefix = "system:"
+
+// IsSystemIdempotencyKey tells whether key is in the namespace reserved for the bank's own keys
+func IsSystemIdempotencyKey(key string) bool {
+	return strings.HasPrefix(key, SystemIdempotencyKeyPrefix)
+}
@@ -0,0 +1,13 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsSystemIdempotencyKey(t *testing.T) {
+	require.True(t, IsSystemIdempotencyKey(SystemIdempotencyKeyPrefix+"standing_order:1:2"))
+	require.False(t, IsSystemIdempotencyKey("standing_order:1:2"))
+	require.False(t, IsSystemIdempotencyKey(RandomString(32)))
+}
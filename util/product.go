@@ -0,0 +1,15 @@
+package util
+
+// Account products, each product and currency pair has its own interest rate
+const (
+	CheckingProduct = "checking"
+	SavingsProduct  = "savings"
+)
+
+func IsSupportedProduct(product string) bool {
+	switch product {
+	case CheckingProduct, SavingsProduct:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,42 @@
+package util
+
+import (
+	"fmt"
+	"github.com/robfig/cron/v3"
+	"time"
+)
+
+// MinScheduleInterval is the shortest gap allowed between two runs of a schedule
+const MinScheduleInterval = time.Minute
+
+// Standard 5 field cron expressions plus descriptors like @monthly and @every 24h
+var scheduleParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// ParseSchedule checks a cron expression or @every interval and that it doesn't run more often than MinScheduleInterval
+func ParseSchedule(schedule string) (cron.Schedule, error) {
+	parsed, err := scheduleParser.Parse(schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	first := parsed.Next(time.Now())
+	if parsed.Next(first).Sub(first) < MinScheduleInterval {
+		return nil, fmt.Errorf("must not run more than once every %s", MinScheduleInterval)
+	}
+	return parsed, nil
+}
+
+// NextRun returns the first run of the schedule after the given time that isn't in the past.
+// Runs missed while nothing was running are skipped rather than replayed.
+func NextRun(schedule string, after time.Time) (time.Time, error) {
+	parsed, err := ParseSchedule(schedule)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	next := parsed.Next(after)
+	for now := time.Now(); !next.After(now); {
+		next = parsed.Next(next)
+	}
+	return next, nil
+}
@@ -0,0 +1,36 @@
+package util
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestParseSchedule(t *testing.T) {
+	_, err := ParseSchedule("0 9 1 * *")
+	require.NoError(t, err)
+
+	_, err = ParseSchedule("@every 720h")
+	require.NoError(t, err)
+
+	_, err = ParseSchedule("@every 1s")
+	require.Error(t, err)
+
+	_, err = ParseSchedule("every day")
+	require.Error(t, err)
+}
+
+func TestNextRun(t *testing.T) {
+	now := time.Now()
+
+	next, err := NextRun("@every 24h", now)
+	require.NoError(t, err)
+	require.WithinDuration(t, now.Add(24*time.Hour), next, time.Second)
+
+	// a run that was missed a few days ago is skipped, but the time of day is kept
+	scheduled := now.Add(-72*time.Hour - time.Minute)
+	next, err = NextRun("@every 24h", scheduled)
+	require.NoError(t, err)
+	require.True(t, next.After(now))
+	require.WithinDuration(t, now.Add(24*time.Hour-time.Minute), next, time.Second)
+}
@@ -0,0 +1,33 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSettlementSignature(t *testing.T) {
+	secret := RandomString(32)
+	signature := SignSettlement(secret, 42, "settled", "payout-1", "")
+	require.Len(t, signature, 64)
+
+	require.True(t, VerifySettlement(secret, 42, "settled", "payout-1", "", signature))
+	require.False(t, VerifySettlement(secret, 43, "settled", "payout-1", "", signature))
+	require.False(t, VerifySettlement(secret, 42, "failed", "payout-1", "", signature))
+	require.False(t, VerifySettlement(secret, 42, "settled", "payout-2", "", signature))
+	require.False(t, VerifySettlement(RandomString(32), 42, "settled", "payout-1", "", signature))
+	// no secret configured refuses every callback
+	require.False(t, VerifySettlement("", 42, "settled", "payout-1", "", SignSettlement("", 42, "settled", "payout-1", "")))
+}
+
+func TestSettlementSignatureFailureReason(t *testing.T) {
+	secret := RandomString(32)
+	signature := SignSettlement(secret, 42, "failed", "payout-1", "account closed")
+
+	require.True(t, VerifySettlement(secret, 42, "failed", "payout-1", "account closed", signature))
+	// only the reason changed
+	require.False(t, VerifySettlement(secret, 42, "failed", "payout-1", "insufficient funds", signature))
+	require.False(t, VerifySettlement(secret, 42, "failed", "payout-1", "", signature))
+	// moving text across the colon between the reference and the reason
+	require.False(t, VerifySettlement(secret, 42, "failed", "payout-1:account", "closed", SignSettlement(secret, 42, "failed", "payout-1", "account:closed")))
+}
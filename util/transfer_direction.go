@@ -0,0 +1,15 @@
+package util
+
+// Directions a list of an account's transfers can be narrowed to, empty lists both
+const (
+	IncomingTransfers = "incoming"
+	OutgoingTransfers = "outgoing"
+)
+
+func IsSupportedTransferDirection(direction string) bool {
+	switch direction {
+	case IncomingTransfers, OutgoingTransfers:
+		return true
+	}
+	return false
+}
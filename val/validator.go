@@ -1,9 +1,13 @@
 package val
 
 import (
+	"encoding/json"
 	"fmt"
+	"github.com/google/uuid"
+	"goBank/util"
 	"net/mail"
 	"regexp"
+	"time"
 )
 
 var (
@@ -67,3 +71,229 @@ func ValidateEmailId(value int64) error {
 func ValidateSecretCode(value string) error {
 	return ValidateString(value, 32, 128)
 }
+
+func ValidateID(value int64) error {
+	if value <= 0 {
+		return fmt.Errorf("must be a positive integer")
+	}
+	return nil
+}
+
+func ValidateAmount(value int64) error {
+	if value <= 0 {
+		return fmt.Errorf("must be greater than 0")
+	}
+	return nil
+}
+
+func ValidateCurrency(value string) error {
+	if !util.IsSupportedCurrency(value) {
+		return fmt.Errorf("unsupported currency")
+	}
+	return nil
+}
+
+func ValidateRole(value string) error {
+	if !util.IsSupportedRole(value) {
+		return fmt.Errorf("unsupported role")
+	}
+	return nil
+}
+
+func ValidateProduct(value string) error {
+	if !util.IsSupportedProduct(value) {
+		return fmt.Errorf("unsupported product")
+	}
+	return nil
+}
+
+func ValidatePageID(value int32) error {
+	if value < 1 {
+		return fmt.Errorf("must be at least 1")
+	}
+	return nil
+}
+
+// MaxPageSize is the most rows a list returns at once
+const MaxPageSize = 100
+
+func ValidatePageSize(value int32) error {
+	if value < 5 || value > MaxPageSize {
+		return fmt.Errorf("must be from 5-%d", MaxPageSize)
+	}
+	return nil
+}
+
+func ValidatePageToken(value string) error {
+	_, err := util.DecodeCursor(value)
+	return err
+}
+
+func ValidateTransferDirection(value string) error {
+	if !util.IsSupportedTransferDirection(value) {
+		return fmt.Errorf("must be %s or %s", util.IncomingTransfers, util.OutgoingTransfers)
+	}
+	return nil
+}
+
+// ValidateTimeRange checks the end of a range isn't before its start, either end may be unset
+func ValidateTimeRange(from time.Time, to time.Time) error {
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		return fmt.Errorf("must not be before the start of the range")
+	}
+	return nil
+}
+
+// ValidateAmountRange checks the upper bound of a range isn't below its lower bound, either bound may be unset
+func ValidateAmountRange(min *int64, max *int64) error {
+	if min != nil && max != nil && *max < *min {
+		return fmt.Errorf("must not be less than the lower bound")
+	}
+	return nil
+}
+
+func ValidateDescription(value string) error {
+	return ValidateString(value, 0, 500)
+}
+
+func ValidateExternalReference(value string) error {
+	return ValidateString(value, 1, 100)
+}
+
+// MaxMetadataSize is the most bytes of JSON a transfer's metadata can take
+const MaxMetadataSize = 4096
+
+func ValidateMetadata(value []byte) error {
+	if len(value) > MaxMetadataSize {
+		return fmt.Errorf("must be at most %d bytes", MaxMetadataSize)
+	}
+
+	var object map[string]interface{}
+	if err := json.Unmarshal(value, &object); err != nil || object == nil {
+		return fmt.Errorf("must be a JSON object")
+	}
+	return nil
+}
+
+func ValidateSearchQuery(value string) error {
+	return ValidateString(value, 1, 200)
+}
+
+func ValidateIdempotencyKey(value string) error {
+	if err := ValidateString(value, 1, 255); err != nil {
+		return err
+	}
+	if util.IsSystemIdempotencyKey(value) {
+		return fmt.Errorf("must not start with %q", util.SystemIdempotencyKeyPrefix)
+	}
+	return nil
+}
+
+func ValidateExchangeRate(value int64) error {
+	if value <= 0 {
+		return fmt.Errorf("must be a positive integer scaled by 1e8")
+	}
+	return nil
+}
+
+func ValidateQuoteID(value string) error {
+	if _, err := uuid.Parse(value); err != nil {
+		return fmt.Errorf("must be a valid UUID")
+	}
+	return nil
+}
+
+func ValidateSessionID(value string) error {
+	if _, err := uuid.Parse(value); err != nil {
+		return fmt.Errorf("must be a valid UUID")
+	}
+	return nil
+}
+
+func ValidateToken(value string) error {
+	return ValidateString(value, 1, 2048)
+}
+
+func ValidateSchedule(value string) error {
+	if _, err := util.ParseSchedule(value); err != nil {
+		return fmt.Errorf("must be a cron expression or @every interval: %s", err)
+	}
+	return nil
+}
+
+func ValidateFutureTime(value time.Time) error {
+	if !value.After(time.Now()) {
+		return fmt.Errorf("must be in the future")
+	}
+	return nil
+}
+
+func ValidateReason(value string) error {
+	return ValidateString(value, 1, 500)
+}
+
+func ValidateFee(value int64) error {
+	if value < 0 {
+		return fmt.Errorf("must not be negative")
+	}
+	return nil
+}
+
+func ValidateBasisPoints(value int64) error {
+	if value < 0 || value > 10000 {
+		return fmt.Errorf("must be from 0-10000")
+	}
+	return nil
+}
+
+func ValidateLimit(value int64) error {
+	if value < 0 {
+		return fmt.Errorf("must not be negative")
+	}
+	return nil
+}
+
+// MaxBatchSize caps how many transfers a single batch can pay
+const MaxBatchSize = 1000
+
+func ValidateBatchSize(value int) error {
+	if value < 1 || value > MaxBatchSize {
+		return fmt.Errorf("must have from 1-%d transfers", MaxBatchSize)
+	}
+	return nil
+}
+
+// MaxStatementPeriod is the longest date range a statement can cover
+const MaxStatementPeriod = 366 * 24 * time.Hour
+
+// ValidateStatementPeriod checks a statement's end comes after its start and it covers at most MaxStatementPeriod
+func ValidateStatementPeriod(start time.Time, end time.Time) error {
+	if !end.After(start) {
+		return fmt.Errorf("must be after the start of the period")
+	}
+	if end.Sub(start) > MaxStatementPeriod {
+		return fmt.Errorf("must be at most 366 days after the start of the period")
+	}
+	return nil
+}
+
+// MaxBalanceHistoryDays is the most daily balances a balance history returns
+const MaxBalanceHistoryDays = 366
+
+// ValidateBalanceHistoryRange checks the last day of a balance history isn't before its first and the history is at most MaxBalanceHistoryDays long
+func ValidateBalanceHistoryRange(fromDate time.Time, toDate time.Time) error {
+	if toDate.Before(fromDate) {
+		return fmt.Errorf("must not be before the first day")
+	}
+	if toDate.Sub(fromDate) >= MaxBalanceHistoryDays*24*time.Hour {
+		return fmt.Errorf("must be less than %d days after the first day", MaxBalanceHistoryDays)
+	}
+	return nil
+}
+
+func ValidateSettlementStatus(value string) error {
+	if value != "settled" && value != "failed" {
+		return fmt.Errorf("must be settled or failed")
+	}
+	return nil
+}
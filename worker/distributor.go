@@ -11,6 +11,31 @@ type TaskDistributor interface {
 		payload *PayloadSendVerifyEmail,
 		opts ...asynq.Option,
 	) error
+	DistributeTaskExecuteStandingOrder(
+		ctx context.Context,
+		payload *PayloadExecuteStandingOrder,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendPendingTransferReviewed(
+		ctx context.Context,
+		payload *PayloadSendPendingTransferReviewed,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskReconcileLedger(
+		ctx context.Context,
+		payload *PayloadReconcileLedger,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendStatement(
+		ctx context.Context,
+		payload *PayloadSendStatement,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendSessionReuseAlert(
+		ctx context.Context,
+		payload *PayloadSendSessionReuseAlert,
+		opts ...asynq.Option,
+	) error
 }
 
 type RedisTaskDistributor struct {
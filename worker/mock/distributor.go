@@ -36,6 +36,101 @@ func (m *MockTaskDistributor) EXPECT() *MockTaskDistributorMockRecorder {
 	return m.recorder
 }
 
+// DistributeTaskExecuteStandingOrder mocks base method.
+func (m *MockTaskDistributor) DistributeTaskExecuteStandingOrder(arg0 context.Context, arg1 *worker.PayloadExecuteStandingOrder, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskExecuteStandingOrder", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskExecuteStandingOrder indicates an expected call of DistributeTaskExecuteStandingOrder.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskExecuteStandingOrder(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskExecuteStandingOrder", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskExecuteStandingOrder), varargs...)
+}
+
+// DistributeTaskReconcileLedger mocks base method.
+func (m *MockTaskDistributor) DistributeTaskReconcileLedger(arg0 context.Context, arg1 *worker.PayloadReconcileLedger, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskReconcileLedger", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskReconcileLedger indicates an expected call of DistributeTaskReconcileLedger.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskReconcileLedger(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskReconcileLedger", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskReconcileLedger), varargs...)
+}
+
+// DistributeTaskSendPendingTransferReviewed mocks base method.
+func (m *MockTaskDistributor) DistributeTaskSendPendingTransferReviewed(arg0 context.Context, arg1 *worker.PayloadSendPendingTransferReviewed, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSendPendingTransferReviewed", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSendPendingTransferReviewed indicates an expected call of DistributeTaskSendPendingTransferReviewed.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendPendingTransferReviewed(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendPendingTransferReviewed", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendPendingTransferReviewed), varargs...)
+}
+
+// DistributeTaskSendSessionReuseAlert mocks base method.
+func (m *MockTaskDistributor) DistributeTaskSendSessionReuseAlert(arg0 context.Context, arg1 *worker.PayloadSendSessionReuseAlert, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSendSessionReuseAlert", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSendSessionReuseAlert indicates an expected call of DistributeTaskSendSessionReuseAlert.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendSessionReuseAlert(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendSessionReuseAlert", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendSessionReuseAlert), varargs...)
+}
+
+// DistributeTaskSendStatement mocks base method.
+func (m *MockTaskDistributor) DistributeTaskSendStatement(arg0 context.Context, arg1 *worker.PayloadSendStatement, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSendStatement", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSendStatement indicates an expected call of DistributeTaskSendStatement.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendStatement(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendStatement", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendStatement), varargs...)
+}
+
 // DistributeTaskSendVerifyEmail mocks base method.
 func (m *MockTaskDistributor) DistributeTaskSendVerifyEmail(arg0 context.Context, arg1 *worker.PayloadSendVerifyEmail, arg2 ...asynq.Option) error {
 	m.ctrl.T.Helper()
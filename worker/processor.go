@@ -18,15 +18,30 @@ const (
 type TaskProcessor interface {
 	Start() error
 	ProcessTaskSendVerifyEmail(ctx context.Context, task *asynq.Task) error
+	ProcessTaskExpireHolds(ctx context.Context, task *asynq.Task) error
+	ProcessTaskEnqueueStandingOrders(ctx context.Context, task *asynq.Task) error
+	ProcessTaskExecuteStandingOrder(ctx context.Context, task *asynq.Task) error
+	ProcessTaskExpirePendingTransfers(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendPendingTransferReviewed(ctx context.Context, task *asynq.Task) error
+	ProcessTaskScheduleReconciliation(ctx context.Context, task *asynq.Task) error
+	ProcessTaskReconcileLedger(ctx context.Context, task *asynq.Task) error
+	ProcessTaskAccrueInterest(ctx context.Context, task *asynq.Task) error
+	ProcessTaskPostInterest(ctx context.Context, task *asynq.Task) error
+	ProcessTaskScheduleStatements(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendStatement(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSnapshotBalances(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendSessionReuseAlert(ctx context.Context, task *asynq.Task) error
 }
 
 type RedisTaskProcessor struct {
 	server *asynq.Server
 	store  db.Store
 	mailer mail.EmailSender
+	// Periodic tasks fan out into per-item tasks through the distributor
+	distributor TaskDistributor
 }
 
-func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store, mailer mail.EmailSender) TaskProcessor {
+func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store, mailer mail.EmailSender, distributor TaskDistributor) TaskProcessor {
 	logger := NewLogger()
 	redis.SetLogger(logger)
 
@@ -46,9 +61,10 @@ func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store, mailer
 	)
 
 	return &RedisTaskProcessor{
-		server: server,
-		store:  store,
-		mailer: mailer,
+		server:      server,
+		store:       store,
+		mailer:      mailer,
+		distributor: distributor,
 	}
 }
 
@@ -56,6 +72,19 @@ func (processor *RedisTaskProcessor) Start() error {
 	mux := asynq.NewServeMux()
 
 	mux.HandleFunc(TaskSendVerifyEmail, processor.ProcessTaskSendVerifyEmail)
+	mux.HandleFunc(TaskExpireHolds, processor.ProcessTaskExpireHolds)
+	mux.HandleFunc(TaskEnqueueStandingOrders, processor.ProcessTaskEnqueueStandingOrders)
+	mux.HandleFunc(TaskExecuteStandingOrder, processor.ProcessTaskExecuteStandingOrder)
+	mux.HandleFunc(TaskExpirePendingTransfers, processor.ProcessTaskExpirePendingTransfers)
+	mux.HandleFunc(TaskSendPendingTransferReviewed, processor.ProcessTaskSendPendingTransferReviewed)
+	mux.HandleFunc(TaskScheduleReconciliation, processor.ProcessTaskScheduleReconciliation)
+	mux.HandleFunc(TaskReconcileLedger, processor.ProcessTaskReconcileLedger)
+	mux.HandleFunc(TaskAccrueInterest, processor.ProcessTaskAccrueInterest)
+	mux.HandleFunc(TaskPostInterest, processor.ProcessTaskPostInterest)
+	mux.HandleFunc(TaskScheduleStatements, processor.ProcessTaskScheduleStatements)
+	mux.HandleFunc(TaskSendStatement, processor.ProcessTaskSendStatement)
+	mux.HandleFunc(TaskSnapshotBalances, processor.ProcessTaskSnapshotBalances)
+	mux.HandleFunc(TaskSendSessionReuseAlert, processor.ProcessTaskSendSessionReuseAlert)
 
 	return processor.server.Start(mux)
 }
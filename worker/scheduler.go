@@ -0,0 +1,84 @@
+package worker
+
+import (
+	"fmt"
+	"github.com/hibiken/asynq"
+)
+
+// Cron specs of the periodic tasks
+const (
+	ScheduleExpireHolds            = "@every 1m"
+	ScheduleEnqueueStandingOrders  = "@every 1m"
+	ScheduleExpirePendingTransfers = "@every 5m"
+	ScheduleReconciliation         = "0 3 * * *"
+	// Just after midnight so the day's last transfers are in, posting waits for the last day of the month to accrue
+	ScheduleAccrueInterest = "10 0 * * *"
+	SchedulePostInterest   = "0 1 1 * *"
+	// Statements cover the previous calendar month in UTC
+	ScheduleStatements = "0 2 1 * *"
+	// Yesterday's closing balances, early so the day's history is quick to read
+	ScheduleSnapshotBalances = "5 0 * * *"
+)
+
+type TaskScheduler interface {
+	Start() error
+}
+
+type RedisTaskScheduler struct {
+	scheduler *asynq.Scheduler
+}
+
+func NewRedisTaskScheduler(redisOpt asynq.RedisClientOpt) TaskScheduler {
+	scheduler := asynq.NewScheduler(redisOpt, &asynq.SchedulerOpts{
+		Logger: NewLogger(),
+	})
+
+	return &RedisTaskScheduler{
+		scheduler: scheduler,
+	}
+}
+
+// Start registers every periodic task and blocks while enqueueing them on schedule
+func (taskScheduler *RedisTaskScheduler) Start() error {
+	_, err := taskScheduler.scheduler.Register(ScheduleExpireHolds, asynq.NewTask(TaskExpireHolds, nil), asynq.Queue(QueueDefault))
+	if err != nil {
+		return fmt.Errorf("failed to register %s: %w", TaskExpireHolds, err)
+	}
+
+	_, err = taskScheduler.scheduler.Register(ScheduleEnqueueStandingOrders, asynq.NewTask(TaskEnqueueStandingOrders, nil), asynq.Queue(QueueDefault))
+	if err != nil {
+		return fmt.Errorf("failed to register %s: %w", TaskEnqueueStandingOrders, err)
+	}
+
+	_, err = taskScheduler.scheduler.Register(ScheduleExpirePendingTransfers, asynq.NewTask(TaskExpirePendingTransfers, nil), asynq.Queue(QueueDefault))
+	if err != nil {
+		return fmt.Errorf("failed to register %s: %w", TaskExpirePendingTransfers, err)
+	}
+
+	_, err = taskScheduler.scheduler.Register(ScheduleReconciliation, asynq.NewTask(TaskScheduleReconciliation, nil), asynq.Queue(QueueDefault))
+	if err != nil {
+		return fmt.Errorf("failed to register %s: %w", TaskScheduleReconciliation, err)
+	}
+
+	_, err = taskScheduler.scheduler.Register(ScheduleAccrueInterest, asynq.NewTask(TaskAccrueInterest, nil), asynq.Queue(QueueDefault))
+	if err != nil {
+		return fmt.Errorf("failed to register %s: %w", TaskAccrueInterest, err)
+	}
+
+	_, err = taskScheduler.scheduler.Register(SchedulePostInterest, asynq.NewTask(TaskPostInterest, nil), asynq.Queue(QueueDefault))
+	if err != nil {
+		return fmt.Errorf("failed to register %s: %w", TaskPostInterest, err)
+	}
+
+	_, err = taskScheduler.scheduler.Register(ScheduleStatements, asynq.NewTask(TaskScheduleStatements, nil), asynq.Queue(QueueDefault))
+	if err != nil {
+		return fmt.Errorf("failed to register %s: %w", TaskScheduleStatements, err)
+	}
+
+	_, err = taskScheduler.scheduler.Register(ScheduleSnapshotBalances, asynq.NewTask(TaskSnapshotBalances, nil), asynq.Queue(QueueDefault))
+	if err != nil {
+		return fmt.Errorf("failed to register %s: %w", TaskSnapshotBalances, err)
+	}
+
+	return taskScheduler.scheduler.Run()
+}
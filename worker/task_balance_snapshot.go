@@ -0,0 +1,58 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+	db "goBank/db/sqlc"
+	"time"
+)
+
+const TaskSnapshotBalances = "task:snapshot_balances"
+
+// snapshotBatchSize is how many accounts are snapshotted per query
+const snapshotBatchSize = 500
+
+// ProcessTaskSnapshotBalances records yesterday's closing balance of every account that isn't closed,
+// so a balance at a point in time only adds up the entries since the day before it.
+// The balance is worked back from the current one, so when the task runs doesn't change it. Rerunning it for the same day is a no-op.
+func (processor *RedisTaskProcessor) ProcessTaskSnapshotBalances(ctx context.Context, task *asynq.Task) error {
+	dayEnd := time.Now().UTC().Truncate(24 * time.Hour)
+	snapshotDate := pgtype.Date{Time: dayEnd.AddDate(0, 0, -1), Valid: true}
+
+	var afterID, created int64
+	for {
+		accountIDs, err := processor.store.ListSnapshotAccounts(ctx, db.ListSnapshotAccountsParams{
+			DayEnd:  dayEnd,
+			AfterID: afterID,
+			Limit:   snapshotBatchSize,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list snapshot accounts: %w", err)
+		}
+		if len(accountIDs) == 0 {
+			break
+		}
+
+		rows, err := processor.store.CreateBalanceSnapshots(ctx, db.CreateBalanceSnapshotsParams{
+			SnapshotDate: snapshotDate,
+			DayEnd:       dayEnd,
+			AccountIds:   accountIDs,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create balance snapshots: %w", err)
+		}
+		created += rows
+
+		if len(accountIDs) < snapshotBatchSize {
+			break
+		}
+		afterID = accountIDs[len(accountIDs)-1]
+	}
+
+	log.Info().Str("type", task.Type()).Time("snapshot_date", snapshotDate.Time).
+		Int64("created", created).Msg("processed task")
+	return nil
+}
@@ -0,0 +1,38 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "goBank/db/sqlc"
+)
+
+const TaskExpireHolds = "task:expire_holds"
+
+// expireHoldsBatchSize caps how many holds one run releases, the next run picks up the rest
+const expireHoldsBatchSize = 100
+
+func (processor *RedisTaskProcessor) ProcessTaskExpireHolds(ctx context.Context, task *asynq.Task) error {
+	holds, err := processor.store.ListExpiredHolds(ctx, expireHoldsBatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to list expired holds: %w", err)
+	}
+
+	expired := 0
+	for _, hold := range holds {
+		_, err := processor.store.ExpireHoldTx(ctx, hold.ID)
+		if err != nil {
+			//Captured or voided since it was listed
+			if errors.Is(err, db.ErrHoldNotAuthorized) {
+				continue
+			}
+			return fmt.Errorf("failed to expire hold %d: %w", hold.ID, err)
+		}
+		expired++
+	}
+
+	log.Info().Str("type", task.Type()).Int("expired", expired).Msg("processed task")
+	return nil
+}
@@ -0,0 +1,108 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+	db "goBank/db/sqlc"
+	"time"
+)
+
+const (
+	TaskAccrueInterest = "task:accrue_interest"
+	TaskPostInterest   = "task:post_interest"
+)
+
+// interestBatchSize is how many accounts are accrued or paid per query
+const interestBatchSize = 500
+
+// ProcessTaskAccrueInterest accrues yesterday's interest on every active account whose product and currency has a rate.
+// It runs just after midnight UTC and works from each account's balance at the end of yesterday,
+// so transfers made since then don't count. Rerunning it for the same day is a no-op.
+func (processor *RedisTaskProcessor) ProcessTaskAccrueInterest(ctx context.Context, task *asynq.Task) error {
+	dayEnd := time.Now().UTC().Truncate(24 * time.Hour)
+	accrualDate := pgtype.Date{Time: dayEnd.AddDate(0, 0, -1), Valid: true}
+
+	var afterID, accrued int64
+	for {
+		rows, err := processor.store.ListInterestBearingAccounts(ctx, db.ListInterestBearingAccountsParams{
+			DayEnd:  dayEnd,
+			AfterID: afterID,
+			Limit:   interestBatchSize,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list interest bearing accounts: %w", err)
+		}
+
+		for _, row := range rows {
+			created, err := processor.store.CreateInterestAccrual(ctx, db.CreateInterestAccrualParams{
+				AccountID:   row.ID,
+				AccrualDate: accrualDate,
+				Balance:     row.EndOfDayBalance,
+				BasisPoints: row.BasisPoints,
+				Amount:      db.DailyInterest(row.EndOfDayBalance, row.BasisPoints),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to accrue interest for account %d: %w", row.ID, err)
+			}
+			accrued += created
+		}
+
+		if len(rows) < interestBatchSize {
+			break
+		}
+		afterID = rows[len(rows)-1].ID
+	}
+
+	log.Info().Str("type", task.Type()).Time("accrual_date", accrualDate.Time).
+		Int64("accrued", accrued).Msg("processed task")
+	return nil
+}
+
+// ProcessTaskPostInterest pays every account the interest it accrued before the current month.
+// An account that can't be paid keeps its accruals and is paid by the first run after it's active again.
+func (processor *RedisTaskProcessor) ProcessTaskPostInterest(ctx context.Context, task *asynq.Task) error {
+	now := time.Now().UTC()
+	before := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	var afterID, posted int64
+	for {
+		accountIDs, err := processor.store.ListAccountsWithUnpostedInterest(ctx, db.ListAccountsWithUnpostedInterestParams{
+			Before:  pgtype.Date{Time: before, Valid: true},
+			AfterID: afterID,
+			Limit:   interestBatchSize,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list accounts with unposted interest: %w", err)
+		}
+
+		for _, accountID := range accountIDs {
+			result, err := processor.store.PostInterestTx(ctx, db.PostInterestTxParams{
+				AccountID: accountID,
+				Before:    before,
+			})
+			if err != nil {
+				if errors.Is(err, db.ErrAccountFrozen) || errors.Is(err, db.ErrAccountClosed) {
+					log.Warn().Err(err).Int64("account_id", accountID).Msg("interest not posted")
+					continue
+				}
+				return fmt.Errorf("failed to post interest for account %d: %w", accountID, err)
+			}
+
+			if result.Accruals > 0 {
+				posted++
+			}
+		}
+
+		if len(accountIDs) < interestBatchSize {
+			break
+		}
+		afterID = accountIDs[len(accountIDs)-1]
+	}
+
+	log.Info().Str("type", task.Type()).Time("before", before).Int64("posted", posted).Msg("processed task")
+	return nil
+}
@@ -0,0 +1,115 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "goBank/db/sqlc"
+	"time"
+)
+
+const (
+	TaskExpirePendingTransfers      = "task:expire_pending_transfers"
+	TaskSendPendingTransferReviewed = "task:send_pending_transfer_reviewed"
+)
+
+// expirePendingTransfersBatchSize caps how many pending transfers one run expires, the next run picks up the rest
+const expirePendingTransfersBatchSize = 100
+
+type PayloadSendPendingTransferReviewed struct {
+	PendingTransferID int64 `json:"pending_transfer_id"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskSendPendingTransferReviewed(
+	ctx context.Context,
+	payload *PayloadSendPendingTransferReviewed,
+	opts ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	task := asynq.NewTask(TaskSendPendingTransferReviewed, jsonPayload, opts...)
+
+	info, err := distributor.client.EnqueueContext(ctx, task)
+
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).
+		Int("max_retry", info.MaxRetry).
+		Msg("enqueued task")
+
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskExpirePendingTransfers(ctx context.Context, task *asynq.Task) error {
+	pendings, err := processor.store.ExpirePendingTransfers(ctx, expirePendingTransfersBatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to expire pending transfers: %w", err)
+	}
+
+	//They're already expired, a failed notification mustn't make the next run skip the rest
+	for _, pending := range pendings {
+		payload := &PayloadSendPendingTransferReviewed{PendingTransferID: pending.ID}
+		err := processor.distributor.DistributeTaskSendPendingTransferReviewed(ctx, payload, asynq.MaxRetry(10))
+		if err != nil {
+			log.Error().Err(err).Int64("pending_transfer_id", pending.ID).Msg("failed to enqueue pending transfer expiry email")
+		}
+	}
+
+	log.Info().Str("type", task.Type()).Int("expired", len(pendings)).Msg("processed task")
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskSendPendingTransferReviewed(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendPendingTransferReviewed
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	pending, err := processor.store.GetPendingTransfer(ctx, payload.PendingTransferID)
+	if err != nil {
+		return fmt.Errorf("failed to get pending transfer: %w", err)
+	}
+
+	user, err := processor.store.GetUser(ctx, pending.Initiator)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	var subject, outcome string
+	switch pending.Status {
+	case db.PendingTransferStatusRejected:
+		subject = "Your transfer was rejected"
+		outcome = fmt.Sprintf("was rejected by a banker: %s", pending.Reason)
+	case db.PendingTransferStatusExpired:
+		subject = "Your transfer has expired"
+		outcome = fmt.Sprintf("was not approved before %s and has expired", pending.ExpiresAt.Format(time.RFC1123))
+	default:
+		return fmt.Errorf("pending transfer is %s: %w", pending.Status, asynq.SkipRetry)
+	}
+
+	content := fmt.Sprintf(`Hello %s,<br/>
+	Your transfer #%d of %d from account #%d to account #%d %s.<br/>
+	No money has been moved.<br/>
+	`, user.FullName, pending.ID, pending.Amount, pending.FromAccountID, pending.ToAccountID, outcome)
+	to := []string{user.Email}
+
+	err = processor.mailer.SendEmail(subject, content, to, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to send pending transfer email: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("email", user.Email).Msg("processed task")
+	return nil
+}
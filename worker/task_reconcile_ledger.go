@@ -0,0 +1,228 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+	db "goBank/db/sqlc"
+	"goBank/util"
+)
+
+const (
+	TaskScheduleReconciliation = "task:schedule_reconciliation"
+	TaskReconcileLedger        = "task:reconcile_ledger"
+)
+
+// reconcileBatchSize is how many accounts or transfers are checked per query
+const reconcileBatchSize = 500
+
+type PayloadReconcileLedger struct {
+	ReportID int64 `json:"report_id"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskReconcileLedger(
+	ctx context.Context,
+	payload *PayloadReconcileLedger,
+	opts ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	task := asynq.NewTask(TaskReconcileLedger, jsonPayload, opts...)
+
+	info, err := distributor.client.EnqueueContext(ctx, task)
+
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).
+		Int("max_retry", info.MaxRetry).
+		Msg("enqueued task")
+
+	return nil
+}
+
+// ProcessTaskScheduleReconciliation opens a report for the periodic run, the same way a banker asking for one does
+func (processor *RedisTaskProcessor) ProcessTaskScheduleReconciliation(ctx context.Context, task *asynq.Task) error {
+	report, err := processor.store.CreateReconciliationReport(ctx, pgtype.Text{})
+	if err != nil {
+		return fmt.Errorf("failed to create reconciliation report: %w", err)
+	}
+
+	payload := &PayloadReconcileLedger{ReportID: report.ID}
+	err = processor.distributor.DistributeTaskReconcileLedger(ctx, payload, asynq.Queue(QueueDefault))
+	if err != nil {
+		return fmt.Errorf("failed to enqueue reconciliation: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Int64("report_id", report.ID).Msg("processed task")
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskReconcileLedger(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadReconcileLedger
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	report, err := processor.store.GetReconciliationReport(ctx, payload.ReportID)
+	if err != nil {
+		return fmt.Errorf("failed to get reconciliation report: %w", err)
+	}
+
+	if report.Status == db.ReconciliationStatusCompleted {
+		log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).Msg("reconciliation already done")
+		return nil
+	}
+
+	//A retry starts the scan over, so drop whatever the failed attempt recorded
+	err = processor.store.DeleteReconciliationDiscrepancies(ctx, report.ID)
+	if err != nil {
+		return fmt.Errorf("failed to clear discrepancies: %w", err)
+	}
+
+	arg := db.CompleteReconciliationReportParams{ID: report.ID}
+
+	arg.AccountsChecked, err = processor.reconcileAccounts(ctx, report.ID, &arg.DiscrepancyCount)
+	if err != nil {
+		return err
+	}
+
+	arg.TransfersChecked, err = processor.reconcileTransfers(ctx, report.ID, &arg.DiscrepancyCount)
+	if err != nil {
+		return err
+	}
+
+	report, err = processor.store.CompleteReconciliationReport(ctx, arg)
+	if err != nil {
+		return fmt.Errorf("failed to complete reconciliation report: %w", err)
+	}
+
+	if report.DiscrepancyCount > 0 {
+		err = processor.sendReconciliationAlertEmail(ctx, report)
+		if err != nil {
+			//The report is recorded, retrying the task would only find it done
+			log.Error().Err(err).Int64("report_id", report.ID).Msg("failed to send reconciliation alert email")
+		}
+	}
+
+	log.Info().Str("type", task.Type()).Int64("report_id", report.ID).
+		Int64("discrepancies", report.DiscrepancyCount).Msg("processed task")
+	return nil
+}
+
+// reconcileAccounts checks every account's balance against the sum of its entries
+func (processor *RedisTaskProcessor) reconcileAccounts(ctx context.Context, reportID int64, discrepancies *int64) (int64, error) {
+	var checked, afterID int64
+	for {
+		rows, err := processor.store.ReconcileAccounts(ctx, db.ReconcileAccountsParams{
+			AfterID: afterID,
+			Limit:   reconcileBatchSize,
+		})
+		if err != nil {
+			return checked, fmt.Errorf("failed to reconcile accounts: %w", err)
+		}
+
+		for _, row := range rows {
+			if row.Balance == row.EntriesTotal {
+				continue
+			}
+
+			_, err := processor.store.CreateReconciliationDiscrepancy(ctx, db.CreateReconciliationDiscrepancyParams{
+				ReportID:  reportID,
+				Kind:      db.DiscrepancyKindBalance,
+				AccountID: pgtype.Int8{Int64: row.ID, Valid: true},
+				Expected:  row.EntriesTotal,
+				Actual:    row.Balance,
+			})
+			if err != nil {
+				return checked, fmt.Errorf("failed to record discrepancy: %w", err)
+			}
+			*discrepancies++
+		}
+
+		checked += int64(len(rows))
+		if len(rows) < reconcileBatchSize {
+			return checked, nil
+		}
+		afterID = rows[len(rows)-1].ID
+	}
+}
+
+// reconcileTransfers checks that every transfer has exactly its debit, its credit and its fee entry if it charged one
+func (processor *RedisTaskProcessor) reconcileTransfers(ctx context.Context, reportID int64, discrepancies *int64) (int64, error) {
+	var checked, afterID int64
+	for {
+		rows, err := processor.store.ReconcileTransfers(ctx, db.ReconcileTransfersParams{
+			AfterID: afterID,
+			Limit:   reconcileBatchSize,
+		})
+		if err != nil {
+			return checked, fmt.Errorf("failed to reconcile transfers: %w", err)
+		}
+
+		for _, row := range rows {
+			//A transfer that charged a fee also credits the revenue account
+			var feeEntries int64
+			if row.Fee > 0 {
+				feeEntries = 1
+			}
+			expected := 2 + feeEntries
+			if row.FromEntries == 1 && row.ToEntries == 1 && row.FeeEntries == feeEntries && row.TotalEntries == expected {
+				continue
+			}
+
+			_, err := processor.store.CreateReconciliationDiscrepancy(ctx, db.CreateReconciliationDiscrepancyParams{
+				ReportID:   reportID,
+				Kind:       db.DiscrepancyKindEntries,
+				TransferID: pgtype.Int8{Int64: row.ID, Valid: true},
+				Expected:   expected,
+				Actual:     row.TotalEntries,
+			})
+			if err != nil {
+				return checked, fmt.Errorf("failed to record discrepancy: %w", err)
+			}
+			*discrepancies++
+		}
+
+		checked += int64(len(rows))
+		if len(rows) < reconcileBatchSize {
+			return checked, nil
+		}
+		afterID = rows[len(rows)-1].ID
+	}
+}
+
+func (processor *RedisTaskProcessor) sendReconciliationAlertEmail(ctx context.Context, report db.ReconciliationReport) error {
+	bankers, err := processor.store.ListUsersByRole(ctx, util.BankerRole)
+	if err != nil {
+		return fmt.Errorf("failed to list bankers: %w", err)
+	}
+
+	if len(bankers) == 0 {
+		return fmt.Errorf("no banker to alert")
+	}
+
+	to := make([]string, len(bankers))
+	for i, banker := range bankers {
+		to[i] = banker.Email
+	}
+
+	subject := fmt.Sprintf("Reconciliation report #%d found %d discrepancies", report.ID, report.DiscrepancyCount)
+	content := fmt.Sprintf(`Hello,<br/>
+	Reconciliation report #%d checked %d accounts and %d transfers and found %d discrepancies between balances, transfers and entries.<br/>
+	Look the report up to see which accounts and transfers are affected.<br/>
+	`, report.ID, report.AccountsChecked, report.TransfersChecked, report.DiscrepancyCount)
+
+	return processor.mailer.SendEmail(subject, content, to, nil, nil, nil)
+}
@@ -0,0 +1,93 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	"html"
+	"time"
+)
+
+const TaskSendSessionReuseAlert = "task:send_session_reuse_alert"
+
+// PayloadSendSessionReuseAlert describes a refresh token that was presented again after it had been rotated
+type PayloadSendSessionReuseAlert struct {
+	SessionID uuid.UUID `json:"session_id"`
+	// Where the reused token was presented from
+	UserAgent  string    `json:"user_agent"`
+	ClientIP   string    `json:"client_ip"`
+	DetectedAt time.Time `json:"detected_at"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskSendSessionReuseAlert(
+	ctx context.Context,
+	payload *PayloadSendSessionReuseAlert,
+	opts ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	task := asynq.NewTask(TaskSendSessionReuseAlert, jsonPayload, opts...)
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).
+		Int("max_retry", info.MaxRetry).
+		Msg("enqueued task")
+
+	return nil
+}
+
+// ProcessTaskSendSessionReuseAlert emails the owner of a session whose rotated refresh token was used again.
+// The session's family has already been blocked by then, so every device on that login has to log in again.
+func (processor *RedisTaskProcessor) ProcessTaskSendSessionReuseAlert(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendSessionReuseAlert
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	session, err := processor.store.GetSession(ctx, payload.SessionID)
+	if err != nil {
+		return fmt.Errorf("failed to get session: %w", err)
+	}
+
+	user, err := processor.store.GetUser(ctx, session.Username)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	subject := "We signed you out of a device"
+	content := fmt.Sprintf(`Hello %s,<br/>
+	On %s a sign-in token of yours that had already been replaced was used again, from %s (%s).<br/>
+	Someone else may have a copy of it, so we signed out the device you logged in on from %s (%s) on %s.<br/>
+	Log in again to keep using it. If you don't recognise this activity, change your password.<br/>
+	`,
+		html.EscapeString(user.FullName),
+		payload.DetectedAt.UTC().Format(time.RFC1123),
+		html.EscapeString(payload.ClientIP),
+		html.EscapeString(payload.UserAgent),
+		html.EscapeString(session.ClientIp),
+		html.EscapeString(session.UserAgent),
+		session.CreatedAt.UTC().Format("2006-01-02"),
+	)
+	to := []string{user.Email}
+
+	err = processor.mailer.SendEmail(subject, content, to, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to send session reuse alert: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("email", user.Email).Msg("processed task")
+	return nil
+}
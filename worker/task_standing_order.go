@@ -0,0 +1,183 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "goBank/db/sqlc"
+	"goBank/util"
+	"time"
+)
+
+const (
+	TaskEnqueueStandingOrders = "task:enqueue_standing_orders"
+	TaskExecuteStandingOrder  = "task:execute_standing_order"
+)
+
+// enqueueStandingOrdersBatchSize caps how many due orders one run enqueues, the next run picks up the rest
+const enqueueStandingOrdersBatchSize = 100
+
+type PayloadExecuteStandingOrder struct {
+	StandingOrderID int64     `json:"standing_order_id"`
+	ScheduledAt     time.Time `json:"scheduled_at"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskExecuteStandingOrder(
+	ctx context.Context,
+	payload *PayloadExecuteStandingOrder,
+	opts ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	task := asynq.NewTask(TaskExecuteStandingOrder, jsonPayload, opts...)
+
+	info, err := distributor.client.EnqueueContext(ctx, task)
+
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).
+		Int("max_retry", info.MaxRetry).
+		Msg("enqueued task")
+
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskEnqueueStandingOrders(ctx context.Context, task *asynq.Task) error {
+	orders, err := processor.store.ListDueStandingOrders(ctx, enqueueStandingOrdersBatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to list due standing orders: %w", err)
+	}
+
+	for _, order := range orders {
+		payload := &PayloadExecuteStandingOrder{
+			StandingOrderID: order.ID,
+			ScheduledAt:     order.NextRunAt,
+		}
+
+		//The task ID makes the run unique, so a run still waiting in the queue isn't enqueued twice
+		err := processor.distributor.DistributeTaskExecuteStandingOrder(ctx, payload,
+			asynq.Queue(QueueCritical),
+			asynq.TaskID(standingOrderRunKey(order.ID, order.NextRunAt)),
+		)
+		if err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+			return fmt.Errorf("failed to enqueue standing order %d: %w", order.ID, err)
+		}
+	}
+
+	log.Info().Str("type", task.Type()).Int("due", len(orders)).Msg("processed task")
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskExecuteStandingOrder(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadExecuteStandingOrder
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	order, err := processor.store.GetStandingOrder(ctx, payload.StandingOrderID)
+	if err != nil {
+		return fmt.Errorf("failed to get standing order: %w", err)
+	}
+
+	if !order.IsActive || !order.NextRunAt.Equal(payload.ScheduledAt) {
+		log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).Msg("standing order run already done")
+		return nil
+	}
+
+	nextRunAt, err := util.NextRun(order.Schedule, order.NextRunAt)
+	if err != nil {
+		return fmt.Errorf("failed to schedule next run: %w", asynq.SkipRetry)
+	}
+
+	//Scheduled payments count towards the owner's transfer limits like any other
+	owner, err := processor.store.GetUser(ctx, order.Owner)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	//The idempotency key makes a retried run replay the transfer instead of paying twice
+	arg := db.CompleteStandingOrderRunTxParams{
+		StandingOrderID: order.ID,
+		ScheduledAt:     order.NextRunAt,
+		NextRunAt:       nextRunAt,
+	}
+	result, err := processor.store.TransferTx(ctx, db.TransferTxParams{
+		FromAccountID:  order.FromAccountID,
+		ToAccountID:    order.ToAccountID,
+		Amount:         order.Amount,
+		Role:           owner.Role,
+		Username:       owner.Username,
+		IdempotencyKey: standingOrderRunKey(order.ID, order.NextRunAt),
+	})
+	if err != nil {
+		if !isTransferRejected(err) {
+			return fmt.Errorf("failed to transfer: %w", err)
+		}
+		arg.Error = err.Error()
+	} else {
+		arg.TransferID = result.Transfer.ID
+	}
+
+	_, err = processor.store.CompleteStandingOrderRunTx(ctx, arg)
+	if err != nil {
+		if errors.Is(err, db.ErrStandingOrderRunDone) {
+			return nil
+		}
+		return fmt.Errorf("failed to record standing order run: %w", err)
+	}
+
+	if arg.Error != "" {
+		err = processor.sendStandingOrderFailedEmail(ctx, order, arg.Error)
+		if err != nil {
+			//The run is recorded, retrying the task would only find it done
+			log.Error().Err(err).Int64("standing_order_id", order.ID).Msg("failed to send standing order failure email")
+		}
+	}
+
+	log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("error", arg.Error).Msg("processed task")
+	return nil
+}
+
+func (processor *RedisTaskProcessor) sendStandingOrderFailedEmail(ctx context.Context, order db.StandingOrder, reason string) error {
+	user, err := processor.store.GetUser(ctx, order.Owner)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	subject := "Your standing order could not be paid"
+	content := fmt.Sprintf(`Hello %s,<br/>
+	Your standing order #%d of %d from account #%d to account #%d scheduled for %s could not be paid: %s.<br/>
+	We will try again at the next scheduled run.<br/>
+	`, user.FullName, order.ID, order.Amount, order.FromAccountID, order.ToAccountID,
+		order.NextRunAt.Format(time.RFC1123), reason)
+	to := []string{user.Email}
+
+	return processor.mailer.SendEmail(subject, content, to, nil, nil, nil)
+}
+
+// standingOrderRunKey is the idempotency key of a run, in the namespace clients can't send keys in
+func standingOrderRunKey(standingOrderID int64, scheduledAt time.Time) string {
+	return fmt.Sprintf("%sstanding_order:%d:%d", util.SystemIdempotencyKeyPrefix, standingOrderID, scheduledAt.UnixMicro())
+}
+
+// isTransferRejected tells transfers the bank refused apart from errors that are worth retrying.
+// A conflicting idempotency key would conflict again on every retry.
+func isTransferRejected(err error) bool {
+	return errors.Is(err, db.ErrInsufficientFunds) || errors.Is(err, db.ErrIdempotencyKeyConflict) ||
+		errors.Is(err, db.ErrAccountFrozen) || errors.Is(err, db.ErrAccountClosed) ||
+		errors.Is(err, db.ErrTransferAmountLimit) || errors.Is(err, db.ErrDailyAmountLimit) ||
+		errors.Is(err, db.ErrDailyCountLimit) || errors.Is(err, db.ErrFeeExceedsAmount)
+}
@@ -0,0 +1,198 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "goBank/db/sqlc"
+	"goBank/statement"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	TaskScheduleStatements = "task:schedule_statements"
+	TaskSendStatement      = "task:send_statement"
+)
+
+// statementBatchSize is how many accounts get their statement enqueued per query
+const statementBatchSize = 500
+
+type PayloadSendStatement struct {
+	AccountID   int64     `json:"account_id"`
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskSendStatement(
+	ctx context.Context,
+	payload *PayloadSendStatement,
+	opts ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	task := asynq.NewTask(TaskSendStatement, jsonPayload, opts...)
+
+	info, err := distributor.client.EnqueueContext(ctx, task)
+
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).
+		Int("max_retry", info.MaxRetry).
+		Msg("enqueued task")
+
+	return nil
+}
+
+// ProcessTaskScheduleStatements enqueues last month's statement of every customer account.
+// Each statement is built and emailed by its own task so one failing mailbox doesn't hold up the rest.
+func (processor *RedisTaskProcessor) ProcessTaskScheduleStatements(ctx context.Context, task *asynq.Task) error {
+	now := time.Now().UTC()
+	periodEnd := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	periodStart := periodEnd.AddDate(0, -1, 0)
+
+	var afterID, enqueued int64
+	for {
+		accountIDs, err := processor.store.ListStatementAccounts(ctx, db.ListStatementAccountsParams{
+			PeriodStart: periodStart,
+			PeriodEnd:   periodEnd,
+			AfterID:     afterID,
+			Limit:       statementBatchSize,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list statement accounts: %w", err)
+		}
+
+		for _, accountID := range accountIDs {
+			payload := &PayloadSendStatement{
+				AccountID:   accountID,
+				PeriodStart: periodStart,
+				PeriodEnd:   periodEnd,
+			}
+			err = processor.distributor.DistributeTaskSendStatement(ctx, payload, asynq.Queue(QueueDefault))
+			if err != nil {
+				return fmt.Errorf("failed to enqueue statement for account %d: %w", accountID, err)
+			}
+			enqueued++
+		}
+
+		if len(accountIDs) < statementBatchSize {
+			break
+		}
+		afterID = accountIDs[len(accountIDs)-1]
+	}
+
+	log.Info().Str("type", task.Type()).Time("period_start", periodStart).
+		Int64("enqueued", enqueued).Msg("processed task")
+	return nil
+}
+
+// ProcessTaskSendStatement builds an account's statement for the period, stores it and emails it
+// to the owner with the csv and printable html attached. A statement that was already emailed isn't sent again,
+// and an empty account that had no activity doesn't get one.
+func (processor *RedisTaskProcessor) ProcessTaskSendStatement(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendStatement
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	stored, err := processor.store.GetStatementForPeriod(ctx, db.GetStatementForPeriodParams{
+		AccountID:   payload.AccountID,
+		PeriodStart: payload.PeriodStart,
+		PeriodEnd:   payload.PeriodEnd,
+	})
+	if err != nil && !errors.Is(err, db.ErrRecordNotFound) {
+		return fmt.Errorf("failed to get statement: %w", err)
+	}
+	if err == nil && stored.EmailedAt.Valid {
+		log.Info().Str("type", task.Type()).Int64("statement_id", stored.ID).Msg("statement already emailed")
+		return nil
+	}
+
+	result, err := processor.store.StatementTx(ctx, db.StatementTxParams{
+		AccountID:   payload.AccountID,
+		PeriodStart: payload.PeriodStart,
+		PeriodEnd:   payload.PeriodEnd,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return fmt.Errorf("account doesn't exist: %w", asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to build statement: %w", err)
+	}
+
+	if len(result.Lines) == 0 && result.ClosingBalance == 0 {
+		log.Info().Str("type", task.Type()).Int64("account_id", payload.AccountID).Msg("no statement for an empty account")
+		return nil
+	}
+
+	csvContent, err := statement.CSV(result)
+	if err != nil {
+		return err
+	}
+	htmlContent, err := statement.HTML(result)
+	if err != nil {
+		return err
+	}
+
+	stored, err = processor.store.CreateStatement(ctx, result.CreateStatementParams(csvContent, htmlContent))
+	if err != nil {
+		return fmt.Errorf("failed to create statement: %w", err)
+	}
+
+	user, err := processor.store.GetUser(ctx, result.Account.Owner)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	// The mailer attaches files by path
+	dir, err := os.MkdirTemp("", "statement")
+	if err != nil {
+		return fmt.Errorf("failed to create statement directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	attachments := []string{
+		filepath.Join(dir, statement.FileName(result, "csv")),
+		filepath.Join(dir, statement.FileName(result, "html")),
+	}
+	for i, content := range []string{csvContent, htmlContent} {
+		if err := os.WriteFile(attachments[i], []byte(content), 0o600); err != nil {
+			return fmt.Errorf("failed to write statement file: %w", err)
+		}
+	}
+
+	subject := fmt.Sprintf("Your statement for account #%d", result.Account.ID)
+	content := fmt.Sprintf(`Hello %s,<br/>
+	Your statement for account #%d from %s to %s is attached.<br/>
+	Open the html file in a browser to print it or save it as a PDF.<br/>
+	`, user.FullName, result.Account.ID, result.PeriodStart.Format(time.DateOnly), result.PeriodEnd.Format(time.DateOnly))
+	to := []string{user.Email}
+
+	err = processor.mailer.SendEmail(subject, content, to, nil, nil, attachments)
+	if err != nil {
+		return fmt.Errorf("failed to send statement email: %w", err)
+	}
+
+	_, err = processor.store.MarkStatementEmailed(ctx, stored.ID)
+	if err != nil {
+		return fmt.Errorf("failed to mark statement emailed: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Int64("statement_id", stored.ID).Str("email", user.Email).Msg("processed task")
+	return nil
+}